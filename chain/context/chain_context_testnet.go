@@ -48,6 +48,7 @@ const (
 	ResendBlockService  = "resendBlockService"
 	PrivacyService      = "privacyService"
 	PermissionService   = "permissionService"
+	EventLogService     = "eventLogService"
 )
 
 type serviceManager interface {
@@ -87,6 +88,7 @@ func NewChainContext(cfgFile string) *ChainContext {
 			chainID:          id,
 			connectPeersPool: new(sync.Map),
 			bandwidthStats:   new(topic.EventBandwidthStats),
+			watchers:         newConfigWatchers(),
 		}
 		sr.povSyncState.Store(topic.SyncNotStart)
 		sr.p2pSyncState.Store(topic.SyncNotStart)
@@ -122,6 +124,7 @@ type ChainContext struct {
 	connectPeersInfo []*types.PeerInfo
 	onlinePeersInfo  []*types.PeerInfo
 	bandwidthStats   *topic.EventBandwidthStats
+	watchers         *configWatchers
 }
 
 func (cc *ChainContext) EventBus() event.EventBus {
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package context
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+var (
+	ErrConfigNotChanged  = errors.New("config not changed")
+	ErrConfigNeedRestart = errors.New("config keys need restart, they must be saved")
+)
+
+// ConfigHook applies the new config to a running service, the change takes effect after restart if it returns error
+type ConfigHook func(cfg *config.Config) error
+
+// ConfigChange is the result of applying the committed config to the running services
+type ConfigChange struct {
+	// keys applied by the services live
+	Applied []string `json:"applied"`
+	// keys which take effect after the chain is restarted
+	Restart []string `json:"restart"`
+	// errors of the services failed to apply the change
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+type configWatcher struct {
+	name string
+	keys []string
+	hook ConfigHook
+}
+
+// watch returns whether the changed key is watched, a watched key covers itself and its children
+func (w *configWatcher) watch(key string) bool {
+	for _, k := range w.keys {
+		if key == k || strings.HasPrefix(key, k+".") {
+			return true
+		}
+	}
+	return false
+}
+
+type configWatchers struct {
+	locker   sync.RWMutex
+	watchers []*configWatcher
+}
+
+func newConfigWatchers() *configWatchers {
+	return &configWatchers{}
+}
+
+// WatchConfig registers the hook of the service to apply the changes of the keys live,
+// keys are json keys of the config joined by dot, like rpc.publicModules or metrics.influx
+func (cc *ChainContext) WatchConfig(name string, keys []string, hook ConfigHook) {
+	cc.watchers.locker.Lock()
+	defer cc.watchers.locker.Unlock()
+
+	for _, w := range cc.watchers.watchers {
+		if w.name == name {
+			w.keys = keys
+			w.hook = hook
+			return
+		}
+	}
+	cc.watchers.watchers = append(cc.watchers.watchers, &configWatcher{name: name, keys: keys, hook: hook})
+}
+
+func (cc *ChainContext) UnwatchConfig(name string) {
+	cc.watchers.locker.Lock()
+	defer cc.watchers.locker.Unlock()
+
+	for i, w := range cc.watchers.watchers {
+		if w.name == name {
+			cc.watchers.watchers = append(cc.watchers.watchers[:i], cc.watchers.watchers[i+1:]...)
+			return
+		}
+	}
+}
+
+// ApplyConfig commits the changed config to runtime, saves it to the config file if isSave,
+// and notifies the services watching the changed keys. If it is not saved, the change is refused
+// if any key is not watched, since the key would be lost when the chain is restarted.
+func (cc *ChainContext) ApplyConfig(isSave bool) (*ConfigChange, error) {
+	cm, err := cc.ConfigManager()
+	if err != nil {
+		return nil, err
+	}
+	old, err := cm.Config()
+	if err != nil {
+		return nil, err
+	}
+	if !isSave {
+		pending, err := cm.Pending()
+		if err != nil {
+			return nil, ErrConfigNotChanged
+		}
+		keys, err := config.ChangedKeys(old, pending)
+		if err != nil {
+			return nil, err
+		}
+		if unwatched := cc.unwatchedKeys(keys); len(unwatched) > 0 {
+			return nil, fmt.Errorf("%s: %s", ErrConfigNeedRestart, strings.Join(unwatched, ", "))
+		}
+	}
+	if isSave {
+		err = cm.CommitAndSave()
+	} else {
+		err = cm.Commit()
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return nil, err
+	}
+	if cfg == old {
+		return nil, ErrConfigNotChanged
+	}
+	keys, err := config.ChangedKeys(old, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cc.notifyConfig(keys, cfg), nil
+}
+
+// unwatchedKeys returns the keys not watched by any service
+func (cc *ChainContext) unwatchedKeys(keys []string) []string {
+	cc.watchers.locker.RLock()
+	defer cc.watchers.locker.RUnlock()
+
+	var unwatched []string
+	for _, k := range keys {
+		watched := false
+		for _, w := range cc.watchers.watchers {
+			if w.watch(k) {
+				watched = true
+				break
+			}
+		}
+		if !watched {
+			unwatched = append(unwatched, k)
+		}
+	}
+	return unwatched
+}
+
+func (cc *ChainContext) notifyConfig(keys []string, cfg *config.Config) *ConfigChange {
+	cc.watchers.locker.RLock()
+	defer cc.watchers.locker.RUnlock()
+
+	change := &ConfigChange{
+		Applied: make([]string, 0),
+		Restart: make([]string, 0),
+		Errors:  make(map[string]string),
+	}
+	// a key is applied if all services watching it applied the change
+	applied := make(map[string]bool)
+	for _, w := range cc.watchers.watchers {
+		var watched []string
+		for _, k := range keys {
+			if w.watch(k) {
+				watched = append(watched, k)
+			}
+		}
+		if len(watched) == 0 {
+			continue
+		}
+		err := w.hook(cfg)
+		for _, k := range watched {
+			if v, ok := applied[k]; !ok || v {
+				applied[k] = err == nil
+			}
+		}
+		if err != nil {
+			log.Root.Errorf("%s apply config: %s", w.name, err)
+			change.Errors[w.name] = err.Error()
+		}
+	}
+	for _, k := range keys {
+		if applied[k] {
+			change.Applied = append(change.Applied, k)
+		} else {
+			change.Restart = append(change.Restart, k)
+		}
+	}
+	return change
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package context
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func TestChainContext_ApplyConfig(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	ctx := NewChainContext(filepath.Join(dir, "test.json"))
+	defer func() {
+		_ = ctx.Destroy()
+		_ = os.RemoveAll(dir)
+	}()
+	cm, err := ctx.ConfigManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var level string
+	ctx.WatchConfig(LogService, []string{"logLevel"}, func(cfg *config.Config) error {
+		level = cfg.LogLevel
+		return nil
+	})
+	ctx.WatchConfig(P2PService, []string{"p2p.bootNode", "p2p.discovery"}, func(cfg *config.Config) error {
+		return errors.New("apply failed")
+	})
+	ctx.WatchConfig(MetricsService, []string{"metrics"}, func(cfg *config.Config) error {
+		return nil
+	})
+
+	if _, err := ctx.ApplyConfig(false); err != ErrConfigNotChanged {
+		t.Fatal(err)
+	}
+
+	params := []string{"logLevel=debug", "p2p.discovery.limit=10", "p2p.syncInterval=200", "metrics.influx.interval=20"}
+	if _, err := cm.UpdateParams(params); err != nil {
+		t.Fatal(err)
+	}
+	// p2p.syncInterval is not watched, it would be lost by restart if it is not saved
+	if _, err := ctx.ApplyConfig(false); err == nil || !strings.Contains(err.Error(), ErrConfigNeedRestart.Error()) ||
+		!strings.Contains(err.Error(), "p2p.syncInterval") {
+		t.Fatal("expect need restart, got", err)
+	}
+	if cfg, _ := ctx.Config(); cfg.P2P.SyncInterval == 200 || level != "" {
+		t.Fatal("config should not be committed")
+	}
+	change, err := ctx.ApplyConfig(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level != "debug" {
+		t.Fatal("log level not applied", level)
+	}
+	if !reflect.DeepEqual(change.Applied, []string{"logLevel", "metrics.influx.interval"}) {
+		t.Fatal(change.Applied)
+	}
+	if !reflect.DeepEqual(change.Restart, []string{"p2p.discovery.limit", "p2p.syncInterval"}) {
+		t.Fatal(change.Restart)
+	}
+	if _, ok := change.Errors[P2PService]; !ok || len(change.Errors) != 1 {
+		t.Fatal(change.Errors)
+	}
+	if cfg, _ := ctx.Config(); cfg.P2P.SyncInterval != 200 {
+		t.Fatal("config not committed")
+	}
+	if content, err := ioutil.ReadFile(cm.ConfigFile); err != nil || !strings.Contains(string(content), `"syncInterval": 200`) {
+		t.Fatal("config not saved", err)
+	}
+
+	ctx.UnwatchConfig(LogService)
+	if _, err := cm.UpdateParams([]string{"logLevel=info"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.ApplyConfig(false); err == nil {
+		t.Fatal("unwatched key should not be applied without saving")
+	}
+	if change, err := ctx.ApplyConfig(true); err != nil || len(change.Applied) != 0 || len(change.Restart) != 1 {
+		t.Fatal(err, change)
+	}
+	if level != "debug" {
+		t.Fatal("unwatched hook is called")
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"errors"
+
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
+)
+
+// EventLogService records chain events, so that subscriptions can be resumed by cursor
+type EventLogService struct {
+	common.ServiceLifecycle
+	cfgFile  string
+	eventLog *eventlog.EventLog
+}
+
+func NewEventLogService(cfgFile string) *EventLogService {
+	return &EventLogService{cfgFile: cfgFile}
+}
+
+func (es *EventLogService) Init() error {
+	if !es.PreInit() {
+		return errors.New("pre init fail")
+	}
+	defer es.PostInit()
+
+	cc := context.NewChainContext(es.cfgFile)
+	el, err := eventlog.NewEventLog(ledger.NewLedger(es.cfgFile).DBStore(), cc.EventBus(), eventlog.DefaultRetention)
+	if err != nil {
+		return err
+	}
+	es.eventLog = el
+	return el.Start()
+}
+
+func (es *EventLogService) Start() error {
+	if !es.PreStart() {
+		return errors.New("pre start fail")
+	}
+	defer es.PostStart()
+	return nil
+}
+
+func (es *EventLogService) Stop() error {
+	if !es.PreStop() {
+		return errors.New("pre stop fail")
+	}
+	defer es.PostStop()
+	if es.eventLog != nil {
+		return es.eventLog.Stop()
+	}
+	return nil
+}
+
+func (es *EventLogService) Status() int32 {
+	return es.State()
+}
+
+func (es *EventLogService) EventLog() *eventlog.EventLog {
+	return es.eventLog
+}
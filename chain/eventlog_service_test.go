@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestEventLogService(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		ledger.CloseLedger()
+		_ = os.RemoveAll(dir)
+	}()
+
+	s := NewEventLogService(cm.ConfigFile)
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Status() != 4 {
+		t.Fatal("event log service start failed")
+	}
+
+	blk := mock.StateBlockWithoutWork()
+	context.NewChainContext(cm.ConfigFile).EventBus().Publish(topic.EventConfirmedBlock, blk)
+	for i := 0; s.EventLog().Latest() == 0; i++ {
+		if i > 50 {
+			t.Fatal("event is not recorded")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err := s.EventLog().Range(0, func(e *eventlog.Event) error {
+		if e.Hash != blk.GetHash() {
+			t.Fatal("invalid event", e)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Status() != 6 {
+		t.Fatal("stop failed.")
+	}
+}
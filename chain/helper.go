@@ -24,6 +24,8 @@ func RegisterServices(cc *context.ChainContext) error {
 	_ = logService.Init()
 	ledgerService := NewLedgerService(cfgFile)
 	_ = cc.Register(context.LedgerService, ledgerService)
+	eventLogService := NewEventLogService(cfgFile)
+	_ = cc.Register(context.EventLogService, eventLogService)
 
 	if !cc.HasService(context.WalletService) {
 		walletService := NewWalletService(cfgFile)
@@ -21,6 +21,7 @@ import (
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/vm/contract"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
 )
 
@@ -34,9 +35,14 @@ type LedgerService struct {
 func NewLedgerService(cfgFile string) *LedgerService {
 	cc := context.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
+	l := ledger.NewLedger(cfgFile)
+	logger := log.NewLogger("ledger_service")
+	if err := l.SetMethodNameResolver(contract.GetChainContractName); err != nil {
+		logger.Error(err)
+	}
 	return &LedgerService{
-		Ledger: ledger.NewLedger(cfgFile),
-		logger: log.NewLogger("ledger_service"),
+		Ledger: l,
+		logger: logger,
 		cfg:    cfg,
 	}
 }
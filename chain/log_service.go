@@ -22,12 +22,13 @@ import (
 type LogService struct {
 	common.ServiceLifecycle
 	cfg *config.Config
+	cc  *context.ChainContext
 }
 
 func NewLogService(cfgFile string) *LogService {
 	cc := context.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
-	return &LogService{cfg: cfg}
+	return &LogService{cfg: cfg, cc: cc}
 }
 
 func (ls *LogService) Init() error {
@@ -36,23 +37,36 @@ func (ls *LogService) Init() error {
 	}
 	defer ls.PostInit()
 
-	// enable rpc debug log
-	l := zap.ErrorLevel
-	if err := l.Set(ls.cfg.LogLevel); err == nil {
-		if l.Enabled(zap.DebugLevel) {
-			rpc.IsDebug = true
-		}
-	}
+	setRPCDebug(ls.cfg.LogLevel)
 
 	return log.Setup(ls.cfg)
 }
 
+// enable rpc debug log
+func setRPCDebug(level string) {
+	l := zap.ErrorLevel
+	if err := l.Set(level); err == nil {
+		rpc.IsDebug = l.Enabled(zap.DebugLevel)
+	}
+}
+
 func (ls *LogService) Start() error {
 	if !ls.PreStart() {
 		return errors.New("LogService pre start fail")
 	}
 	defer ls.PostStart()
 
+	ls.cc.WatchConfig(context.LogService, []string{"logLevel", "log.levels"}, func(cfg *config.Config) error {
+		if err := log.SetLevel(cfg.LogLevel); err != nil {
+			return err
+		}
+		setRPCDebug(cfg.LogLevel)
+		if cfg.Log != nil {
+			return log.SetModuleLevels(cfg.Log.Levels)
+		}
+		return nil
+	})
+
 	return nil
 }
 
@@ -62,6 +76,7 @@ func (ls *LogService) Stop() error {
 	}
 	defer ls.PostStop()
 
+	ls.cc.UnwatchConfig(context.LogService)
 	return log.Teardown()
 }
 
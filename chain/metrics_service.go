@@ -10,13 +10,37 @@ package chain
 import (
 	"context"
 	"errors"
+	"math/big"
+	"sync"
 	"time"
 
+	"github.com/rcrowley/go-metrics"
+
 	ctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/monitor"
 	"github.com/qlcchain/go-qlc/monitor/influxdb"
+	"github.com/qlcchain/go-qlc/monitor/prometheus"
+)
+
+var (
+	povHeightGauge      = metrics.NewRegisteredGauge("pov/height", monitor.ChainRegistry)
+	povTDGauge          = metrics.NewRegisteredGaugeFloat64("pov/td", monitor.ChainRegistry)
+	povTxPoolGauge      = metrics.NewRegisteredGauge("pov/txpool", monitor.ChainRegistry)
+	p2pPeersGauge       = metrics.NewRegisteredGauge("p2p/peers", monitor.ChainRegistry)
+	p2pTotalInGauge     = metrics.NewRegisteredGauge("p2p/bandwidth/totalIn", monitor.ChainRegistry)
+	p2pTotalOutGauge    = metrics.NewRegisteredGauge("p2p/bandwidth/totalOut", monitor.ChainRegistry)
+	p2pRateInGauge      = metrics.NewRegisteredGaugeFloat64("p2p/bandwidth/rateIn", monitor.ChainRegistry)
+	p2pRateOutGauge     = metrics.NewRegisteredGaugeFloat64("p2p/bandwidth/rateOut", monitor.ChainRegistry)
+	cacheIndexGauge     = metrics.NewRegisteredGauge("ledger/cache/index", monitor.ChainRegistry)
+	cacheKeysGauge      = metrics.NewRegisteredGauge("ledger/cache/keys", monitor.ChainRegistry)
+	cacheBlocksGauge    = metrics.NewRegisteredGauge("ledger/cache/blocks", monitor.ChainRegistry)
+	cacheFlushTimeGauge = metrics.NewRegisteredGauge("ledger/cache/flushMilliseconds", monitor.ChainRegistry)
+	dbLSMSizeGauge      = metrics.NewRegisteredGauge("ledger/db/lsm", monitor.ChainRegistry)
+	dbVlogSizeGauge     = metrics.NewRegisteredGauge("ledger/db/vlog", monitor.ChainRegistry)
 )
 
 func NewMetricsService(cfgFile string) *MetricsService {
@@ -24,17 +48,24 @@ func NewMetricsService(cfgFile string) *MetricsService {
 	cfg, _ := cc.Config()
 	ctx2, cancel := context.WithCancel(context.Background())
 	return &MetricsService{
-		cfg:    cfg,
-		ctx:    ctx2,
-		cancel: cancel,
+		cfg:     cfg,
+		cfgFile: cfgFile,
+		cc:      cc,
+		ctx:     ctx2,
+		cancel:  cancel,
 	}
 }
 
 type MetricsService struct {
 	common.ServiceLifecycle
-	cfg    *config.Config
-	ctx    context.Context
-	cancel context.CancelFunc
+	cfg     *config.Config
+	cfgFile string
+	cc      *ctx.ChainContext
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// cancels the runtime stats capture and influx reporter, they are restarted when the config is changed
+	captureLock   sync.Mutex
+	captureCancel context.CancelFunc
 }
 
 func (m *MetricsService) Init() error {
@@ -52,16 +83,45 @@ func (m *MetricsService) Start() error {
 	}
 	defer m.PostStart()
 
-	if m.cfg.Metrics.Enable {
-		d := time.Second * time.Duration(m.cfg.Metrics.SampleInterval)
-		go monitor.CaptureRuntimeCPUStats(m.ctx, d)
-		go monitor.CaptureRuntimeDiskStats(m.ctx, d)
-		go monitor.CaptureRuntimeNetStats(m.ctx, d)
+	m.startCapture(m.cfg.Metrics)
+
+	prom := m.cfg.Metrics.Prometheus
+	if prom != nil && prom.Enable {
+		if err := prometheus.Serve(m.ctx, metrics.DefaultRegistry, prom.ListenAddress, m.collectChainMetrics); err != nil {
+			return err
+		}
+	}
+
+	m.cc.WatchConfig(ctx.MetricsService, []string{"metrics.enable", "metrics.sampleInterval", "metrics.influx"},
+		func(cfg *config.Config) error {
+			m.startCapture(cfg.Metrics)
+			return nil
+		})
+
+	return nil
+}
+
+// startCapture stops the running runtime stats capture and influx reporter, and starts them by the config
+func (m *MetricsService) startCapture(cfg *config.MetricsConfig) {
+	m.captureLock.Lock()
+	defer m.captureLock.Unlock()
+
+	if m.captureCancel != nil {
+		m.captureCancel()
 	}
+	var c context.Context
+	c, m.captureCancel = context.WithCancel(m.ctx)
 
-	influx := m.cfg.Metrics.Influx
+	if cfg.Enable {
+		d := time.Second * time.Duration(cfg.SampleInterval)
+		go monitor.CaptureRuntimeCPUStats(c, d)
+		go monitor.CaptureRuntimeDiskStats(c, d)
+		go monitor.CaptureRuntimeNetStats(c, d)
+	}
+
+	influx := cfg.Influx
 	if influx != nil && influx.Enable && len(influx.URL) > 0 && len(influx.Database) > 0 {
-		go influxdb.InfluxDB(m.ctx,
+		go influxdb.InfluxDB(c,
 			monitor.SystemRegistry,                     // metrics registry
 			time.Second*time.Duration(influx.Interval), // interval
 			influx.URL,      // the InfluxDB url
@@ -70,8 +130,49 @@ func (m *MetricsService) Start() error {
 			influx.Password, // your InfluxDB password
 		)
 	}
+}
 
-	return nil
+// collectChainMetrics updates the chain gauges, it is called before each prometheus scrape
+func (m *MetricsService) collectChainMetrics() {
+	cc := ctx.NewChainContext(m.cfgFile)
+	l := ledger.NewLedger(m.cfgFile)
+
+	if header, err := l.GetLatestPovHeader(); err == nil {
+		povHeightGauge.Update(int64(header.GetHeight()))
+		if td, err := l.GetPovTD(header.GetHash(), header.GetHeight()); err == nil {
+			f, _ := new(big.Float).SetInt(td.Chain.ToBigInt()).Float64()
+			povTDGauge.Update(f)
+		}
+	}
+	if sv, err := cc.Service(ctx.PovService); err == nil {
+		if ps, ok := sv.(*PoVService); ok && ps.GetPoVEngine() != nil {
+			povTxPoolGauge.Update(int64(ps.GetPoVEngine().GetTxPool().GetPendingTxNum()))
+		}
+	}
+
+	p2pPeersGauge.Update(int64(len(cc.GetConnectPeersInfo())))
+	if bw := cc.GetBandwidthStats(); bw != nil {
+		p2pTotalInGauge.Update(bw.TotalIn)
+		p2pTotalOutGauge.Update(bw.TotalOut)
+		p2pRateInGauge.Update(bw.RateIn)
+		p2pRateOutGauge.Update(bw.RateOut)
+	}
+
+	if stats := l.GetCacheStat(); len(stats) > 0 {
+		c := stats[len(stats)-1]
+		cacheIndexGauge.Update(int64(c.Index))
+		cacheKeysGauge.Update(int64(c.Key))
+		cacheBlocksGauge.Update(int64(c.Block))
+		if c.End > c.Start {
+			cacheFlushTimeGauge.Update((c.End - c.Start) / int64(time.Millisecond))
+		}
+	}
+	if r, err := l.Action(storage.Size, 0); err == nil {
+		if s, ok := r.(map[string]int64); ok {
+			dbLSMSizeGauge.Update(s["lsm"])
+			dbVlogSizeGauge.Update(s["vlog"])
+		}
+	}
 }
 
 func (m *MetricsService) Stop() error {
@@ -80,6 +181,7 @@ func (m *MetricsService) Stop() error {
 	}
 	defer m.PostStop()
 
+	m.cc.UnwatchConfig(ctx.MetricsService)
 	m.cancel()
 
 	return nil
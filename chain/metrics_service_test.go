@@ -8,13 +8,17 @@
 package chain
 
 import (
+	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
 )
 
 func TestMetricsService(t *testing.T) {
@@ -46,3 +50,41 @@ func TestMetricsService(t *testing.T) {
 		t.Fatal("metrics stop failed.")
 	}
 }
+
+func TestMetricsService_Prometheus(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		ledger.CloseLedger()
+		_ = os.RemoveAll(dir)
+	}()
+
+	ls := NewMetricsService(cm.ConfigFile)
+	ls.cfg.Metrics.Prometheus = &config.Prometheus{Enable: true, ListenAddress: "127.0.0.1:19748"}
+	if err := ls.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ls.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer ls.Stop()
+
+	rsp, err := http.Get("http://127.0.0.1:19748/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rsp.Body.Close()
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"qlc_chain_pov_height", "qlc_chain_p2p_peers", "qlc_chain_ledger_db_lsm", "qlc_chain_dpos_confirmation_seconds_count"} {
+		if !strings.Contains(string(body), name) {
+			t.Fatal(name, "not found")
+		}
+	}
+}
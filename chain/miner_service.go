@@ -12,7 +12,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/consensus/pov"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/miner"
@@ -21,6 +23,7 @@ import (
 type MinerService struct {
 	common.ServiceLifecycle
 	miner  *miner.Miner
+	cc     *context.ChainContext
 	logger *zap.SugaredLogger
 }
 
@@ -28,6 +31,7 @@ func NewMinerService(cfgFile string, povEngine *pov.PoVEngine) *MinerService {
 	m := miner.NewMiner(cfgFile, povEngine.GetChain(), povEngine.GetTxPool(), povEngine.GetConsensus())
 	return &MinerService{
 		miner:  m,
+		cc:     context.NewChainContext(cfgFile),
 		logger: log.NewLogger("miner_service"),
 	}
 }
@@ -50,7 +54,13 @@ func (ms *MinerService) Start() error {
 	}
 	defer ms.PostStart()
 
-	return ms.miner.Start()
+	if err := ms.miner.Start(); err != nil {
+		return err
+	}
+	ms.cc.WatchConfig(context.MinerService, []string{"pov.coinbase", "pov.algoName"}, func(cfg *config.Config) error {
+		return ms.miner.SetMinerConfig(cfg.PoV)
+	})
+	return nil
 }
 
 func (ms *MinerService) Stop() error {
@@ -59,6 +69,7 @@ func (ms *MinerService) Stop() error {
 	}
 	defer ms.PostStop()
 
+	ms.cc.UnwatchConfig(context.MinerService)
 	return ms.miner.Stop()
 }
 
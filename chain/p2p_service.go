@@ -12,7 +12,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/p2p"
 )
@@ -20,6 +22,7 @@ import (
 type P2PService struct {
 	common.ServiceLifecycle
 	p2p    *p2p.QlcService
+	cc     *context.ChainContext
 	logger *zap.SugaredLogger
 }
 
@@ -28,7 +31,7 @@ func NewP2PService(cfgFile string) (*P2PService, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &P2PService{p2p: p, logger: log.NewLogger("p2p_service")}, nil
+	return &P2PService{p2p: p, cc: context.NewChainContext(cfgFile), logger: log.NewLogger("p2p_service")}, nil
 }
 
 func (p *P2PService) Init() error {
@@ -49,6 +52,11 @@ func (p *P2PService) Start() error {
 		p.logger.Error(err)
 		return err
 	}
+	p.cc.WatchConfig(context.P2PService, []string{"p2p.bootNode", "p2p.discovery.limit", "p2pLimit"}, func(cfg *config.Config) error {
+		p.p2p.Node().SetBootNodes(cfg.P2P.BootNodes)
+		p.p2p.Node().SetDiscoveryLimit(cfg.P2P.Discovery.Limit)
+		return p.p2p.Node().SetMessageLimit(cfg.P2PLimit)
+	})
 	p.PostStart()
 	return nil
 }
@@ -59,6 +67,7 @@ func (p *P2PService) Stop() error {
 	}
 	defer p.PostStop()
 
+	p.cc.UnwatchConfig(context.P2PService)
 	p.p2p.Stop()
 	p.logger.Info("p2p stopped")
 	return nil
@@ -14,6 +14,7 @@ import (
 
 	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/privacy"
 )
@@ -21,6 +22,7 @@ import (
 type PrivacyService struct {
 	common.ServiceLifecycle
 	controller *privacy.Controller
+	cc         *context.ChainContext
 	logger     *zap.SugaredLogger
 }
 
@@ -30,6 +32,7 @@ func NewPrivacyService(cfgFile string) *PrivacyService {
 	ctrl := privacy.NewController(cc)
 	return &PrivacyService{
 		controller: ctrl,
+		cc:         cc,
 		logger:     log.NewLogger("privacy_service"),
 	}
 }
@@ -48,7 +51,13 @@ func (s *PrivacyService) Start() error {
 	}
 	defer s.PostStart()
 
-	return s.controller.Start()
+	if err := s.controller.Start(); err != nil {
+		return err
+	}
+	s.cc.WatchConfig(context.PrivacyService, []string{"privacy.ptmNode"}, func(cfg *config.Config) error {
+		return s.controller.SetPtmNode(cfg.Privacy.PtmNode)
+	})
+	return nil
 }
 
 func (s *PrivacyService) Stop() error {
@@ -57,6 +66,7 @@ func (s *PrivacyService) Stop() error {
 	}
 	defer s.PostStop()
 
+	s.cc.UnwatchConfig(context.PrivacyService)
 	return s.controller.Stop()
 }
 
@@ -12,7 +12,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/rpc"
 )
@@ -20,6 +22,7 @@ import (
 type RPCService struct {
 	common.ServiceLifecycle
 	rpc    *rpc.RPC
+	cc     *context.ChainContext
 	logger *zap.SugaredLogger
 }
 
@@ -28,7 +31,7 @@ func NewRPCService(cfgFile string) (*RPCService, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &RPCService{rpc: rpc, logger: log.NewLogger("rpc_service")}, nil
+	return &RPCService{rpc: rpc, cc: context.NewChainContext(cfgFile), logger: log.NewLogger("rpc_service")}, nil
 }
 
 func (rs *RPCService) Init() error {
@@ -49,6 +52,9 @@ func (rs *RPCService) Start() error {
 		rs.logger.Error(err)
 		return err
 	}
+	rs.cc.WatchConfig(context.RPCService, []string{"rpc.publicModules"}, func(cfg *config.Config) error {
+		return rs.rpc.SetPublicModules(cfg.RPC.PublicModules)
+	})
 	rs.PostStart()
 	return nil
 }
@@ -59,6 +65,7 @@ func (rs *RPCService) Stop() error {
 	}
 	defer rs.PostStop()
 
+	rs.cc.UnwatchConfig(context.RPCService)
 	rs.rpc.StopRPC()
 	rs.logger.Info("rpc stopped")
 	return nil
@@ -2,7 +2,6 @@ package commands
 
 import (
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 )
@@ -28,7 +27,7 @@ func addDebugFeedConsensusCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDebugFeedConsensusCmd() error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 )
@@ -26,7 +25,7 @@ func addDebugConsensusGetPerfCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDebugConsensusGetPerfCmd() error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
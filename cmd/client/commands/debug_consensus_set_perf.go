@@ -2,7 +2,6 @@ package commands
 
 import (
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 )
@@ -46,7 +45,7 @@ func addDebugConsensusSetPerfCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDebugConsensusSetPerfCmd(op int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 )
@@ -31,7 +30,7 @@ func addDebugPovInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDebugPovInfoCmd() error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
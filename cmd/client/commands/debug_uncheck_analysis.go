@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -42,7 +41,7 @@ func addDebugUncheckAnalysisCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDebugUncheckAnalysisCmd(hashStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"golang.org/x/net/websocket"
+
+	"github.com/qlcchain/go-qlc/rpc/tlsutil"
+)
+
+var (
+	caCertP     string
+	clientCertP string
+	clientKeyP  string
+)
+
+// rpcClient also closes the websocket connection, which is not closed by the client created by rpc.DialIO
+type rpcClient struct {
+	*rpc.Client
+	conn io.Closer
+}
+
+// Close closes the connection first, the client waits for its reader to stop
+func (c *rpcClient) Close() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+	c.Client.Close()
+}
+
+// dial connects to endpointP, for https and wss endpoints only server certificates issued by the CA of caCertP
+// are trusted if it is set, and the client certificate is sent for mutual TLS
+func dial() (*rpcClient, error) {
+	u, err := url.Parse(endpointP)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "https" && u.Scheme != "wss") || (caCertP == "" && clientCertP == "") {
+		client, err := rpc.Dial(endpointP)
+		if err != nil {
+			return nil, err
+		}
+		return &rpcClient{Client: client}, nil
+	}
+
+	tlsConfig, err := tlsutil.ClientConfig(caCertP, clientCertP, clientKeyP)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "https" {
+		client, err := rpc.DialHTTPWithClient(endpointP, &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &rpcClient{Client: client}, nil
+	}
+
+	origin, _ := os.Hostname()
+	wsConfig, err := websocket.NewConfig(endpointP, "http://"+origin)
+	if err != nil {
+		return nil, err
+	}
+	wsConfig.TlsConfig = tlsConfig
+	conn, err := websocket.DialConfig(wsConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := rpc.DialIO(context.Background(), conn, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &rpcClient{Client: client, conn: conn}, nil
+}
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -141,7 +140,7 @@ func addDSChangeOrderCmdByShell(parentCmd *ishell.Cmd) {
 
 func DSChangeOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, startTimeP, endTimeP, billingTypeP,
 	bandwidthP, billingUnitP, priceP, productIdP, privateFromP, privateForP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -155,7 +154,7 @@ func addDSCreateOrderCmdByShell(parentCmd *ishell.Cmd) {
 
 func DSCreateOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, srcPortP, dstPortP, billingTypeP,
 	bandwidthP, billingUnitP, priceP, startTimeP, endTimeP, numP, privateFromP, privateForP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
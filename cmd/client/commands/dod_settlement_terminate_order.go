@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -104,7 +103,7 @@ func addDSTerminateOrderCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func DSTerminateOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, productIdP, priceP, privateFromP, privateForP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -80,7 +79,7 @@ func addDSTerminateResponseCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func DSTerminateResponse(addressP, hashP, actionP, privateFromP, privateForP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -95,7 +94,7 @@ func addDSUpdateProductInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func DSUpdateProductInfo(addressP, orderIdP, productIdP, orderItemIdP, activeP, privateFromP, privateForP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
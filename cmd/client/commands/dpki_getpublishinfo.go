@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -61,7 +60,7 @@ func addDpkiGetPublishInfoCmdByShell(parentCmd *ishell.Cmd) {
 func dpkiGetPublishInfoAction(addressP, stypeP, sidP string) error {
 	fmt.Println(addressP, stypeP, sidP)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
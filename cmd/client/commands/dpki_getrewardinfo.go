@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -51,7 +50,7 @@ func dpkiRewardInfoAction(addressP string) error {
 	}
 	rwdAddr, _ := types.HexToAddress(addressP)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -52,7 +51,7 @@ func addDpkiGetVerifierStateListCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runDpkiGetVerifierStateListCmd(hash string, height int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -128,7 +127,7 @@ func publish(accountP, typeP, idP, ktP, pkP, feeP, verifiersP string) error {
 		return fmt.Errorf("account format err")
 	}
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
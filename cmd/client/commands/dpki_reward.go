@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -97,7 +96,7 @@ func dpkiRewardAction(rwdPriKeyP string, bnfPriKeyP string, bnfAddrHexP string)
 		}
 	}
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
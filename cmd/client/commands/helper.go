@@ -18,4 +18,7 @@ func addcommands() {
 	addPermissionCmd()
 	addPrivacyCmd()
 	addPtmKeyCmd()
+	addMultiSigCmd()
+	addLogCmd()
+	addTopCmd()
 }
@@ -18,6 +18,9 @@ func addcommands() {
 	addPermissionCmd()
 	addPrivacyCmd()
 	addPtmKeyCmd()
+	addMultiSigCmd()
+	addLogCmd()
+	addTopCmd()
 	addDoDSettlementCmd()
 	addKYCCmd()
 }
@@ -11,7 +11,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -71,7 +70,7 @@ func addLedgerBalanceByCobra(parentCmd *cobra.Command) {
 }
 
 func accountBalance(addresses []string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
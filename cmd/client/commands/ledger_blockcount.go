@@ -11,7 +11,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -55,7 +54,7 @@ func addLedgerBlockCountByCobra(parentCmd *cobra.Command) {
 }
 
 func blocks() error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -58,7 +57,7 @@ func addLedgerDumpByCobra(parentCmd *cobra.Command) {
 }
 
 func dump() error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
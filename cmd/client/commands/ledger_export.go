@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+var exportCsvHeader = []string{"hash", "type", "time", "token", "tokenName", "direction", "amount", "value",
+	"counterparty", "method", "params"}
+
+type ledgerExportParam struct {
+	account string
+	from    string
+	to      string
+	format  string
+	output  string
+}
+
+func addLedgerExportByIshell(parentCmd *ishell.Cmd) {
+	account := util.Flag{
+		Name:  "account",
+		Must:  true,
+		Usage: "account address to export",
+		Value: "",
+	}
+	from := util.Flag{
+		Name:  "from",
+		Must:  false,
+		Usage: "start date, YYYY-MM-DD, RFC3339 or unix seconds",
+		Value: "",
+	}
+	to := util.Flag{
+		Name:  "to",
+		Must:  false,
+		Usage: "end date (inclusive), YYYY-MM-DD, RFC3339 or unix seconds",
+		Value: "",
+	}
+	format := util.Flag{
+		Name:  "format",
+		Must:  false,
+		Usage: "output format, csv or jsonl",
+		Value: "csv",
+	}
+	output := util.Flag{
+		Name:  "output",
+		Must:  false,
+		Usage: "file to write, stdout if empty",
+		Value: "",
+	}
+	args := []util.Flag{account, from, to, format, output}
+	c := &ishell.Cmd{
+		Name:                "export",
+		Help:                "export account history with decoded amounts and contract methods",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			p := ledgerExportParam{
+				account: util.StringVar(c.Args, account),
+				from:    util.StringVar(c.Args, from),
+				to:      util.StringVar(c.Args, to),
+				format:  util.StringVar(c.Args, format),
+				output:  util.StringVar(c.Args, output),
+			}
+			if err := ledgerExportAction(&p); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addLedgerExportByCobra(parentCmd *cobra.Command) {
+	var p ledgerExportParam
+	var c = &cobra.Command{
+		Use:   "export",
+		Short: "export account history with decoded amounts and contract methods",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := ledgerExportAction(&p); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&p.account, "account", "", "account address to export")
+	c.Flags().StringVar(&p.from, "from", "", "start date, YYYY-MM-DD, RFC3339 or unix seconds")
+	c.Flags().StringVar(&p.to, "to", "", "end date (inclusive), YYYY-MM-DD, RFC3339 or unix seconds")
+	c.Flags().StringVar(&p.format, "format", "csv", "output format, csv or jsonl")
+	c.Flags().StringVar(&p.output, "output", "", "file to write, stdout if empty")
+	parentCmd.AddCommand(c)
+}
+
+func ledgerExportAction(p *ledgerExportParam) error {
+	address, err := types.HexToAddress(p.account)
+	if err != nil {
+		return err
+	}
+	start, err := parseExportTime(p.from, false)
+	if err != nil {
+		return fmt.Errorf("invalid from: %s", err)
+	}
+	end, err := parseExportTime(p.to, true)
+	if err != nil {
+		return fmt.Errorf("invalid to: %s", err)
+	}
+	if p.format != "csv" && p.format != "jsonl" {
+		return fmt.Errorf("invalid format %s, should be csv or jsonl", p.format)
+	}
+
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var w io.Writer = os.Stdout
+	if p.output != "" {
+		f, err := os.Create(p.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	rw, err := newExportWriter(w, p.format)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan *api.APIHistoryExport, 100)
+	sub, err := client.Subscribe(ctx, "ledger", ch, "exportAccountHistory", address, start, end)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case r := <-ch:
+			if r.Done {
+				if err := rw.flush(); err != nil {
+					return err
+				}
+				if r.Error != "" {
+					return fmt.Errorf("export stopped after %d records: %s", r.Count, r.Error)
+				}
+				if p.output != "" {
+					txPrint(fmt.Sprintf("%d records are exported to %s", r.Count, p.output))
+				}
+				return nil
+			}
+			if r.Record != nil {
+				if err := rw.write(r.Record); err != nil {
+					return err
+				}
+			}
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		}
+	}
+}
+
+// parseExportTime parses date to unix seconds, date only of the end is the end of the day, empty is unlimited
+func parseExportTime(s string, isEnd bool) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		if isEnd {
+			return t.AddDate(0, 0, 1).Unix() - 1, nil
+		}
+		return t.Unix(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%s is not YYYY-MM-DD, RFC3339 or unix seconds", s)
+	}
+	return i, nil
+}
+
+type exportWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func newExportWriter(w io.Writer, format string) (*exportWriter, error) {
+	if format == "jsonl" {
+		return &exportWriter{json: json.NewEncoder(w)}, nil
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportCsvHeader); err != nil {
+		return nil, err
+	}
+	return &exportWriter{csv: cw}, nil
+}
+
+func (w *exportWriter) write(r *api.APIHistoryRecord) error {
+	if w.json != nil {
+		return w.json.Encode(r)
+	}
+	params := ""
+	if len(r.Params) > 0 {
+		b, err := json.Marshal(r.Params)
+		if err != nil {
+			return err
+		}
+		params = string(b)
+	}
+	counterparty := ""
+	if !r.Counterparty.IsZero() {
+		counterparty = r.Counterparty.String()
+	}
+	return w.csv.Write([]string{
+		r.Hash.String(),
+		r.Type.String(),
+		time.Unix(r.Timestamp, 0).UTC().Format(time.RFC3339),
+		r.Token.String(),
+		r.TokenName,
+		r.Direction,
+		r.Amount.String(),
+		r.Value,
+		counterparty,
+		r.Method,
+		params,
+	})
+}
+
+func (w *exportWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
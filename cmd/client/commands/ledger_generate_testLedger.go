@@ -12,7 +12,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -109,7 +108,7 @@ func generateLedger(fromAccountP string, repCountsP int, toAccountsP []string) e
 }
 
 func sendReceiveAndChangeAction(repCountsP int, from *types.Account, toAccountsP []string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
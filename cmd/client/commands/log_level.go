@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+// log level of the node can be changed at runtime, it is reset by the config after the node restarts
+func addLogCmd() {
+	if interactive {
+		cmd := &ishell.Cmd{
+			Name: "log",
+			Help: "log level commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(cmd)
+
+		addLogLevelsCmdByShell(cmd)
+		addLogSetLevelCmdByShell(cmd)
+	} else {
+		var cmd = &cobra.Command{
+			Use:   "log",
+			Short: "log level commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(cmd)
+
+		addLogLevelsCmdByCobra(cmd)
+		addLogSetLevelCmdByCobra(cmd)
+	}
+}
+
+func addLogLevelsCmdByShell(parentCmd *ishell.Cmd) {
+	c := &ishell.Cmd{
+		Name: "levels",
+		Help: "get log level of each module",
+		Func: func(c *ishell.Context) {
+			args := []util.Flag{}
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := logLevelsAction(); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addLogLevelsCmdByCobra(parentCmd *cobra.Command) {
+	var c = &cobra.Command{
+		Use:   "levels",
+		Short: "get log level of each module",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := logLevelsAction(); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	parentCmd.AddCommand(c)
+}
+
+func addLogSetLevelCmdByShell(parentCmd *ishell.Cmd) {
+	module := util.Flag{
+		Name:  "module",
+		Must:  false,
+		Usage: "module name, e.g. dpos, the global level is changed if it is empty",
+		Value: "",
+	}
+	level := util.Flag{
+		Name:  "level",
+		Must:  false,
+		Usage: "log level(debug/info/warn/error), the module uses the global level if it is empty",
+		Value: "",
+	}
+	args := []util.Flag{module, level}
+	c := &ishell.Cmd{
+		Name:                "setLevel",
+		Help:                "change log level of module",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			moduleP := util.StringVar(c.Args, module)
+			levelP := util.StringVar(c.Args, level)
+			if err := logSetLevelAction(moduleP, levelP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addLogSetLevelCmdByCobra(parentCmd *cobra.Command) {
+	var moduleP string
+	var levelP string
+	var c = &cobra.Command{
+		Use:   "setLevel",
+		Short: "change log level of module",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := logSetLevelAction(moduleP, levelP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&moduleP, "module", "", "module name, e.g. dpos, the global level is changed if it is empty")
+	c.Flags().StringVar(&levelP, "level", "", "log level(debug/info/warn/error), the module uses the global level if it is empty")
+	parentCmd.AddCommand(c)
+}
+
+func logLevelsAction() error {
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var r api.APILogLevels
+	if err := client.Call(&r, "debug_getLogLevels"); err != nil {
+		return err
+	}
+	modules := make([]string, 0, len(r.Modules))
+	for m := range r.Modules {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+
+	txPrint(fmt.Sprintf("global: %s", r.Level))
+	for _, m := range modules {
+		txPrint(fmt.Sprintf("%s: %s", m, r.Modules[m]))
+	}
+	return nil
+}
+
+func logSetLevelAction(moduleP, levelP string) error {
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Call(nil, "debug_setLogLevel", moduleP, levelP); err != nil {
+		return err
+	}
+	if moduleP == "" {
+		moduleP = "global"
+	}
+	if levelP == "" {
+		levelP = "global level"
+	}
+	txPrint(fmt.Sprintf("log level of %s is changed to %s", moduleP, levelP))
+	return nil
+}
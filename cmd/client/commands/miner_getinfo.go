@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -52,7 +51,7 @@ func minerRewardInfoAction(addressP string) error {
 	}
 	minerAddr, _ := types.HexToAddress(addressP)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -119,7 +118,7 @@ func minerRewardAction(cbPriKeyP string, bnfPriKeyP string, bnfAddrHexP string)
 		}
 	}
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
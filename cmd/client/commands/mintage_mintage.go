@@ -12,7 +12,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -117,7 +116,7 @@ func mintageAction(account, preHash, tokenName, tokenSymbol, totalSupply string,
 	}
 	a := types.NewAccount(bytes)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
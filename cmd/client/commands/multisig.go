@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+// a block of a multi-signature account is built by tx build with the multisig address, proposed to the node,
+// co-signed offline by the owners and broadcast once enough owners signed it
+func addMultiSigCmd() {
+	if interactive {
+		cmd := &ishell.Cmd{
+			Name: "multisig",
+			Help: "multi-signature account commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(cmd)
+
+		addMultiSigAddressCmdByShell(cmd)
+		addMultiSigProposeCmdByShell(cmd)
+		addMultiSigSignCmdByShell(cmd)
+		addMultiSigListCmdByShell(cmd)
+		addMultiSigBroadcastCmdByShell(cmd)
+	} else {
+		var cmd = &cobra.Command{
+			Use:   "multisig",
+			Short: "multi-signature account commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(cmd)
+
+		addMultiSigAddressCmdByCobra(cmd)
+		addMultiSigProposeCmdByCobra(cmd)
+		addMultiSigSignCmdByCobra(cmd)
+		addMultiSigListCmdByCobra(cmd)
+		addMultiSigBroadcastCmdByCobra(cmd)
+	}
+}
+
+func parseMultiSigOwners(ownersP string) ([]types.Address, error) {
+	owners := make([]types.Address, 0)
+	for _, s := range strings.Split(ownersP, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		addr, err := types.HexToAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, addr)
+	}
+	return owners, nil
+}
+
+func printMultiSigProposal(p *api.APIMultiSigProposal) {
+	txPrint(fmt.Sprintf("proposal %s of %s, signed %d/%d, completed: %t", p.Hash, p.Block.Address, p.Signed, p.Threshold, p.Completed))
+}
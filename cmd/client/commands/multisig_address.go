@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func addMultiSigAddressCmdByShell(parentCmd *ishell.Cmd) {
+	owners := util.Flag{
+		Name:  "owners",
+		Must:  true,
+		Usage: "owner addresses, separated by comma",
+		Value: "",
+	}
+	threshold := util.Flag{
+		Name:  "threshold",
+		Must:  true,
+		Usage: "count of owners required to sign a block",
+		Value: 0,
+	}
+	args := []util.Flag{owners, threshold}
+	c := &ishell.Cmd{
+		Name:                "address",
+		Help:                "address of multi-signature account",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			ownersP := util.StringVar(c.Args, owners)
+			thresholdP, err := util.IntVar(c.Args, threshold)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+			if err := multiSigAddressAction(ownersP, thresholdP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addMultiSigAddressCmdByCobra(parentCmd *cobra.Command) {
+	var ownersP string
+	var thresholdP int
+	var c = &cobra.Command{
+		Use:   "address",
+		Short: "address of multi-signature account",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := multiSigAddressAction(ownersP, thresholdP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&ownersP, "owners", "", "owner addresses, separated by comma")
+	c.Flags().IntVar(&thresholdP, "threshold", 0, "count of owners required to sign a block")
+	parentCmd.AddCommand(c)
+}
+
+func multiSigAddressAction(ownersP string, thresholdP int) error {
+	owners, err := parseMultiSigOwners(ownersP)
+	if err != nil {
+		return err
+	}
+	if thresholdP <= 0 || thresholdP > types.MaxMultiSigOwners {
+		return types.ErrMultiSigThreshold
+	}
+	// the address is derived locally, no need to connect the node
+	addr, err := types.MultiSigAddress(uint8(thresholdP), owners)
+	if err != nil {
+		return err
+	}
+	txPrint(fmt.Sprintf("multisig address: %s", addr))
+	return nil
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+func addMultiSigProposeCmdByShell(parentCmd *ishell.Cmd) {
+	in := util.Flag{
+		Name:  "in",
+		Must:  true,
+		Usage: "unsigned block file of the multisig address, built by tx build",
+		Value: "",
+	}
+	owners := util.Flag{
+		Name:  "owners",
+		Must:  true,
+		Usage: "owner addresses, separated by comma",
+		Value: "",
+	}
+	threshold := util.Flag{
+		Name:  "threshold",
+		Must:  true,
+		Usage: "count of owners required to sign a block",
+		Value: 0,
+	}
+	args := []util.Flag{in, owners, threshold}
+	c := &ishell.Cmd{
+		Name:                "propose",
+		Help:                "propose block of multi-signature account for owners to sign",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			inP := util.StringVar(c.Args, in)
+			ownersP := util.StringVar(c.Args, owners)
+			thresholdP, err := util.IntVar(c.Args, threshold)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+			if err := multiSigProposeAction(inP, ownersP, thresholdP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addMultiSigProposeCmdByCobra(parentCmd *cobra.Command) {
+	var inP string
+	var ownersP string
+	var thresholdP int
+	var c = &cobra.Command{
+		Use:   "propose",
+		Short: "propose block of multi-signature account for owners to sign",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := multiSigProposeAction(inP, ownersP, thresholdP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&inP, "in", "i", "", "unsigned block file of the multisig address, built by tx build")
+	c.Flags().StringVar(&ownersP, "owners", "", "owner addresses, separated by comma")
+	c.Flags().IntVar(&thresholdP, "threshold", 0, "count of owners required to sign a block")
+	parentCmd.AddCommand(c)
+}
+
+func addMultiSigSignCmdByShell(parentCmd *ishell.Cmd) {
+	hash := util.Flag{
+		Name:  "hash",
+		Must:  true,
+		Usage: "block hash of the proposal",
+		Value: "",
+	}
+	ks := util.Flag{
+		Name:  "keystore",
+		Must:  true,
+		Usage: "account or wallet keystore file of the owner",
+		Value: "",
+	}
+	pwd := util.Flag{
+		Name:  "password",
+		Must:  false,
+		Usage: "password of the keystore",
+		Value: "",
+	}
+	args := []util.Flag{hash, ks, pwd}
+	c := &ishell.Cmd{
+		Name:                "sign",
+		Help:                "sign proposal by owner keystore, the private key is not sent to the node",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			hashP := util.StringVar(c.Args, hash)
+			ksP := util.StringVar(c.Args, ks)
+			pwdP := util.StringVar(c.Args, pwd)
+			if err := multiSigSignAction(hashP, ksP, pwdP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addMultiSigSignCmdByCobra(parentCmd *cobra.Command) {
+	var hashP string
+	var ksP string
+	var pwdP string
+	var c = &cobra.Command{
+		Use:   "sign",
+		Short: "sign proposal by owner keystore, the private key is not sent to the node",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := multiSigSignAction(hashP, ksP, pwdP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&hashP, "hash", "", "block hash of the proposal")
+	c.Flags().StringVarP(&ksP, "keystore", "s", "", "account or wallet keystore file of the owner")
+	c.Flags().StringVarP(&pwdP, "password", "p", "", "password of the keystore")
+	parentCmd.AddCommand(c)
+}
+
+func addMultiSigListCmdByShell(parentCmd *ishell.Cmd) {
+	owner := util.Flag{
+		Name:  "owner",
+		Must:  false,
+		Usage: "only list proposals the owner can sign",
+		Value: "",
+	}
+	args := []util.Flag{owner}
+	c := &ishell.Cmd{
+		Name:                "list",
+		Help:                "list proposals of multi-signature accounts",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			ownerP := util.StringVar(c.Args, owner)
+			if err := multiSigListAction(ownerP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addMultiSigListCmdByCobra(parentCmd *cobra.Command) {
+	var ownerP string
+	var c = &cobra.Command{
+		Use:   "list",
+		Short: "list proposals of multi-signature accounts",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := multiSigListAction(ownerP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&ownerP, "owner", "", "only list proposals the owner can sign")
+	parentCmd.AddCommand(c)
+}
+
+func addMultiSigBroadcastCmdByShell(parentCmd *ishell.Cmd) {
+	hash := util.Flag{
+		Name:  "hash",
+		Must:  true,
+		Usage: "block hash of the proposal",
+		Value: "",
+	}
+	args := []util.Flag{hash}
+	c := &ishell.Cmd{
+		Name:                "broadcast",
+		Help:                "process proposal signed by enough owners",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			hashP := util.StringVar(c.Args, hash)
+			if err := multiSigBroadcastAction(hashP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addMultiSigBroadcastCmdByCobra(parentCmd *cobra.Command) {
+	var hashP string
+	var c = &cobra.Command{
+		Use:   "broadcast",
+		Short: "process proposal signed by enough owners",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := multiSigBroadcastAction(hashP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&hashP, "hash", "", "block hash of the proposal")
+	parentCmd.AddCommand(c)
+}
+
+func multiSigProposeAction(inP, ownersP string, thresholdP int) error {
+	blk, err := readBlockFile(inP)
+	if err != nil {
+		return err
+	}
+	owners, err := parseMultiSigOwners(ownersP)
+	if err != nil {
+		return err
+	}
+	if thresholdP <= 0 || thresholdP > types.MaxMultiSigOwners {
+		return types.ErrMultiSigThreshold
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var p api.APIMultiSigProposal
+	if err := client.Call(&p, "ledger_proposeMultiSig", blk, thresholdP, owners); err != nil {
+		return err
+	}
+	printMultiSigProposal(&p)
+	return nil
+}
+
+func multiSigSignAction(hashP, ksP, pwdP string) error {
+	hash, err := types.NewHash(hashP)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(ksP)
+	if err != nil {
+		return err
+	}
+	ks, err := keystore.Parse(content)
+	if err != nil {
+		return err
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var p api.APIMultiSigProposal
+	if err := client.Call(&p, "ledger_multiSigProposal", hash); err != nil {
+		return err
+	}
+	if p.Block == nil || p.Block.MultiSig == nil {
+		return fmt.Errorf("invalid proposal %s", hash)
+	}
+	// the hash is checked locally, so the owner never signs a block other than the one shown
+	if p.Block.GetHash() != hash {
+		return fmt.Errorf("proposal block hash mismatch, expect %s", hash)
+	}
+	for _, owner := range p.Block.MultiSig.Owners {
+		account, err := ks.FindAccount(pwdP, owner, walletSearchLimit)
+		if err != nil {
+			continue
+		}
+		sign := account.Sign(hash)
+		if err := client.Call(&p, "ledger_signMultiSig", hash, owner, sign); err != nil {
+			return err
+		}
+		printMultiSigProposal(&p)
+		return nil
+	}
+	return errors.New("keystore does not contain any owner of the proposal")
+}
+
+func multiSigListAction(ownerP string) error {
+	var owner *types.Address
+	if ownerP != "" {
+		addr, err := types.HexToAddress(ownerP)
+		if err != nil {
+			return err
+		}
+		owner = &addr
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var ps []*api.APIMultiSigProposal
+	if err := client.Call(&ps, "ledger_multiSigProposals", owner); err != nil {
+		return err
+	}
+	if len(ps) == 0 {
+		txPrint("no proposal")
+		return nil
+	}
+	for _, p := range ps {
+		printMultiSigProposal(p)
+	}
+	return nil
+}
+
+func multiSigBroadcastAction(hashP string) error {
+	hash, err := types.NewHash(hashP)
+	if err != nil {
+		return err
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var h types.Hash
+	if err := client.Call(&h, "ledger_processMultiSig", hash); err != nil {
+		return err
+	}
+	txPrint(fmt.Sprintf("broadcast multisig block %s success", h))
+	return nil
+}
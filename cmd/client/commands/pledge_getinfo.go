@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -53,7 +52,7 @@ func addPledgeGetInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPledgeGetInfoCmd(pldAddr, bnfAddr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -126,7 +125,7 @@ func pledgeAction(beneficialAccount, beneficialAddressP, pledgeAccount, amount,
 		return errors.New("beneficial account or address is empty")
 	}
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
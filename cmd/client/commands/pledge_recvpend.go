@@ -14,7 +14,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -72,7 +71,7 @@ func pledgeRecvPendAction(priKeyP, hashP, method string) error {
 	}
 	account := types.NewAccount(pBytes)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -14,7 +14,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -116,7 +115,7 @@ func withdrawPledgeAction(beneficialAccount, pledgeAccount, amount, pType, NEP5T
 	}
 	b := types.NewAccount(bBytes)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -61,7 +60,7 @@ func addPovBlockInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovBlockInfoCmd(height int, hash string, txOffset, txCount int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -182,7 +181,7 @@ func addPovBlockListCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovBlockListCmd(height, count int, asc bool) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
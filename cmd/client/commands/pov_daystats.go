@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -52,7 +51,7 @@ func addPovDiffDayStatCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovDiffDayStatCmd(day int, height int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -121,7 +120,7 @@ func addPovMinerDayStatCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovMinerDayStatCmd(day int, height int, filter string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -51,7 +50,7 @@ func addPovLastNHourInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovLastNHourInfoCmd(endHeight int, hourSpan int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"sort"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -45,7 +44,7 @@ func addPovMinerInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovMinerInfoCmd(minerAddrStrList []string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,6 @@ import (
 	"sort"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -60,7 +59,7 @@ func addPovRepStateListCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovRepStateListCmd(hash string, height int, sortType string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
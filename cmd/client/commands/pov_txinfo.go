@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	cutil "github.com/qlcchain/go-qlc/common/util"
@@ -45,7 +44,7 @@ func addPovTxInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPovTxInfoCmd(txHashStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -77,7 +76,7 @@ func addPrivacyDemoKVSetCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPrivacySetDemoKVCmd(priKeyStr, keyStr, valStr, priFromStr, priForStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -200,7 +199,7 @@ func addPrivacyDemoKVGetCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPrivacyGetDemoKVCmd(keyStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
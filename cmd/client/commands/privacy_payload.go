@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/rpc/api"
@@ -68,7 +67,7 @@ func runPrivacyDistributePayloadCmd(dataStr, priFromStr, priForStr string) error
 		return errors.New("privateFor is nil")
 	}
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -153,7 +152,7 @@ func addPrivacyGetPayloadCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runPrivacyGetPayloadCmd(keyStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -194,7 +193,7 @@ func runPrivacyGetPayloadCmd(keyStr string) error {
 }
 
 func runPrivacyGetPayloadByHashCmd(hashStr string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	_ "github.com/qlcchain/go-qlc/common"
@@ -56,7 +55,7 @@ func addPtmKeyGetPubkeyCmdByShell(parentCmd *ishell.Cmd) {
 func ptmKeyGetPubkeyAction(addressP, btypeP string) error {
 	fmt.Println(addressP, btypeP)
 
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -25,6 +25,7 @@ func addRepCmd() {
 
 		addRepRewardCmdByShell(cmd)
 		addRepRewardRecvpendCmdByShell(cmd)
+		addRepPerformanceCmdByShell(cmd)
 	} else {
 		var cmd = &cobra.Command{
 			Use:   "rep",
@@ -36,5 +37,6 @@ func addRepCmd() {
 
 		addRepRewardCmdByCobra(cmd)
 		addRepRewardRecvpendByCobra(cmd)
+		addRepPerformanceCmdByCobra(cmd)
 	}
 }
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+func addRepPerformanceCmdByShell(parentCmd *ishell.Cmd) {
+	startFlag := util.Flag{
+		Name:  "start",
+		Must:  false,
+		Usage: "start height of pov block",
+		Value: 0,
+	}
+	endFlag := util.Flag{
+		Name:  "end",
+		Must:  false,
+		Usage: "end height of pov block, 0 means latest",
+		Value: 0,
+	}
+	args := []util.Flag{startFlag, endFlag}
+	cmd := &ishell.Cmd{
+		Name:                "performance",
+		Help:                "representative uptime, vote participation, vote latency and stake share",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+
+			start, err := util.IntVar(c.Args, startFlag)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+			end, err := util.IntVar(c.Args, endFlag)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+
+			if err := runRepPerformanceCmd(start, end); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(cmd)
+}
+
+func addRepPerformanceCmdByCobra(parentCmd *cobra.Command) {
+	var start, end int
+	var cmd = &cobra.Command{
+		Use:   "performance",
+		Short: "representative uptime, vote participation, vote latency and stake share",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := runRepPerformanceCmd(start, end)
+			if err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	cmd.Flags().IntVar(&start, "start", 0, "start height of pov block")
+	cmd.Flags().IntVar(&end, "end", 0, "end height of pov block, 0 means latest")
+	parentCmd.AddCommand(cmd)
+}
+
+func runRepPerformanceCmd(start, end int) error {
+	if start < 0 || end < 0 {
+		return errors.New("invalid height value")
+	}
+
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	rspInfo := new(api.RepPerformanceInfo)
+	err = client.Call(rspInfo, "rep_performance", start, end)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("StartHeight: %d, EndHeight: %d\n", rspInfo.StartHeight, rspInfo.EndHeight)
+	fmt.Printf("ConfirmedBlocks: %d, ExpectedHearts: %d\n", rspInfo.BlockCount, rspInfo.ExpectedHearts)
+
+	fmt.Printf("%-5s %-64s %-8s %-8s %-8s %-10s %-8s %-8s\n", "Rank", "Address", "Stake%", "Uptime%", "Vote%", "Latency", "Votes", "Missed")
+	for _, rp := range rspInfo.Reps {
+		fmt.Printf("%-5d %-64s %-8.2f %-8.2f %-8.2f %-10s %-8d %-8d\n",
+			rp.Rank,
+			rp.Account,
+			rp.StakeShare,
+			rp.Uptime,
+			rp.Participation,
+			fmt.Sprintf("%dms", rp.AvgVoteLatency),
+			rp.VoteCount,
+			rp.MissedBlocks)
+	}
+
+	return nil
+}
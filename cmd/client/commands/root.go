@@ -56,6 +56,9 @@ func Execute(osArgs []string) {
 			},
 		}
 		rootCmd.PersistentFlags().StringVarP(&endpointP, "endpoint", "e", endpointP, "endpoint for client")
+		rootCmd.PersistentFlags().StringVar(&caCertP, "cacert", "", "CA certificate to verify the https/wss endpoint")
+		rootCmd.PersistentFlags().StringVar(&clientCertP, "cert", "", "client certificate for mutual TLS")
+		rootCmd.PersistentFlags().StringVar(&clientKeyP, "key", "", "client key for mutual TLS")
 		addcommands()
 		if err := rootCmd.Execute(); err != nil {
 			fmt.Println(err)
@@ -65,6 +68,7 @@ func Execute(osArgs []string) {
 }
 
 func isInteractive(osArgs []string) bool {
+	tlsArgs(osArgs)
 	if len(osArgs) > 1 && osArgs[1] == "-i" {
 		if len(osArgs) > 3 && osArgs[2] == "--endpoint" {
 			endpointP = osArgs[3]
@@ -77,3 +81,17 @@ func isInteractive(osArgs []string) bool {
 	}
 	return false
 }
+
+// tlsArgs sets TLS options of the interactive mode, which does not parse flags
+func tlsArgs(osArgs []string) {
+	for i := 1; i < len(osArgs)-1; i++ {
+		switch osArgs[i] {
+		case "--cacert":
+			caCertP = osArgs[i+1]
+		case "--cert":
+			clientCertP = osArgs[i+1]
+		case "--key":
+			clientKeyP = osArgs[i+1]
+		}
+	}
+}
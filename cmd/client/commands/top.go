@@ -0,0 +1,311 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+const (
+	topMaxFeedBlocks   = 1000
+	topAccountBlocks   = 50
+	topRefreshInterval = 3 * time.Second
+)
+
+// top is a full-screen dashboard of the node, it owns the terminal, so it is only added to the cobra commands
+func addTopCmd() {
+	if interactive {
+		return
+	}
+	var c = &cobra.Command{
+		Use:   "top",
+		Short: "live dashboard of pov tip, consensus, pending and unchecked blocks, peers and confirmed blocks",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := topAction(); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	rootCmd.AddCommand(c)
+}
+
+func topAction() error {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return errors.New("top must be run in a terminal")
+	}
+
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	screen := newTopScreen(os.Stdout)
+	screen.open()
+	defer func() {
+		screen.close()
+		_ = terminal.Restore(fd, state)
+	}()
+
+	t := newTopModel(endpointP)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blockCh := make(chan *api.APIBlock, 100)
+	sub, err := client.Subscribe(ctx, "ledger", blockCh, "newBlock")
+	var subErr <-chan error
+	if err != nil {
+		t.err = fmt.Errorf("subscribe blocks: %s", err)
+	} else {
+		defer sub.Unsubscribe()
+		subErr = sub.Err()
+	}
+
+	keys := make(chan topKey, 10)
+	go readTopKeys(os.Stdin, keys)
+
+	// the status is fetched in background, and it is skipped if the last fetch is not finished
+	statusCh := make(chan *topStatus, 1)
+	refreshing := false
+	refresh := func() {
+		if refreshing {
+			return
+		}
+		refreshing = true
+		go func() {
+			statusCh <- fetchTopStatus(client)
+		}()
+	}
+	refresh()
+	refreshTicker := time.NewTicker(topRefreshInterval)
+	defer refreshTicker.Stop()
+	renderTicker := time.NewTicker(time.Second)
+	defer renderTicker.Stop()
+
+	for {
+		w, h, err := terminal.GetSize(fd)
+		if err != nil {
+			w, h = 80, 24
+		}
+		screen.draw(t.render(w, h))
+
+		select {
+		case k := <-keys:
+			if k == topKeyQuit {
+				return nil
+			}
+			t.onKey(k, h, client)
+		case blk := <-blockCh:
+			t.addBlock(blk)
+		case err := <-subErr:
+			if err != nil {
+				t.err = fmt.Errorf("block subscription: %s", err)
+			}
+			subErr = nil
+		case s := <-statusCh:
+			t.status = s
+			refreshing = false
+		case <-refreshTicker.C:
+			refresh()
+		case <-renderTicker.C:
+		}
+	}
+}
+
+type topStatus struct {
+	povHeader *api.PovApiHeader
+	tps       uint32
+	pending   int
+	unchecked int
+	peers     map[string]uint64
+	err       error
+}
+
+func fetchTopStatus(client *rpcClient) *topStatus {
+	s := new(topStatus)
+	setErr := func(method string, err error) {
+		if err != nil && s.err == nil {
+			s.err = fmt.Errorf("%s: %s", method, err)
+		}
+	}
+
+	s.povHeader = new(api.PovApiHeader)
+	if err := client.Call(s.povHeader, "pov_getLatestHeader"); err != nil {
+		s.povHeader = nil
+		setErr("pov_getLatestHeader", err)
+	}
+
+	// tps of dpos is the average of confirmed blocks per second, the first item is still being counted
+	var consInfo struct {
+		Tps []uint32 `json:"tps"`
+	}
+	if err := client.Call(&consInfo, "debug_getConsInfo"); err != nil {
+		setErr("debug_getConsInfo", err)
+	} else if len(consInfo.Tps) > 1 {
+		s.tps = consInfo.Tps[1]
+	}
+
+	setErr("debug_pendingsCount", client.Call(&s.pending, "debug_pendingsCount"))
+
+	unchecks := make(map[string]int)
+	setErr("debug_uncheckBlocksCount", client.Call(&unchecks, "debug_uncheckBlocksCount"))
+	s.unchecked = unchecks["Total"]
+
+	setErr("net_peersCount", client.Call(&s.peers, "net_peersCount"))
+	return s
+}
+
+// topPage is a screen of the dashboard, it shows the text lines followed by the selectable blocks
+type topPage struct {
+	title    string
+	lines    []string
+	blocks   []*api.APIBlock
+	selected int
+	offset   int
+}
+
+func (p *topPage) size() int {
+	return len(p.lines) + len(p.blocks)
+}
+
+func (p *topPage) selectedBlock() *api.APIBlock {
+	i := p.selected - len(p.lines)
+	if i >= 0 && i < len(p.blocks) {
+		return p.blocks[i]
+	}
+	return nil
+}
+
+type topModel struct {
+	endpoint string
+	status   *topStatus
+	err      error
+	// the first page is the feed of confirmed blocks, the pages drilled into are pushed after it
+	pages []*topPage
+}
+
+func newTopModel(endpoint string) *topModel {
+	return &topModel{
+		endpoint: endpoint,
+		pages:    []*topPage{{title: "confirmed blocks"}},
+	}
+}
+
+func (t *topModel) page() *topPage {
+	return t.pages[len(t.pages)-1]
+}
+
+func (t *topModel) addBlock(blk *api.APIBlock) {
+	feed := t.pages[0]
+	feed.blocks = append([]*api.APIBlock{blk}, feed.blocks...)
+	if len(feed.blocks) > topMaxFeedBlocks {
+		feed.blocks = feed.blocks[:topMaxFeedBlocks]
+	}
+	// keep the selected block if the feed is scrolled
+	if feed.selected > 0 {
+		feed.selected++
+		feed.offset++
+	}
+	if feed.selected >= feed.size() {
+		feed.selected = feed.size() - 1
+	}
+}
+
+func (t *topModel) onKey(k topKey, height int, client *rpcClient) {
+	p := t.page()
+	switch k {
+	case topKeyUp:
+		p.selected--
+	case topKeyDown:
+		p.selected++
+	case topKeyPageUp:
+		p.selected -= topBodyHeight(height)
+	case topKeyPageDown:
+		p.selected += topBodyHeight(height)
+	case topKeyBack:
+		if len(t.pages) > 1 {
+			t.pages = t.pages[:len(t.pages)-1]
+		}
+		t.err = nil
+	case topKeyEnter:
+		if blk := p.selectedBlock(); blk != nil {
+			t.push(blockPage(client, blk.Hash))
+		}
+	case topKeyAccount:
+		if blk := p.selectedBlock(); blk != nil {
+			t.push(accountPage(client, blk.Address))
+		}
+	}
+	if p.selected >= p.size() {
+		p.selected = p.size() - 1
+	}
+	if p.selected < 0 {
+		p.selected = 0
+	}
+}
+
+func (t *topModel) push(p *topPage, err error) {
+	if err != nil {
+		t.err = err
+		return
+	}
+	t.err = nil
+	t.pages = append(t.pages, p)
+}
+
+func blockPage(client *rpcClient, hash types.Hash) (*topPage, error) {
+	blk := new(api.APIBlock)
+	if err := client.Call(blk, "ledger_blockInfo", hash); err != nil {
+		return nil, fmt.Errorf("block %s: %s", hash, err)
+	}
+	data, err := json.MarshalIndent(blk, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &topPage{title: fmt.Sprintf("block %s", hash), lines: strings.Split(string(data), "\n")}, nil
+}
+
+func accountPage(client *rpcClient, address types.Address) (*topPage, error) {
+	info := new(api.APIAccount)
+	if err := client.Call(info, "ledger_accountInfo", address); err != nil {
+		return nil, fmt.Errorf("account %s: %s", address, err)
+	}
+	var blocks []*api.APIBlock
+	if err := client.Call(&blocks, "ledger_accountHistoryTopn", address, topAccountBlocks, 0); err != nil {
+		return nil, fmt.Errorf("account %s: %s", address, err)
+	}
+
+	p := &topPage{title: fmt.Sprintf("account %s", address), blocks: blocks}
+	if info.Representative != nil {
+		p.lines = append(p.lines, fmt.Sprintf("representative: %s", info.Representative))
+	}
+	for _, tm := range info.Tokens {
+		p.lines = append(p.lines, fmt.Sprintf("%s: balance %s, pending %s, blocks %d, header %s",
+			tm.TokenName, tm.Balance, tm.Pending, tm.BlockCount, tm.Header))
+	}
+	p.lines = append(p.lines, fmt.Sprintf("latest %d blocks:", len(blocks)))
+	p.selected = len(p.lines)
+	return p, nil
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+type topKey int
+
+const (
+	topKeyUnknown topKey = iota
+	topKeyUp
+	topKeyDown
+	topKeyPageUp
+	topKeyPageDown
+	topKeyEnter
+	topKeyAccount
+	topKeyBack
+	topKeyQuit
+)
+
+// the terminal is in raw mode, so the keys are read byte by byte and escape sequences are parsed here
+func readTopKeys(r io.Reader, keys chan<- topKey) {
+	buf := make([]byte, 16)
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			keys <- topKeyQuit
+			return
+		}
+		if k := parseTopKey(buf[:n]); k != topKeyUnknown {
+			keys <- k
+		}
+	}
+}
+
+func parseTopKey(b []byte) topKey {
+	switch string(b) {
+	case "\x1b[A", "k":
+		return topKeyUp
+	case "\x1b[B", "j":
+		return topKeyDown
+	case "\x1b[5~":
+		return topKeyPageUp
+	case "\x1b[6~", " ":
+		return topKeyPageDown
+	case "\r", "\n":
+		return topKeyEnter
+	case "a":
+		return topKeyAccount
+	case "\x1b", "b", "\x7f":
+		return topKeyBack
+	case "q", "\x03", "\x04":
+		return topKeyQuit
+	}
+	return topKeyUnknown
+}
+
+type topScreen struct {
+	w *bufio.Writer
+}
+
+func newTopScreen(w io.Writer) *topScreen {
+	return &topScreen{w: bufio.NewWriter(w)}
+}
+
+// open switches to the alternate screen and hides the cursor
+func (s *topScreen) open() {
+	_, _ = s.w.WriteString("\x1b[?1049h\x1b[?25l")
+	_ = s.w.Flush()
+}
+
+func (s *topScreen) close() {
+	_, _ = s.w.WriteString("\x1b[?25h\x1b[?1049l")
+	_ = s.w.Flush()
+}
+
+// draw overwrites the screen from the top left, lines are ended by \r\n since the terminal is in raw mode
+func (s *topScreen) draw(lines []string) {
+	_, _ = s.w.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i > 0 {
+			_, _ = s.w.WriteString("\r\n")
+		}
+		_, _ = s.w.WriteString(line)
+		_, _ = s.w.WriteString("\x1b[K")
+	}
+	_, _ = s.w.WriteString("\x1b[J")
+	_ = s.w.Flush()
+}
+
+const (
+	topHeaderLines = 4
+	topFooterLines = 1
+)
+
+func topBodyHeight(height int) int {
+	if h := height - topHeaderLines - topFooterLines; h > 0 {
+		return h
+	}
+	return 1
+}
+
+// render returns the lines of screen, they are cut by the width and the body is scrolled to the selected line
+func (t *topModel) render(width, height int) []string {
+	lines := make([]string, 0, height)
+	lines = append(lines, fmt.Sprintf("gqlcclient top - %s    %s", t.endpoint, time.Now().Format("2006-01-02 15:04:05")))
+	lines = append(lines, t.renderStatus())
+	if t.err != nil {
+		lines = append(lines, "error: "+t.err.Error())
+	} else if t.status != nil && t.status.err != nil {
+		lines = append(lines, "error: "+t.status.err.Error())
+	} else {
+		lines = append(lines, strings.Repeat("-", width))
+	}
+
+	p := t.page()
+	lines = append(lines, fmt.Sprintf("[%s] %d/%d", p.title, p.selected+1, p.size()))
+
+	body := topBodyHeight(height)
+	if p.selected < p.offset {
+		p.offset = p.selected
+	}
+	if p.selected >= p.offset+body {
+		p.offset = p.selected - body + 1
+	}
+	for i := p.offset; i < p.offset+body; i++ {
+		line := ""
+		if i < len(p.lines) {
+			line = p.lines[i]
+		} else if j := i - len(p.lines); j < len(p.blocks) {
+			line = formatTopBlock(p.blocks[j])
+		}
+		// lines only pages are scrolled without cursor
+		if i == p.selected && len(p.blocks) > 0 {
+			line = "\x1b[7m" + cutTopLine(line, width) + "\x1b[0m"
+			lines = append(lines, line)
+			continue
+		}
+		lines = append(lines, cutTopLine(line, width))
+	}
+
+	lines = append(lines, cutTopLine("up/down/pgup/pgdn: move  enter: block  a: account chain  esc: back  q: quit", width))
+	return lines
+}
+
+func (t *topModel) renderStatus() string {
+	s := t.status
+	if s == nil {
+		return "loading..."
+	}
+	pov := "pov: -"
+	if s.povHeader != nil && s.povHeader.PovHeader != nil {
+		hash := s.povHeader.GetHash()
+		pov = fmt.Sprintf("pov: %d %s %s", s.povHeader.GetHeight(), hash.String()[:16], s.povHeader.AlgoName)
+	}
+	return fmt.Sprintf("%s | dpos: %d blocks/s | pending: %d | unchecked: %d | peers: %d connect, %d online",
+		pov, s.tps, s.pending, s.unchecked, s.peers["connect"], s.peers["online"])
+}
+
+func formatTopBlock(blk *api.APIBlock) string {
+	if blk == nil || blk.StateBlock == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s  %-16s %s  %s  %s %s",
+		time.Unix(blk.Timestamp, 0).Format("15:04:05"), blk.Type, blk.Hash.String()[:16],
+		blk.Address, blk.Amount, blk.TokenName)
+}
+
+func cutTopLine(line string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	r := []rune(line)
+	if len(r) > width {
+		return string(r[:width])
+	}
+	return line
+}
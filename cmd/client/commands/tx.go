@@ -29,6 +29,9 @@ func addTxCmd() {
 		addTxRollbackCmdByShell(txCmd)
 		addTxBatchSendByShell(txCmd)
 		addSendToCreateByShell(txCmd)
+		addTxBuildCmdByShell(txCmd)
+		addTxSignCmdByShell(txCmd)
+		addTxBroadcastCmdByShell(txCmd)
 	} else {
 		var txCmd = &cobra.Command{
 			Use:   "tx",
@@ -45,6 +48,9 @@ func addTxCmd() {
 		addTxRollbackCmdByCobra(txCmd)
 		addTxBatchSendByCobra(txCmd)
 		addSendToCreateByCobra(txCmd)
+		addTxBuildCmdByCobra(txCmd)
+		addTxSignCmdByCobra(txCmd)
+		addTxBroadcastCmdByCobra(txCmd)
 	}
 }
 
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -54,7 +53,7 @@ func addTxBlockInfoCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runTxBlockInfoCmd(hashStrList []string, status int) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -133,7 +132,7 @@ func addTxBlockListCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func runTxBlockListCmd(address string, offset, limit int, show string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
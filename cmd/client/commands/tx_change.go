@@ -13,7 +13,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -102,7 +101,7 @@ func changeAction(accountP string, repP string) error {
 }
 
 func sendChangeTx(account *types.Account, repAddr types.Address) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,411 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+// accounts of a wallet keystore searched for the signer of the block
+const walletSearchLimit = 1000
+
+// the offline workflow: tx build writes the unsigned block without work to a file on an online machine,
+// tx sign generates the work and signs the file by the keystore on an air-gapped machine, and tx broadcast submits the signed
+// block by ledger_process, so the private key never touches the node
+
+func addTxBuildCmdByShell(parentCmd *ishell.Cmd) {
+	typ := util.Flag{
+		Name:  "type",
+		Must:  true,
+		Usage: "block type, send/receive/change",
+		Value: "",
+	}
+	from := util.Flag{
+		Name:  "from",
+		Must:  false,
+		Usage: "send account or account to change representative",
+		Value: "",
+	}
+	to := util.Flag{
+		Name:  "to",
+		Must:  false,
+		Usage: "receive account of send block",
+		Value: "",
+	}
+	token := util.Flag{
+		Name:  "token",
+		Must:  false,
+		Usage: "token name of send block",
+		Value: "QLC",
+	}
+	amount := util.Flag{
+		Name:  "amount",
+		Must:  false,
+		Usage: "send amount",
+		Value: "",
+	}
+	hash := util.Flag{
+		Name:  "hash",
+		Must:  false,
+		Usage: "send block hash to receive",
+		Value: "",
+	}
+	rep := util.Flag{
+		Name:  "repAddr",
+		Must:  false,
+		Usage: "representative address",
+		Value: "",
+	}
+	out := util.Flag{
+		Name:  "out",
+		Must:  true,
+		Usage: "file to write the unsigned block",
+		Value: "",
+	}
+	args := []util.Flag{typ, from, to, token, amount, hash, rep, out}
+	c := &ishell.Cmd{
+		Name:                "build",
+		Help:                "build unsigned block for offline signing",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			p := txBuildParam{
+				typ:    util.StringVar(c.Args, typ),
+				from:   util.StringVar(c.Args, from),
+				to:     util.StringVar(c.Args, to),
+				token:  util.StringVar(c.Args, token),
+				amount: util.StringVar(c.Args, amount),
+				hash:   util.StringVar(c.Args, hash),
+				rep:    util.StringVar(c.Args, rep),
+				out:    util.StringVar(c.Args, out),
+			}
+			if err := txBuildAction(&p); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxBuildCmdByCobra(parentCmd *cobra.Command) {
+	var p txBuildParam
+	var c = &cobra.Command{
+		Use:   "build",
+		Short: "build unsigned block for offline signing",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txBuildAction(&p); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVar(&p.typ, "type", "", "block type, send/receive/change")
+	c.Flags().StringVarP(&p.from, "from", "f", "", "send account or account to change representative")
+	c.Flags().StringVarP(&p.to, "to", "t", "", "receive account of send block")
+	c.Flags().StringVarP(&p.token, "token", "k", "QLC", "token name of send block")
+	c.Flags().StringVarP(&p.amount, "amount", "m", "", "send amount")
+	c.Flags().StringVar(&p.hash, "hash", "", "send block hash to receive")
+	c.Flags().StringVarP(&p.rep, "repAddr", "r", "", "representative address")
+	c.Flags().StringVarP(&p.out, "out", "o", "", "file to write the unsigned block")
+	parentCmd.AddCommand(c)
+}
+
+func addTxSignCmdByShell(parentCmd *ishell.Cmd) {
+	in := util.Flag{
+		Name:  "in",
+		Must:  true,
+		Usage: "unsigned block file",
+		Value: "",
+	}
+	ks := util.Flag{
+		Name:  "keystore",
+		Must:  true,
+		Usage: "account or wallet keystore file",
+		Value: "",
+	}
+	pwd := util.Flag{
+		Name:  "password",
+		Must:  false,
+		Usage: "password of the keystore",
+		Value: "",
+	}
+	out := util.Flag{
+		Name:  "out",
+		Must:  true,
+		Usage: "file to write the signed block",
+		Value: "",
+	}
+	args := []util.Flag{in, ks, pwd, out}
+	c := &ishell.Cmd{
+		Name:                "sign",
+		Help:                "sign block file by keystore, it does not connect the node",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			inP := util.StringVar(c.Args, in)
+			ksP := util.StringVar(c.Args, ks)
+			pwdP := util.StringVar(c.Args, pwd)
+			outP := util.StringVar(c.Args, out)
+			if err := txSignAction(inP, ksP, pwdP, outP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxSignCmdByCobra(parentCmd *cobra.Command) {
+	var inP string
+	var ksP string
+	var pwdP string
+	var outP string
+	var c = &cobra.Command{
+		Use:   "sign",
+		Short: "sign block file by keystore, it does not connect the node",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txSignAction(inP, ksP, pwdP, outP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&inP, "in", "i", "", "unsigned block file")
+	c.Flags().StringVarP(&ksP, "keystore", "s", "", "account or wallet keystore file")
+	c.Flags().StringVarP(&pwdP, "password", "p", "", "password of the keystore")
+	c.Flags().StringVarP(&outP, "out", "o", "", "file to write the signed block")
+	parentCmd.AddCommand(c)
+}
+
+func addTxBroadcastCmdByShell(parentCmd *ishell.Cmd) {
+	in := util.Flag{
+		Name:  "in",
+		Must:  true,
+		Usage: "signed block file",
+		Value: "",
+	}
+	args := []util.Flag{in}
+	c := &ishell.Cmd{
+		Name:                "broadcast",
+		Help:                "submit signed block file",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			inP := util.StringVar(c.Args, in)
+			if err := txBroadcastAction(inP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxBroadcastCmdByCobra(parentCmd *cobra.Command) {
+	var inP string
+	var c = &cobra.Command{
+		Use:   "broadcast",
+		Short: "submit signed block file",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txBroadcastAction(inP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&inP, "in", "i", "", "signed block file")
+	parentCmd.AddCommand(c)
+}
+
+type txBuildParam struct {
+	typ    string
+	from   string
+	to     string
+	token  string
+	amount string
+	hash   string
+	rep    string
+	out    string
+}
+
+func txBuildAction(p *txBuildParam) error {
+	if p.out == "" {
+		return errors.New("invalid output file")
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var blk types.StateBlock
+	switch p.typ {
+	case "send":
+		from, err := types.HexToAddress(p.from)
+		if err != nil {
+			return err
+		}
+		to, err := types.HexToAddress(p.to)
+		if err != nil {
+			return err
+		}
+		if p.amount == "" {
+			return errors.New("invalid amount")
+		}
+		para := api.APISendBlockPara{
+			From:      from,
+			TokenName: p.token,
+			To:        to,
+			Amount:    types.StringToBalance(p.amount),
+		}
+		if err := client.Call(&blk, "ledger_generateUnsignedSendBlock", &para); err != nil {
+			return err
+		}
+	case "receive":
+		hash, err := types.NewHash(p.hash)
+		if err != nil {
+			return err
+		}
+		if err := client.Call(&blk, "ledger_generateUnsignedReceiveBlock", hash); err != nil {
+			return err
+		}
+	case "change":
+		from, err := types.HexToAddress(p.from)
+		if err != nil {
+			return err
+		}
+		rep, err := types.HexToAddress(p.rep)
+		if err != nil {
+			return err
+		}
+		if err := client.Call(&blk, "ledger_generateUnsignedChangeBlock", from, rep); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid block type %s, should be send/receive/change", p.typ)
+	}
+	if err := writeBlockFile(p.out, &blk); err != nil {
+		return err
+	}
+	txPrint(fmt.Sprintf("write unsigned %s block %s of %s to %s", blk.Type, blk.GetHash(), blk.Address, p.out))
+	return nil
+}
+
+func txSignAction(inP, ksP, pwdP, outP string) error {
+	if outP == "" {
+		return errors.New("invalid output file")
+	}
+	blk, err := readBlockFile(inP)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(ksP)
+	if err != nil {
+		return err
+	}
+	ks, err := keystore.Parse(content)
+	if err != nil {
+		return err
+	}
+	account, err := ks.FindAccount(pwdP, blk.Address, walletSearchLimit)
+	if err != nil {
+		return err
+	}
+	if !blk.IsValid() {
+		// the work only depends on the root, the node does not generate it
+		var w types.Work
+		worker, _ := types.NewWorker(w, blk.Root())
+		blk.Work = worker.NewWork()
+	}
+	blk.Signature = account.Sign(blk.GetHash())
+	if err := writeBlockFile(outP, blk); err != nil {
+		return err
+	}
+	txPrint(fmt.Sprintf("write signed %s block %s of %s to %s", blk.Type, blk.GetHash(), blk.Address, outP))
+	return nil
+}
+
+func txBroadcastAction(inP string) error {
+	blk, err := readBlockFile(inP)
+	if err != nil {
+		return err
+	}
+	hash := blk.GetHash()
+	if !blk.Address.Verify(hash[:], blk.Signature[:]) {
+		return fmt.Errorf("block %s is not signed by %s", hash, blk.Address)
+	}
+	if !blk.IsValid() {
+		return fmt.Errorf("invalid work of block %s", hash)
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var h types.Hash
+	if err := client.Call(&h, "ledger_process", blk); err != nil {
+		return err
+	}
+	txPrint(fmt.Sprintf("broadcast %s block %s of %s success", blk.Type, h, blk.Address))
+	return nil
+}
+
+func readBlockFile(file string) (*types.StateBlock, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	blk := new(types.StateBlock)
+	if err := json.Unmarshal(content, blk); err != nil {
+		return nil, fmt.Errorf("invalid block file %s: %s", file, err)
+	}
+	if blk.Address.IsZero() {
+		return nil, fmt.Errorf("invalid block file %s: no address", file)
+	}
+	return blk, nil
+}
+
+func writeBlockFile(file string, blk *types.StateBlock) error {
+	data, err := json.MarshalIndent(blk, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0600)
+}
+
+func txPrint(s string) {
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+}
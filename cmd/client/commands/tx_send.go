@@ -13,7 +13,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -122,7 +121,7 @@ func sendAction(fromP, toP, tokenP, amountP string) error {
 }
 
 func sendTx(account *types.Account, to types.Address, token string, amount types.Balance) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
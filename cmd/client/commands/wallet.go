@@ -25,6 +25,8 @@ func addWalletCmd() {
 
 		addWalletChangePasswordCmdByShell(cmd)
 		addWalletCreateCmdByShell(cmd)
+		addWalletExportCmdByShell(cmd)
+		addWalletImportCmdByShell(cmd)
 		addWalletListCmdByShell(cmd)
 		addWalletRemoveCmdByShell(cmd)
 	} else {
@@ -38,6 +40,8 @@ func addWalletCmd() {
 
 		addWalletChangePasswordCmdByCobra(cmd)
 		addWalletCreateCmdByCobra(cmd)
+		addWalletExportCmdByCobra(cmd)
+		addWalletImportCmdByCobra(cmd)
 		addWalletListCmdByCobra(cmd)
 		addWalletRemoveCmdByCobra(cmd)
 	}
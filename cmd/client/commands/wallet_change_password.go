@@ -11,7 +11,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -89,7 +88,7 @@ func addWalletChangePasswordCmdByCobra(parentCmd *cobra.Command) {
 }
 
 func changePwd(accountP, pwdP, newPwdP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -11,7 +11,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -31,7 +30,31 @@ func addWalletCreateCmdByShell(parentCmd *ishell.Cmd) {
 		Usage: "seed for wallet",
 		Value: "",
 	}
-	args := []util.Flag{pwd, seed}
+	mnemonic := util.Flag{
+		Name:  "mnemonic",
+		Must:  false,
+		Usage: "generate a new mnemonic for wallet",
+		Value: false,
+	}
+	phrase := util.Flag{
+		Name:  "phrase",
+		Must:  false,
+		Usage: "restore wallet from the mnemonic",
+		Value: "",
+	}
+	passphrase := util.Flag{
+		Name:  "passphrase",
+		Must:  false,
+		Usage: "passphrase of the mnemonic",
+		Value: "",
+	}
+	path := util.Flag{
+		Name:  "path",
+		Must:  false,
+		Usage: "derivation path of the mnemonic wallet",
+		Value: "",
+	}
+	args := []util.Flag{pwd, seed, mnemonic, phrase, passphrase, path}
 	c := &ishell.Cmd{
 		Name:                "create",
 		Help:                "create a wallet for QLCChain node",
@@ -46,7 +69,16 @@ func addWalletCreateCmdByShell(parentCmd *ishell.Cmd) {
 			}
 			pwdP := util.StringVar(c.Args, pwd)
 			seedP := util.StringVar(c.Args, seed)
-			err := createWallet(pwdP, seedP)
+			mnemonicP := util.BoolVar(c.Args, mnemonic)
+			phraseP := util.StringVar(c.Args, phrase)
+			passphraseP := util.StringVar(c.Args, passphrase)
+			pathP := util.StringVar(c.Args, path)
+			var err error
+			if mnemonicP || phraseP != "" {
+				err = createMnemonicWallet(pwdP, phraseP, passphraseP, pathP)
+			} else {
+				err = createWallet(pwdP, seedP)
+			}
 			if err != nil {
 				util.Warn(err)
 				return
@@ -59,11 +91,20 @@ func addWalletCreateCmdByShell(parentCmd *ishell.Cmd) {
 func addWalletCreateCmdByCobra(parentCmd *cobra.Command) {
 	var pwdP string
 	var seedP string
+	var mnemonicP bool
+	var phraseP string
+	var passphraseP string
+	var pathP string
 	var wcCmd = &cobra.Command{
 		Use:   "create",
 		Short: "create a wallet for QLCChain node",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := createWallet(pwdP, seedP)
+			var err error
+			if mnemonicP || phraseP != "" {
+				err = createMnemonicWallet(pwdP, phraseP, passphraseP, pathP)
+			} else {
+				err = createWallet(pwdP, seedP)
+			}
 			if err != nil {
 				cmd.Println(err)
 				return
@@ -72,11 +113,15 @@ func addWalletCreateCmdByCobra(parentCmd *cobra.Command) {
 	}
 	wcCmd.Flags().StringVarP(&seedP, "seed", "s", "", "seed for wallet")
 	wcCmd.Flags().StringVarP(&pwdP, "password", "p", "", "password for wallet")
+	wcCmd.Flags().BoolVarP(&mnemonicP, "mnemonic", "m", false, "generate a new mnemonic for wallet")
+	wcCmd.Flags().StringVarP(&phraseP, "phrase", "", "", "restore wallet from the mnemonic")
+	wcCmd.Flags().StringVarP(&passphraseP, "passphrase", "", "", "passphrase of the mnemonic")
+	wcCmd.Flags().StringVarP(&pathP, "path", "", "", "derivation path of the mnemonic wallet, m/44'/1995' by default")
 	parentCmd.AddCommand(wcCmd)
 }
 
 func createWallet(pwdP, seedP string) error {
-	client, err := rpc.Dial(endpointP)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -98,3 +143,33 @@ func createWallet(pwdP, seedP string) error {
 	}
 	return nil
 }
+
+// createMnemonicWallet creates a wallet from the mnemonic, a new mnemonic is generated if it is empty
+func createMnemonicWallet(pwdP, phraseP, passphraseP, pathP string) error {
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if phraseP == "" {
+		if err := client.Call(&phraseP, "account_newMnemonic"); err != nil {
+			return err
+		}
+	}
+	var addr types.Address
+	if pathP == "" {
+		err = client.Call(&addr, "wallet_newWalletByMnemonic", phraseP, passphraseP, pwdP)
+	} else {
+		err = client.Call(&addr, "wallet_newWalletByMnemonic", phraseP, passphraseP, pwdP, pathP)
+	}
+	if err != nil {
+		return err
+	}
+	s := fmt.Sprintf("create wallet: address=>%s, password=>%s success\nmnemonic: %s\nplease keep the mnemonic safe, it is the only way to restore the wallet", addr.String(), pwdP, phraseP)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
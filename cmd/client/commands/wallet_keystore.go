@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
+)
+
+func addWalletExportCmdByShell(parentCmd *ishell.Cmd) {
+	wallet := util.Flag{
+		Name:  "wallet",
+		Must:  true,
+		Usage: "wallet address",
+		Value: "",
+	}
+	account := util.Flag{
+		Name:  "account",
+		Must:  false,
+		Usage: "account in the wallet to export, the whole wallet is exported if not set",
+		Value: "",
+	}
+	pwd := util.Flag{
+		Name:  "password",
+		Must:  false,
+		Usage: "password for wallet, which also encrypts the keystore",
+		Value: "",
+	}
+	file := util.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "keystore file to write",
+		Value: "",
+	}
+	args := []util.Flag{wallet, account, pwd, file}
+	c := &ishell.Cmd{
+		Name:                "export",
+		Help:                "export wallet or account to keystore file",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			walletP := util.StringVar(c.Args, wallet)
+			accountP := util.StringVar(c.Args, account)
+			pwdP := util.StringVar(c.Args, pwd)
+			fileP := util.StringVar(c.Args, file)
+			if err := exportKeystore(walletP, accountP, pwdP, fileP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addWalletExportCmdByCobra(parentCmd *cobra.Command) {
+	var walletP string
+	var accountP string
+	var pwdP string
+	var fileP string
+	var c = &cobra.Command{
+		Use:   "export",
+		Short: "export wallet or account to keystore file",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := exportKeystore(walletP, accountP, pwdP, fileP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&walletP, "wallet", "w", "", "wallet address")
+	c.Flags().StringVarP(&accountP, "account", "a", "", "account in the wallet to export, the whole wallet is exported if not set")
+	c.Flags().StringVarP(&pwdP, "password", "p", "", "password for wallet, which also encrypts the keystore")
+	c.Flags().StringVarP(&fileP, "file", "f", "", "keystore file to write")
+	parentCmd.AddCommand(c)
+}
+
+func addWalletImportCmdByShell(parentCmd *ishell.Cmd) {
+	pwd := util.Flag{
+		Name:  "password",
+		Must:  false,
+		Usage: "password of the keystore, which becomes the wallet password",
+		Value: "",
+	}
+	file := util.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "wallet keystore file to read",
+		Value: "",
+	}
+	args := []util.Flag{pwd, file}
+	c := &ishell.Cmd{
+		Name:                "import",
+		Help:                "import wallet from keystore file",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			pwdP := util.StringVar(c.Args, pwd)
+			fileP := util.StringVar(c.Args, file)
+			if err := importKeystore(pwdP, fileP); err != nil {
+				util.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addWalletImportCmdByCobra(parentCmd *cobra.Command) {
+	var pwdP string
+	var fileP string
+	var c = &cobra.Command{
+		Use:   "import",
+		Short: "import wallet from keystore file",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := importKeystore(pwdP, fileP); err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&pwdP, "password", "p", "", "password of the keystore, which becomes the wallet password")
+	c.Flags().StringVarP(&fileP, "file", "f", "", "wallet keystore file to read")
+	parentCmd.AddCommand(c)
+}
+
+func exportKeystore(walletP, accountP, pwdP, fileP string) error {
+	if fileP == "" {
+		return errors.New("invalid keystore file")
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var ks keystore.Keystore
+	if accountP == "" {
+		err = client.Call(&ks, "wallet_exportWallet", walletP, pwdP)
+	} else {
+		err = client.Call(&ks, "wallet_exportAccount", walletP, accountP, pwdP)
+	}
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(fileP, data, 0600); err != nil {
+		return err
+	}
+	s := fmt.Sprintf("export %s keystore of %s to %s success", ks.Type, ks.Address, fileP)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
+
+func importKeystore(pwdP, fileP string) error {
+	content, err := ioutil.ReadFile(fileP)
+	if err != nil {
+		return err
+	}
+	client, err := dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	var addr types.Address
+	if err := client.Call(&addr, "wallet_importKeystore", string(content), pwdP); err != nil {
+		return err
+	}
+	s := fmt.Sprintf("import wallet: address=>%s success", addr)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
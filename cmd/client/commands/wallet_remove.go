@@ -11,7 +11,6 @@ import (
 	"fmt"
 
 	"github.com/abiosoft/ishell"
-	rpc "github.com/qlcchain/jsonrpc2"
 	"github.com/spf13/cobra"
 
 	"github.com/qlcchain/go-qlc/cmd/util"
@@ -24,7 +23,13 @@ func addWalletRemoveCmdByShell(parentCmd *ishell.Cmd) {
 		Usage: "account for wallet",
 		Value: "",
 	}
-	args := []util.Flag{account}
+	pwd := util.Flag{
+		Name:  "password",
+		Must:  true,
+		Usage: "password for wallet",
+		Value: "",
+	}
+	args := []util.Flag{account, pwd}
 	c := &ishell.Cmd{
 		Name:                "remove",
 		Help:                "remove a wallet",
@@ -38,8 +43,9 @@ func addWalletRemoveCmdByShell(parentCmd *ishell.Cmd) {
 				return
 			}
 			accountP := util.StringVar(c.Args, account)
+			passwordP := util.StringVar(c.Args, pwd)
 
-			err := removeWallet(accountP)
+			err := removeWallet(accountP, passwordP)
 			if err != nil {
 				util.Warn(err)
 				return
@@ -51,11 +57,12 @@ func addWalletRemoveCmdByShell(parentCmd *ishell.Cmd) {
 
 func addWalletRemoveCmdByCobra(parentCmd *cobra.Command) {
 	var accountP string
+	var passwordP string
 	var wrCmd = &cobra.Command{
 		Use:   "remove",
 		Short: "remove wallet",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := removeWallet(accountP)
+			err := removeWallet(accountP, passwordP)
 			if err != nil {
 				cmd.Println(err)
 				return
@@ -63,16 +70,17 @@ func addWalletRemoveCmdByCobra(parentCmd *cobra.Command) {
 		},
 	}
 	wrCmd.Flags().StringVarP(&accountP, "account", "a", "", "wallet address")
+	wrCmd.Flags().StringVarP(&passwordP, "password", "p", "", "password for wallet")
 	parentCmd.AddCommand(wrCmd)
 }
 
-func removeWallet(accountP string) error {
-	client, err := rpc.Dial(endpointP)
+func removeWallet(accountP, pwdP string) error {
+	client, err := dial()
 	if err != nil {
 		return err
 	}
 	defer client.Close()
-	err = client.Call(nil, "wallet_remove", accountP)
+	err = client.Call(nil, "wallet_remove", accountP, pwdP)
 	if err != nil {
 		return err
 	}
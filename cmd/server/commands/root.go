@@ -127,6 +127,7 @@ func addCommand() {
 	chainVersion()
 	removeDB()
 	purgePov()
+	addSnapshotCmd()
 }
 
 func start() error {
@@ -215,8 +216,8 @@ func start() error {
 		}()
 
 		if b, err := session.VerifyPassword(passwordP); b && err == nil {
-			bytes, err := session.GetSeed()
-			tmp, err := seedToAccounts(bytes)
+			// derived by the session, so both the seed and the HD wallets are supported
+			tmp, err := session.Accounts(uint32(maxAccountSize))
 			if err != nil {
 				return err
 			}
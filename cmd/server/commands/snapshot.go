@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/snapshot"
+)
+
+func addSnapshotCmd() {
+	if interactive {
+		snapshotCmd := &ishell.Cmd{
+			Name: "snapshot",
+			Help: "snapshot commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(snapshotCmd)
+		addSnapshotExportCmdByShell(snapshotCmd)
+		addSnapshotImportCmdByShell(snapshotCmd)
+	} else {
+		snapshotCmd := &cobra.Command{
+			Use:   "snapshot",
+			Short: "snapshot commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(snapshotCmd)
+		addSnapshotExportCmdByCobra(snapshotCmd)
+		addSnapshotImportCmdByCobra(snapshotCmd)
+	}
+}
+
+func addSnapshotExportCmdByShell(parentCmd *ishell.Cmd) {
+	file := cmdutil.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "snapshot file to write",
+		Value: "",
+	}
+	height := cmdutil.Flag{
+		Name:  "height",
+		Must:  false,
+		Usage: "pov height of the snapshot, latest height if not set",
+		Value: -1,
+	}
+	args := []cmdutil.Flag{file, height}
+	c := &ishell.Cmd{
+		Name:                "export",
+		Help:                "export ledger snapshot",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			fileP := cmdutil.StringVar(c.Args, file)
+			heightP, _ := cmdutil.IntVar(c.Args, height)
+			if err := exportSnapshot(fileP, heightP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addSnapshotExportCmdByCobra(parentCmd *cobra.Command) {
+	var fileP string
+	var heightP int
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "export ledger snapshot",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := exportSnapshot(fileP, heightP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&fileP, "file", "f", "", "snapshot file to write")
+	c.Flags().IntVarP(&heightP, "height", "", -1, "pov height of the snapshot, latest height if not set")
+	parentCmd.AddCommand(c)
+}
+
+func addSnapshotImportCmdByShell(parentCmd *ishell.Cmd) {
+	file := cmdutil.Flag{
+		Name:  "file",
+		Must:  true,
+		Usage: "snapshot file to read",
+		Value: "",
+	}
+	stateHash := cmdutil.Flag{
+		Name:  "stateHash",
+		Must:  false,
+		Usage: "trusted state hash of pov header to verify the snapshot",
+		Value: "",
+	}
+	args := []cmdutil.Flag{file, stateHash}
+	c := &ishell.Cmd{
+		Name:                "import",
+		Help:                "import ledger snapshot to an empty ledger",
+		CompleterWithPrefix: cmdutil.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if cmdutil.HelpText(c, args) {
+				return
+			}
+			if err := cmdutil.CheckArgs(c, args); err != nil {
+				cmdutil.Warn(err)
+				return
+			}
+			fileP := cmdutil.StringVar(c.Args, file)
+			stateHashP := cmdutil.StringVar(c.Args, stateHash)
+			if err := importSnapshot(fileP, stateHashP); err != nil {
+				cmdutil.Warn(err)
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addSnapshotImportCmdByCobra(parentCmd *cobra.Command) {
+	var fileP string
+	var stateHashP string
+	c := &cobra.Command{
+		Use:   "import",
+		Short: "import ledger snapshot to an empty ledger",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := importSnapshot(fileP, stateHashP); err != nil {
+				cmd.PrintErr(err)
+			}
+		},
+	}
+	c.Flags().StringVarP(&fileP, "file", "f", "", "snapshot file to read")
+	c.Flags().StringVarP(&stateHashP, "stateHash", "", "", "trusted state hash of pov header to verify the snapshot")
+	parentCmd.AddCommand(c)
+}
+
+func openSnapshotLedger() (*ledger.Ledger, error) {
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cm.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	return chain.NewLedgerService(cm.ConfigFile).Ledger, nil
+}
+
+func exportSnapshot(fileP string, heightP int) error {
+	if fileP == "" {
+		return fmt.Errorf("invalid snapshot file")
+	}
+	l, err := openSnapshotLedger()
+	if err != nil {
+		return err
+	}
+	defer ledger.CloseLedger()
+
+	var height uint64
+	if heightP < 0 {
+		if height, err = l.GetPovLatestHeight(); err != nil {
+			return fmt.Errorf("get pov latest height: %s", err)
+		}
+	} else {
+		height = uint64(heightP)
+	}
+
+	f, err := os.Create(fileP)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cmdutil.Info("starting to export snapshot, please wait...")
+	meta, err := snapshot.Export(l, f, height)
+	if err != nil {
+		return err
+	}
+	cmdutil.Info("pov height", meta.Height, "hash", meta.Hash, "state hash", meta.StateHash)
+	cmdutil.Info("finished to export snapshot.")
+	return nil
+}
+
+func importSnapshot(fileP string, stateHashP string) error {
+	var stateHash types.Hash
+	if stateHashP != "" {
+		if err := stateHash.Of(stateHashP); err != nil {
+			return fmt.Errorf("invalid state hash: %s", err)
+		}
+	}
+
+	f, err := os.Open(fileP)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	l, err := openSnapshotLedger()
+	if err != nil {
+		return err
+	}
+	defer ledger.CloseLedger()
+
+	cmdutil.Info("starting to import snapshot, please wait...")
+	meta, err := snapshot.Import(l, f, stateHash)
+	if err != nil {
+		return err
+	}
+	if stateHash.IsZero() {
+		cmdutil.Warn("snapshot is not verified by a trusted state hash")
+	}
+	cmdutil.Info("pov height", meta.Height, "hash", meta.Hash, "state hash", meta.StateHash)
+	cmdutil.Info("finished to import snapshot.")
+	return nil
+}
@@ -10,6 +10,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/abiosoft/ishell"
 	"github.com/spf13/cobra"
@@ -24,11 +25,17 @@ import (
 func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 	seed := util.Flag{
 		Name:  "seed",
-		Must:  true,
+		Must:  false,
 		Usage: "seed for a wallet",
 		Value: "",
 	}
-	args := []util.Flag{seed, password, cfgPath}
+	keystoreFile := util.Flag{
+		Name:  "keystore",
+		Must:  false,
+		Usage: "wallet keystore file, decrypted by the password",
+		Value: "",
+	}
+	args := []util.Flag{seed, keystoreFile, password, cfgPath}
 	s := &ishell.Cmd{
 		Name:                "import",
 		Help:                "import a wallet",
@@ -42,10 +49,11 @@ func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 				return
 			}
 			seedP = util.StringVar(c.Args, seed)
+			keystoreP := util.StringVar(c.Args, keystoreFile)
 			passwordP = util.StringVar(c.Args, password)
 			cfgPathP = util.StringVar(c.Args, cfgPath)
 			//if passwordP = ""
-			err := importWallet(seedP)
+			err := importWallet(seedP, keystoreP)
 			if err != nil {
 				util.Warn(err)
 				return
@@ -56,11 +64,12 @@ func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func addImportWalletCmdByCobra(parentCmd *cobra.Command) {
+	var keystoreP string
 	wiCmd := &cobra.Command{
 		Use:   "import",
 		Short: "import a wallet",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := importWallet(seedP)
+			err := importWallet(seedP, keystoreP)
 			if err != nil {
 				cmd.PrintErr(err)
 				return
@@ -68,10 +77,11 @@ func addImportWalletCmdByCobra(parentCmd *cobra.Command) {
 		},
 	}
 	wiCmd.Flags().StringVarP(&seedP, "seed", "s", "", "seed for a wallet")
+	wiCmd.Flags().StringVarP(&keystoreP, "keystore", "k", "", "wallet keystore file, decrypted by the password")
 	parentCmd.AddCommand(wiCmd)
 }
 
-func importWallet(seedP string) error {
+func importWallet(seedP, keystoreP string) error {
 	chain := context.NewChainContext(cfgPathP)
 	defer func() {
 		if chain != nil {
@@ -83,7 +93,12 @@ func importWallet(seedP string) error {
 	if err != nil {
 		return err
 	}
-	if len(seedP) != types.SeedSize {
+	var content []byte
+	if keystoreP != "" {
+		if content, err = ioutil.ReadFile(keystoreP); err != nil {
+			return err
+		}
+	} else if len(seedP) != types.SeedSize {
 		return errors.New("invalid seed")
 	}
 	w := wallet.NewWalletStore(cm.ConfigFile)
@@ -93,12 +108,20 @@ func importWallet(seedP string) error {
 		}
 	}()
 
-	addr, err := w.NewWalletBySeed(seedP, passwordP)
-	if err != nil {
-		return err
+	var s string
+	if content != nil {
+		addr, err := w.ImportKeystore(content, passwordP)
+		if err != nil {
+			return err
+		}
+		s = fmt.Sprintf("import keystore[%s] => %s success", keystoreP, addr.String())
+	} else {
+		addr, err := w.NewWalletBySeed(seedP, passwordP)
+		if err != nil {
+			return err
+		}
+		s = fmt.Sprintf("import seed[%s] password[%s] => %s success", seedP, passwordP, addr.String())
 	}
-
-	s := fmt.Sprintf("import seed[%s] password[%s] => %s success", seedP, passwordP, addr.String())
 	if interactive {
 		util.Info(s)
 	} else {
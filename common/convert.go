@@ -10,6 +10,7 @@ package common
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/qlcchain/go-qlc/common/types"
 )
@@ -48,3 +49,28 @@ func RawToBalanceFloat(b types.Balance, unit string) (*big.Float, error) {
 	}
 	return nil, fmt.Errorf("invalid unit %s", unit)
 }
+
+// RawToDecimal formats the raw amount of a token with its decimals, e.g. 123456 with 3 decimals is 123.456,
+// trailing zeros of the fraction are removed
+func RawToDecimal(b types.Balance, decimals uint8) string {
+	if b.Int == nil {
+		return "0"
+	}
+	s := new(big.Int).Abs(b.Int).String()
+	sign := ""
+	if b.Int.Sign() < 0 {
+		sign = "-"
+	}
+	d := int(decimals)
+	if d == 0 {
+		return sign + s
+	}
+	if len(s) <= d {
+		s = strings.Repeat("0", d-len(s)+1) + s
+	}
+	integer, fraction := s[:len(s)-d], strings.TrimRight(s[len(s)-d:], "0")
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + "." + fraction
+}
@@ -41,3 +41,23 @@ func TestRawToBalance(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestRawToDecimal(t *testing.T) {
+	cases := []struct {
+		raw      types.Balance
+		decimals uint8
+		expect   string
+	}{
+		{types.NewBalance(123456), 3, "123.456"},
+		{types.NewBalance(123000), 3, "123"},
+		{types.NewBalance(5), 8, "0.00000005"},
+		{types.NewBalance(-1500), 3, "-1.5"},
+		{types.NewBalance(42), 0, "42"},
+		{types.Balance{}, 8, "0"},
+	}
+	for _, c := range cases {
+		if r := RawToDecimal(c.raw, c.decimals); r != c.expect {
+			t.Fatal(c.raw, c.decimals, r)
+		}
+	}
+}
@@ -9,4 +9,5 @@ const (
 	RpcDPoSGetConsPerf
 	RpcDPoSFeed
 	RpcDPoSDebug
+	RpcDPoSElectionTrace
 )
@@ -51,6 +51,11 @@ var (
 
 	PoVMaxForkHeight = uint64(POVChainBlocksPerHour * 23)
 
+	// PovMultiSigForkHeight is the pov height from which blocks of multi-signature accounts are valid, it is
+	// checked against the pov height of the block, nodes before the fork reject such blocks as bad signature.
+	// The testnet forks ahead of the mainnet to exercise multi-signature accounts first.
+	PovMultiSigForkHeight = uint64(640000)
+
 	PovGenesisPowHex    = "00000000ffff0000000000000000000000000000000000000000000000000000"
 	PovGenesisPowInt, _ = new(big.Int).SetString(PovGenesisPowHex, 16)
 	PovGenesisPowBits   = types.BigToCompact(PovGenesisPowInt) //0x1d00ffff
@@ -0,0 +1,216 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/google/btree"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+// kvItem is a key-value pair ordered by key, deleted marks a pending delete in a batch
+type kvItem struct {
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+func (i *kvItem) Less(than btree.Item) bool {
+	return bytes.Compare(i.key, than.(*kvItem).key) < 0
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// kvWriter is implemented by stores which use txnBatch and writeBatch, it applies all ops atomically
+type kvWriter interface {
+	Get(k []byte) ([]byte, error)
+	Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error
+	write(ops []*kvItem) error
+}
+
+// txnBatch is a read-write batch, writes are buffered and visible to its own reads until committed to the store
+type txnBatch struct {
+	store  kvWriter
+	writes *btree.BTree
+}
+
+func newTxnBatch(store kvWriter) *txnBatch {
+	return &txnBatch{store: store, writes: btree.New(32)}
+}
+
+func (b *txnBatch) Get(k []byte) (interface{}, error) {
+	if i := b.writes.Get(&kvItem{key: k}); i != nil {
+		item := i.(*kvItem)
+		if item.deleted {
+			return nil, storage.KeyNotFound
+		}
+		return copyBytes(item.value), nil
+	}
+	return b.store.Get(k)
+}
+
+func (b *txnBatch) Put(k []byte, v interface{}) error {
+	b.writes.ReplaceOrInsert(&kvItem{key: copyBytes(k), value: copyBytes(v.([]byte))})
+	return nil
+}
+
+func (b *txnBatch) Delete(k []byte) error {
+	b.writes.ReplaceOrInsert(&kvItem{key: copyBytes(k), deleted: true})
+	return nil
+}
+
+// Iterator merges pending writes of the batch with the data of the store
+func (b *txnBatch) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	base := make([]*kvItem, 0)
+	if err := b.store.Iterator(prefix, end, func(k, v []byte) error {
+		base = append(base, &kvItem{key: copyBytes(k), value: copyBytes(v)})
+		return nil
+	}); err != nil {
+		return err
+	}
+	pending := make([]*kvItem, 0)
+	b.writes.AscendGreaterOrEqual(&kvItem{key: prefix}, func(i btree.Item) bool {
+		item := i.(*kvItem)
+		if !inRange(item.key, prefix, end) {
+			return false
+		}
+		pending = append(pending, item)
+		return true
+	})
+
+	for len(base) > 0 || len(pending) > 0 {
+		var item *kvItem
+		if len(pending) == 0 {
+			item, base = base[0], base[1:]
+		} else if len(base) == 0 {
+			item, pending = pending[0], pending[1:]
+		} else {
+			switch c := bytes.Compare(base[0].key, pending[0].key); {
+			case c < 0:
+				item, base = base[0], base[1:]
+			case c > 0:
+				item, pending = pending[0], pending[1:]
+			default:
+				item, base, pending = pending[0], base[1:], pending[1:]
+			}
+		}
+		if item.deleted {
+			continue
+		}
+		if err := fn(item.key, item.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *txnBatch) Drop(prefix []byte) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	keys := make([][]byte, 0)
+	if err := b.Iterator(prefix, nil, func(k, v []byte) error {
+		keys = append(keys, k)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *txnBatch) Discard() {
+	b.writes = btree.New(32)
+}
+
+func (b *txnBatch) commit() error {
+	ops := make([]*kvItem, 0, b.writes.Len())
+	b.writes.Ascend(func(i btree.Item) bool {
+		ops = append(ops, i.(*kvItem))
+		return true
+	})
+	b.Discard()
+	return b.store.write(ops)
+}
+
+// writeBatch is a write only batch, ops are applied to the store in order when committed
+type writeBatch struct {
+	store kvWriter
+	ops   []*kvItem
+}
+
+func newWriteBatch(store kvWriter) *writeBatch {
+	return &writeBatch{store: store, ops: make([]*kvItem, 0)}
+}
+
+func (b *writeBatch) Get([]byte) (interface{}, error) {
+	return nil, errors.New("BatchWrite can write only")
+}
+
+func (b *writeBatch) Iterator(prefix []byte, end []byte, f func(k, v []byte) error) error {
+	return errors.New("BatchWrite can write only")
+}
+
+func (b *writeBatch) Delete(k []byte) error {
+	b.ops = append(b.ops, &kvItem{key: copyBytes(k), deleted: true})
+	return nil
+}
+
+func (b *writeBatch) Put(k []byte, v interface{}) error {
+	b.ops = append(b.ops, &kvItem{key: copyBytes(k), value: copyBytes(v.([]byte))})
+	return nil
+}
+
+func (b *writeBatch) Drop(prefix []byte) error {
+	return errors.New("BatchWrite can write only")
+}
+
+func (b *writeBatch) Discard() {
+	b.ops = b.ops[:0]
+}
+
+func (b *writeBatch) commit() error {
+	ops := b.ops
+	b.ops = make([]*kvItem, 0)
+	return b.store.write(ops)
+}
+
+// putBatch commits a batch created by a kvWriter store
+func putBatch(batch storage.Batch) error {
+	switch b := batch.(type) {
+	case *txnBatch:
+		return b.commit()
+	case *writeBatch:
+		return b.commit()
+	}
+	return errors.New("error batch type")
+}
+
+// batchWrite runs fn within a batch of the store and commits it if fn succeeds
+func batchWrite(store kvWriter, canRead bool, fn func(batch storage.Batch) error) error {
+	var batch storage.Batch
+	if canRead {
+		batch = newTxnBatch(store)
+	} else {
+		batch = newWriteBatch(store)
+	}
+	if err := fn(batch); err != nil {
+		batch.Discard()
+		return err
+	}
+	return putBatch(batch)
+}
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	qlcutil "github.com/qlcchain/go-qlc/common/util"
+)
+
+type LevelDBStore struct {
+	db  *leveldb.DB
+	dir string
+}
+
+// NewLevelDBStore initializes/opens a leveldb database in the given directory.
+func NewLevelDBStore(dir string) (storage.Store, error) {
+	_ = qlcutil.CreateDirIfNotExist(dir)
+	db, err := leveldb.OpenFile(dir, &opt.Options{
+		BlockCacheCapacity: 32 * opt.MiB,
+		WriteBuffer:        16 * opt.MiB,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LevelDBStore{db: db, dir: dir}, nil
+}
+
+func (l *LevelDBStore) Get(k []byte) ([]byte, error) {
+	v, err := l.db.Get(k, nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return nil, storage.KeyNotFound
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (l *LevelDBStore) Put(k, v []byte) error {
+	return l.db.Put(k, v, nil)
+}
+
+func (l *LevelDBStore) Delete(k []byte) error {
+	return l.db.Delete(k, nil)
+}
+
+func (l *LevelDBStore) Has(k []byte) (bool, error) {
+	return l.db.Has(k, nil)
+}
+
+func (l *LevelDBStore) Batch(canRead bool) storage.Batch {
+	if canRead {
+		return newTxnBatch(l)
+	}
+	return newWriteBatch(l)
+}
+
+func (l *LevelDBStore) PutBatch(batch storage.Batch) error {
+	return putBatch(batch)
+}
+
+func (l *LevelDBStore) BatchWrite(canRead bool, fn func(batch storage.Batch) error) error {
+	return batchWrite(l, canRead, fn)
+}
+
+func (l *LevelDBStore) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	it := l.db.NewIterator(levelDBRange(prefix, end), nil)
+	defer it.Release()
+	for it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+func (l *LevelDBStore) Count(prefix []byte) (uint64, error) {
+	var i uint64
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+	for it.Next() {
+		i++
+	}
+	return i, it.Error()
+}
+
+func (l *LevelDBStore) Purge() error {
+	return l.db.CompactRange(util.Range{})
+}
+
+func (l *LevelDBStore) Drop(prefix []byte) error {
+	batch := new(leveldb.Batch)
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	for it.Next() {
+		batch.Delete(copyBytes(it.Key()))
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return l.db.Write(batch, nil)
+}
+
+// Upgrade does nothing, leveldb store is introduced after all data upgrades of badger store
+func (l *LevelDBStore) Upgrade(version int) error {
+	return nil
+}
+
+func (l *LevelDBStore) Action(at storage.ActionType) (interface{}, error) {
+	switch at {
+	case storage.GC:
+		if err := l.Purge(); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	case storage.Size:
+		var size int64
+		err := filepath.Walk(l.dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				size += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		s := make(map[string]int64)
+		s["lsm"] = size
+		s["vlog"] = 0
+		return s, nil
+	default:
+		return "", errors.New("invalid action type")
+	}
+}
+
+func (l *LevelDBStore) Close() error {
+	return l.db.Close()
+}
+
+func (l *LevelDBStore) write(ops []*kvItem) error {
+	batch := new(leveldb.Batch)
+	for _, op := range ops {
+		if op.deleted {
+			batch.Delete(op.key)
+		} else {
+			batch.Put(op.key, op.value)
+		}
+	}
+	return l.db.Write(batch, nil)
+}
+
+func levelDBRange(prefix []byte, end []byte) *util.Range {
+	if end == nil {
+		return util.BytesPrefix(prefix)
+	}
+	return &util.Range{Start: prefix, Limit: end}
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/btree"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+// MemoryStore keeps all data in an ordered in-memory tree, data is lost when it is closed
+type MemoryStore struct {
+	lock sync.RWMutex
+	tree *btree.BTree
+	size int64
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() storage.Store {
+	return &MemoryStore{tree: btree.New(32)}
+}
+
+func (m *MemoryStore) Get(k []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if i := m.tree.Get(&kvItem{key: k}); i != nil {
+		return copyBytes(i.(*kvItem).value), nil
+	}
+	return nil, storage.KeyNotFound
+}
+
+func (m *MemoryStore) Put(k, v []byte) error {
+	return m.write([]*kvItem{{key: copyBytes(k), value: copyBytes(v)}})
+}
+
+func (m *MemoryStore) Delete(k []byte) error {
+	return m.write([]*kvItem{{key: k, deleted: true}})
+}
+
+func (m *MemoryStore) Has(k []byte) (bool, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.tree.Has(&kvItem{key: k}), nil
+}
+
+func (m *MemoryStore) Batch(canRead bool) storage.Batch {
+	if canRead {
+		return newTxnBatch(m)
+	}
+	return newWriteBatch(m)
+}
+
+func (m *MemoryStore) PutBatch(batch storage.Batch) error {
+	return putBatch(batch)
+}
+
+func (m *MemoryStore) BatchWrite(canRead bool, fn func(batch storage.Batch) error) error {
+	return batchWrite(m, canRead, fn)
+}
+
+// Iterator works on a copy of the matched items, so fn can write to the store
+func (m *MemoryStore) Iterator(prefix []byte, end []byte, fn func(k, v []byte) error) error {
+	if len(prefix) <= 0 {
+		return errors.New("invalid prefix")
+	}
+
+	for _, item := range m.items(prefix, end) {
+		if err := fn(copyBytes(item.key), copyBytes(item.value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) Count(prefix []byte) (uint64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	var i uint64
+	m.tree.AscendGreaterOrEqual(&kvItem{key: prefix}, func(item btree.Item) bool {
+		if !inRange(item.(*kvItem).key, prefix, nil) {
+			return false
+		}
+		i++
+		return true
+	})
+	return i, nil
+}
+
+func (m *MemoryStore) Purge() error {
+	return nil
+}
+
+func (m *MemoryStore) Drop(prefix []byte) error {
+	if prefix == nil {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.tree = btree.New(32)
+		m.size = 0
+		return nil
+	}
+
+	items := m.items(prefix, nil)
+	for _, item := range items {
+		item.deleted = true
+	}
+	return m.write(items)
+}
+
+// Upgrade does nothing, a memory store never holds data of old versions
+func (m *MemoryStore) Upgrade(version int) error {
+	return nil
+}
+
+func (m *MemoryStore) Action(at storage.ActionType) (interface{}, error) {
+	switch at {
+	case storage.GC:
+		return nil, nil
+	case storage.Size:
+		m.lock.RLock()
+		defer m.lock.RUnlock()
+		s := make(map[string]int64)
+		s["lsm"] = m.size
+		s["vlog"] = 0
+		return s, nil
+	default:
+		return "", errors.New("invalid action type")
+	}
+}
+
+func (m *MemoryStore) Close() error {
+	return m.Drop(nil)
+}
+
+func (m *MemoryStore) items(prefix []byte, end []byte) []*kvItem {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	items := make([]*kvItem, 0)
+	m.tree.AscendGreaterOrEqual(&kvItem{key: prefix}, func(i btree.Item) bool {
+		item := i.(*kvItem)
+		if !inRange(item.key, prefix, end) {
+			return false
+		}
+		items = append(items, &kvItem{key: item.key, value: item.value})
+		return true
+	})
+	return items
+}
+
+func (m *MemoryStore) write(ops []*kvItem) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, op := range ops {
+		var old btree.Item
+		if op.deleted {
+			old = m.tree.Delete(op)
+		} else {
+			old = m.tree.ReplaceOrInsert(&kvItem{key: op.key, value: op.value})
+			m.size += int64(len(op.key) + len(op.value))
+		}
+		if old != nil {
+			item := old.(*kvItem)
+			m.size -= int64(len(item.key) + len(item.value))
+		}
+	}
+	return nil
+}
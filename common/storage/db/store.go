@@ -0,0 +1,74 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+// storage engines which can be selected by DBConfig.Engine
+const (
+	BadgerEngine  = "badger"
+	LevelDBEngine = "leveldb"
+	MemoryEngine  = "memory"
+)
+
+// StoreProvider opens a store of one engine in the given directory
+type StoreProvider func(dir string) (storage.Store, error)
+
+var (
+	providers     = make(map[string]StoreProvider)
+	providersLock = sync.RWMutex{}
+)
+
+func init() {
+	RegisterStore(BadgerEngine, NewBadgerStore)
+	RegisterStore(LevelDBEngine, NewLevelDBStore)
+	RegisterStore(MemoryEngine, func(string) (storage.Store, error) {
+		return NewMemoryStore(), nil
+	})
+}
+
+// RegisterStore makes a storage engine available by name, it replaces the provider registered before with the same name
+func RegisterStore(engine string, provider StoreProvider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[engine] = provider
+}
+
+// Engines returns names of all registered storage engines
+func Engines() []string {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+	engines := make([]string, 0, len(providers))
+	for name := range providers {
+		engines = append(engines, name)
+	}
+	sort.Strings(engines)
+	return engines
+}
+
+// NewStore opens a store with the named engine, empty engine means badger
+func NewStore(engine, dir string) (storage.Store, error) {
+	if engine == "" {
+		engine = BadgerEngine
+	}
+	providersLock.RLock()
+	provider, ok := providers[engine]
+	providersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported storage engine: %s", engine)
+	}
+	return provider(dir)
+}
+
+// inRange checks if key belongs to the range of Iterator, keys with prefix if end is nil, otherwise [prefix, end)
+func inRange(key, prefix, end []byte) bool {
+	if end == nil {
+		return bytes.HasPrefix(key, prefix)
+	}
+	return bytes.Compare(key, prefix) >= 0 && bytes.Compare(key, end) < 0
+}
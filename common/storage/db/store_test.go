@@ -0,0 +1,302 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// conformance cases every storage engine must pass
+var storeCases = []struct {
+	name string
+	fn   func(t *testing.T, store storage.Store)
+}{
+	{"GetPut", testStoreGetPut},
+	{"Iterator", testStoreIterator},
+	{"Count", testStoreCount},
+	{"Drop", testStoreDrop},
+	{"BatchWrite", testStoreBatchWrite},
+	{"WriteBatch", testStoreWriteBatch},
+	{"BatchDrop", testStoreBatchDrop},
+	{"Upgrade", testStoreUpgrade},
+}
+
+func TestStore_Conformance(t *testing.T) {
+	for _, engine := range Engines() {
+		engine := engine
+		t.Run(engine, func(t *testing.T) {
+			for _, c := range storeCases {
+				c := c
+				t.Run(c.name, func(t *testing.T) {
+					dir := filepath.Join(config.QlcTestDataDir(), "store", uuid.New().String())
+					store, err := NewStore(engine, dir)
+					if err != nil {
+						t.Fatal(err)
+					}
+					defer func() {
+						if err := store.Close(); err != nil {
+							t.Fatal(err)
+						}
+						_ = os.RemoveAll(dir)
+					}()
+					c.fn(t, store)
+				})
+			}
+		})
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	if _, err := NewStore("unknown", ""); err == nil {
+		t.Fatal("unknown engine should fail")
+	}
+	store, err := NewStore(MemoryEngine, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.(*MemoryStore); !ok {
+		t.Fatal("invalid store type")
+	}
+}
+
+func putKeys(t *testing.T, store storage.Store, keys ...[]byte) {
+	for _, k := range keys {
+		if err := store.Put(k, append([]byte{0xff}, k...)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func iterKeys(t *testing.T, iter func(prefix, end []byte, fn func(k, v []byte) error) error, prefix, end []byte) [][]byte {
+	keys := make([][]byte, 0)
+	if err := iter(prefix, end, func(k, v []byte) error {
+		if !bytes.Equal(v[1:], k) {
+			t.Fatalf("invalid value %v of key %v", v, k)
+		}
+		keys = append(keys, append([]byte{}, k...))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func checkKeys(t *testing.T, got [][]byte, exp ...[]byte) {
+	t.Helper()
+	if len(got) != len(exp) {
+		t.Fatalf("exp %v, got %v", exp, got)
+	}
+	for i := range exp {
+		if !bytes.Equal(got[i], exp[i]) {
+			t.Fatalf("exp %v, got %v", exp, got)
+		}
+	}
+}
+
+func testStoreGetPut(t *testing.T, store storage.Store) {
+	key := []byte{1, 2, 3}
+	if _, err := store.Get(key); err != storage.KeyNotFound {
+		t.Fatal("expect key not found, got", err)
+	}
+	if b, err := store.Has(key); err != nil || b {
+		t.Fatal(b, err)
+	}
+	if err := store.Put(key, []byte{4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := store.Get(key); err != nil || !bytes.Equal(v, []byte{4, 5, 6}) {
+		t.Fatal(v, err)
+	}
+	if err := store.Put(key, []byte{7}); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := store.Get(key); err != nil || !bytes.Equal(v, []byte{7}) {
+		t.Fatal(v, err)
+	}
+	if b, err := store.Has(key); err != nil || !b {
+		t.Fatal(b, err)
+	}
+	if err := store.Delete(key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(key); err != storage.KeyNotFound {
+		t.Fatal("expect key not found, got", err)
+	}
+}
+
+func testStoreIterator(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{2, 1}, []byte{1, 2}, []byte{1, 1, 5}, []byte{1, 3}, []byte{0, 9}, []byte{1})
+
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1}, nil), []byte{1}, []byte{1, 1, 5}, []byte{1, 2}, []byte{1, 3})
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1, 1}, nil), []byte{1, 1, 5})
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1, 1}, []byte{2, 1}), []byte{1, 1, 5}, []byte{1, 2}, []byte{1, 3})
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1, 3}, []byte{3}), []byte{1, 3}, []byte{2, 1})
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{3}, nil))
+
+	if err := store.Iterator(nil, nil, func(k, v []byte) error { return nil }); err == nil {
+		t.Fatal("empty prefix should fail")
+	}
+
+	errStop := errors.New("stop")
+	count := 0
+	if err := store.Iterator([]byte{1}, nil, func(k, v []byte) error {
+		count++
+		return errStop
+	}); err != errStop || count != 1 {
+		t.Fatal("iterator should stop at error", count, err)
+	}
+
+	// writes in iterator callback
+	if err := store.Iterator([]byte{1}, nil, func(k, v []byte) error {
+		return store.Put(append([]byte{3}, k...), v)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := store.Count([]byte{3}); err != nil || c != 4 {
+		t.Fatal(c, err)
+	}
+}
+
+func testStoreCount(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{1, 2, 3}, []byte{1, 2, 4}, []byte{1, 3}, []byte{2})
+	if c, err := store.Count([]byte{1, 2}); err != nil || c != 2 {
+		t.Fatal(c, err)
+	}
+	if c, err := store.Count([]byte{1}); err != nil || c != 3 {
+		t.Fatal(c, err)
+	}
+	if c, err := store.Count(nil); err != nil || c != 4 {
+		t.Fatal(c, err)
+	}
+	if c, err := store.Count([]byte{5}); err != nil || c != 0 {
+		t.Fatal(c, err)
+	}
+}
+
+func testStoreDrop(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{1, 2, 3}, []byte{1, 2, 4}, []byte{1, 3}, []byte{2})
+	if err := store.Drop([]byte{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1}, []byte{3}), []byte{1, 3}, []byte{2})
+	if err := store.Drop(nil); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := store.Count(nil); err != nil || c != 0 {
+		t.Fatal(c, err)
+	}
+}
+
+func testStoreBatchWrite(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{1, 1}, []byte{1, 3})
+
+	if err := store.BatchWrite(true, func(batch storage.Batch) error {
+		if err := batch.Put([]byte{1, 2}, []byte{0xff, 1, 2}); err != nil {
+			return err
+		}
+		if err := batch.Delete([]byte{1, 3}); err != nil {
+			return err
+		}
+		if v, err := batch.Get([]byte{1, 2}); err != nil || !bytes.Equal(v.([]byte), []byte{0xff, 1, 2}) {
+			t.Fatal("batch should read its own write", v, err)
+		}
+		if _, err := batch.Get([]byte{1, 3}); err != storage.KeyNotFound {
+			t.Fatal("expect key not found, got", err)
+		}
+		checkKeys(t, iterKeys(t, batch.Iterator, []byte{1}, nil), []byte{1, 1}, []byte{1, 2})
+		if _, err := store.Get([]byte{1, 2}); err != storage.KeyNotFound {
+			t.Fatal("write should not be visible before commit")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{1}, nil), []byte{1, 1}, []byte{1, 2})
+
+	errAbort := errors.New("abort")
+	if err := store.BatchWrite(true, func(batch storage.Batch) error {
+		if err := batch.Put([]byte{1, 4}, []byte{0xff, 1, 4}); err != nil {
+			return err
+		}
+		return errAbort
+	}); err != errAbort {
+		t.Fatal("expect abort error, got", err)
+	}
+	if b, _ := store.Has([]byte{1, 4}); b {
+		t.Fatal("failed batch should not be committed")
+	}
+
+	batch := store.Batch(true)
+	if err := batch.Put([]byte{1, 5}, []byte{0xff, 1, 5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutBatch(batch); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := store.Has([]byte{1, 5}); !b {
+		t.Fatal("batch should be committed")
+	}
+}
+
+func testStoreWriteBatch(t *testing.T, store storage.Store) {
+	if err := store.BatchWrite(false, func(batch storage.Batch) error {
+		if _, err := batch.Get([]byte{1}); err == nil {
+			t.Fatal("write batch should not read")
+		}
+		for i := 0; i < 100; i++ {
+			if err := batch.Put([]byte{1, byte(i)}, []byte{0xff, 1, byte(i)}); err != nil {
+				return err
+			}
+		}
+		return batch.Delete([]byte{1, 0})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := store.Count([]byte{1}); err != nil || c != 99 {
+		t.Fatal(c, err)
+	}
+
+	batch := store.Batch(false)
+	if err := batch.Delete([]byte{1, 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutBatch(batch); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := store.Count([]byte{1}); err != nil || c != 98 {
+		t.Fatal(c, err)
+	}
+}
+
+func testStoreBatchDrop(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{1, 1}, []byte{1, 2}, []byte{2, 1})
+	batch := store.Batch(true)
+	if err := batch.Put([]byte{1, 3}, []byte{0xff, 1, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Drop([]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	checkKeys(t, iterKeys(t, batch.Iterator, []byte{1}, []byte{3}), []byte{2, 1})
+	if err := store.PutBatch(batch); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := store.Count([]byte{1}); err != nil || c != 0 {
+		t.Fatal(c, err)
+	}
+}
+
+func testStoreUpgrade(t *testing.T, store storage.Store) {
+	putKeys(t, store, []byte{200, 1})
+	if err := store.Upgrade(13); err != nil {
+		t.Fatal(err)
+	}
+	checkKeys(t, iterKeys(t, store.Iterator, []byte{200}, nil), []byte{200, 1})
+}
@@ -85,6 +85,11 @@ const (
 	KeyPrefixPrivatePayload
 	KeyPrefixGapDoDSettleState
 	KeyPrefixGapPovHeight
+	KeyPrefixEventLog         // prefix + seq => event, prefix => latest seq
+	KeyPrefixMultiSigProposal // prefix + block hash => proposal
+	KeyPrefixElectionTrace    // prefix + block hash => election trace
+	KeyPrefixRepOnlineStats   // prefix + period => online statistics of representatives
+	KeyPrefixAccountTokenSeq  // prefix + address + token + seq => block hash
 
 	// Trie key space should be different
 	KeyPrefixTrieVMStorage = 100 // Deprecated vm_store.go, idPrefixStorage
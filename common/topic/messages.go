@@ -48,11 +48,14 @@ type EventRPCSyncCallMsg struct {
 type EventPublishMsg struct {
 	Block *types.StateBlock
 	From  string
+	// Direct is true if the message is received on a stream from From rather than relayed by pubsub
+	Direct bool
 }
 
 type EventConfirmReqMsg struct {
 	Blocks []*types.StateBlock
 	From   string
+	Direct bool
 }
 
 type EventAddP2PStreamMsg struct {
@@ -73,6 +76,8 @@ type EventBandwidthStats struct {
 	TotalOut int64
 	RateIn   float64
 	RateOut  float64
+	// messages dropped for exceeding the limits by message type
+	Dropped map[string]uint64 `json:",omitempty"`
 }
 
 type EventP2PConnectPeersMsg struct {
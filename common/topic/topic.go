@@ -49,6 +49,8 @@ const (
 
 	EventAddBlockCache        TopicType = "addBlockCache"
 	EventPermissionNodeUpdate TopicType = "permissionNodeUpdate"
+	EventPeerMisbehave        TopicType = "peerMisbehave"
+	EventElectionTrace        TopicType = "electionTrace"
 
 	EventPrivacySendReq TopicType = "privacySendReq"
 	EventPrivacySendRsp TopicType = "privacySendRsp"
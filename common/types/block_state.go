@@ -40,6 +40,8 @@ type StateBlock struct {
 
 	Work      Work      `msg:"work,extension" json:"work"`
 	Signature Signature `msg:"signature,extension" json:"signature"`
+	// MultiSig is set instead of Signature if the address is a multi-signature account, it is not hashed
+	MultiSig *MultiSig `msg:"multiSig,omitempty" json:"multiSig,omitempty"`
 
 	// following fields just for cache, not marshaled in db or p2p message
 	Flag uint64 `msg:"-" json:"-"`
@@ -348,19 +350,27 @@ func (bs *StateBlockList) Deserialize(text []byte) error {
 func (b *StateBlock) ConvertToSchema() ([]Schema, error) {
 	return []Schema{
 		&BlockHash{
-			Type:      b.Type.String(),
-			Address:   b.Address.String(),
-			Timestamp: b.Timestamp,
-			Hash:      b.GetHash().String(),
+			Type:           b.Type.String(),
+			Address:        b.Address.String(),
+			Timestamp:      b.Timestamp,
+			Hash:           b.GetHash().String(),
+			Token:          b.Token.String(),
+			Link:           b.Link.String(),
+			Representative: b.Representative.String(),
 		}}, nil
 }
 
 type BlockHash struct {
-	Id        int64  `db:"id" typ:"integer"`
-	Hash      string `db:"hash" typ:"char(64)"`
-	Type      string `db:"type"  typ:"varchar(15)"`
-	Address   string `db:"address" typ:"char(64)"`
-	Timestamp int64  `db:"timestamp" typ:"integer"`
+	Id             int64  `db:"id" typ:"integer"`
+	Hash           string `db:"hash" typ:"char(64)"`
+	Type           string `db:"type"  typ:"varchar(15)"`
+	Address        string `db:"address" typ:"char(64)"`
+	Timestamp      int64  `db:"timestamp" typ:"bigint"`
+	Token          string `db:"token" typ:"char(64)"`
+	Link           string `db:"link" typ:"char(64)"`
+	Representative string `db:"representative" typ:"char(64)"`
+	Amount         int64  `db:"amount" typ:"bigint"`
+	Method         string `db:"method" typ:"varchar(64)"`
 }
 
 func (s *BlockHash) DeleteKey() string {
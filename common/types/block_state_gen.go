@@ -175,6 +175,24 @@ func (z *StateBlock) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Signature")
 				return
 			}
+		case "multiSig":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "MultiSig")
+					return
+				}
+				z.MultiSig = nil
+			} else {
+				if z.MultiSig == nil {
+					z.MultiSig = new(MultiSig)
+				}
+				err = z.MultiSig.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "MultiSig")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -188,9 +206,14 @@ func (z *StateBlock) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *StateBlock) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 23
+	// omitempty: check for empty values
+	zb0001Len := byte(24)
+	if z.MultiSig == nil {
+		zb0001Len--
+	}
+	// map header, size zb0001Len
 	// write "type"
-	err = en.Append(0xde, 0x0, 0x17, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	err = en.Append(0xde, 0x0, zb0001Len, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	if err != nil {
 		return
 	}
@@ -426,15 +449,32 @@ func (z *StateBlock) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Signature")
 		return
 	}
+	if z.MultiSig != nil { // if not empty
+		// write "multiSig"
+		err = en.Append(0xa8, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67)
+		if err != nil {
+			return
+		}
+		err = z.MultiSig.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "MultiSig")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *StateBlock) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 23
+	// omitempty: check for empty values
+	zb0001Len := byte(24)
+	if z.MultiSig == nil {
+		zb0001Len--
+	}
+	// map header, size zb0001Len
 	// string "type"
-	o = append(o, 0xde, 0x0, 0x17, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	o = append(o, 0xde, 0x0, zb0001Len, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	o, err = z.Type.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "Type")
@@ -565,6 +605,15 @@ func (z *StateBlock) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Signature")
 		return
 	}
+	if z.MultiSig != nil { // if not empty
+		// string "multiSig"
+		o = append(o, 0xa8, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67)
+		o, err = z.MultiSig.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "MultiSig")
+			return
+		}
+	}
 	return
 }
 
@@ -737,6 +786,23 @@ func (z *StateBlock) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Signature")
 				return
 			}
+		case "multiSig":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.MultiSig = nil
+			} else {
+				if z.MultiSig == nil {
+					z.MultiSig = new(MultiSig)
+				}
+				bts, err = z.MultiSig.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "MultiSig")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -756,6 +822,9 @@ func (z *StateBlock) Msgsize() (s int) {
 		s += msgp.StringPrefixSize + len(z.PrivateFor[za0001])
 	}
 	s += 7 + msgp.StringPrefixSize + len(z.PrivateGroupID) + 5 + msgp.ExtensionPrefixSize + z.Work.Len() + 10 + msgp.ExtensionPrefixSize + z.Signature.Len()
+	if z.MultiSig != nil {
+		s += 9 + z.MultiSig.Msgsize()
+	}
 	return
 }
 
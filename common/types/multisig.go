@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+//go:generate msgp
+
+// MaxMultiSigOwners is the max count of owners of a multi-signature account
+const MaxMultiSigOwners = 16
+
+var (
+	multiSigPrefix       = []byte("qlc_multisig")
+	multiSigCancelPrefix = []byte("qlc_multisig_cancel")
+)
+
+var (
+	ErrMultiSigThreshold  = errors.New("invalid multisig threshold")
+	ErrMultiSigOwners     = errors.New("invalid multisig owners")
+	ErrMultiSigNotOwner   = errors.New("address is not owner of the multisig account")
+	ErrMultiSigAddress    = errors.New("multisig owners do not match the address")
+	ErrMultiSigSignatures = errors.New("not enough multisig signatures")
+)
+
+// MultiSig is the owner set and threshold of a multi-signature account, and the signatures of the owners.
+// The address of the account is derived from the owner set and threshold, so no one holds its private key,
+// and a block of the account is valid if at least threshold owners sign the block hash.
+type MultiSig struct {
+	Threshold  uint8            `msg:"threshold" json:"threshold"`
+	Owners     []Address        `msg:"owners" json:"owners"`
+	Signatures []OwnerSignature `msg:"signatures" json:"signatures"`
+}
+
+type OwnerSignature struct {
+	Owner     Address   `msg:"owner,extension" json:"owner"`
+	Signature Signature `msg:"signature,extension" json:"signature"`
+}
+
+// MultiSigProposal is a block of a multi-signature account which is waiting for the signatures of the owners
+type MultiSigProposal struct {
+	Block     *StateBlock `msg:"block" json:"block"`
+	Timestamp int64       `msg:"timestamp" json:"timestamp"`
+}
+
+// NewMultiSig returns the multisig of the owners, the owners are sorted so the address does not depend on the order
+func NewMultiSig(threshold uint8, owners []Address) (*MultiSig, error) {
+	m := &MultiSig{
+		Threshold: threshold,
+		Owners:    make([]Address, len(owners)),
+	}
+	copy(m.Owners, owners)
+	sort.Slice(m.Owners, func(i, j int) bool {
+		return bytes.Compare(m.Owners[i][:], m.Owners[j][:]) < 0
+	})
+	if err := m.check(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MultiSigAddress returns the address of the multi-signature account
+func MultiSigAddress(threshold uint8, owners []Address) (Address, error) {
+	m, err := NewMultiSig(threshold, owners)
+	if err != nil {
+		return ZeroAddress, err
+	}
+	return m.Address(), nil
+}
+
+func (m *MultiSig) check() error {
+	if len(m.Owners) == 0 || len(m.Owners) > MaxMultiSigOwners {
+		return ErrMultiSigOwners
+	}
+	if m.Threshold == 0 || int(m.Threshold) > len(m.Owners) {
+		return ErrMultiSigThreshold
+	}
+	for i := 1; i < len(m.Owners); i++ {
+		// owners must be sorted and distinct
+		if bytes.Compare(m.Owners[i-1][:], m.Owners[i][:]) >= 0 {
+			return ErrMultiSigOwners
+		}
+	}
+	return nil
+}
+
+// Address returns the address derived from the threshold and the owners
+func (m *MultiSig) Address() Address {
+	buf := new(bytes.Buffer)
+	buf.Write(multiSigPrefix)
+	buf.WriteByte(m.Threshold)
+	for _, o := range m.Owners {
+		buf.Write(o[:])
+	}
+	return Address(HashData(buf.Bytes()))
+}
+
+func (m *MultiSig) IsOwner(address Address) bool {
+	for _, o := range m.Owners {
+		if o == address {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSignature adds the signature of the owner to the block hash, the previous signature of the owner is replaced
+func (m *MultiSig) AddSignature(owner Address, hash Hash, signature Signature) error {
+	if !m.IsOwner(owner) {
+		return ErrMultiSigNotOwner
+	}
+	if !owner.Verify(hash[:], signature[:]) {
+		return fmt.Errorf("invalid signature of owner %s", owner)
+	}
+	for i, s := range m.Signatures {
+		if s.Owner == owner {
+			m.Signatures[i].Signature = signature
+			return nil
+		}
+	}
+	m.Signatures = append(m.Signatures, OwnerSignature{Owner: owner, Signature: signature})
+	return nil
+}
+
+// Signed returns the count of distinct owners whose signature of the hash is valid
+func (m *MultiSig) Signed(hash Hash) int {
+	signed := make(map[Address]bool)
+	for _, s := range m.Signatures {
+		if signed[s.Owner] || !m.IsOwner(s.Owner) {
+			continue
+		}
+		if s.Owner.Verify(hash[:], s.Signature[:]) {
+			signed[s.Owner] = true
+		}
+	}
+	return len(signed)
+}
+
+// Verify checks the multisig belongs to the address, and at least threshold owners signed the hash
+func (m *MultiSig) Verify(address Address, hash Hash) error {
+	if err := m.check(); err != nil {
+		return err
+	}
+	if m.Address() != address {
+		return ErrMultiSigAddress
+	}
+	if m.Signed(hash) < int(m.Threshold) {
+		return ErrMultiSigSignatures
+	}
+	return nil
+}
+
+// MultiSigCancelHash returns the hash an owner signs to cancel the proposal of the block hash, it differs from
+// the block hash, so the signature approving the block can not be used to cancel the proposal
+func MultiSigCancelHash(hash Hash) Hash {
+	buf := new(bytes.Buffer)
+	buf.Write(multiSigCancelPrefix)
+	buf.Write(hash[:])
+	return HashData(buf.Bytes())
+}
+
+func (p *MultiSigProposal) Serialize() ([]byte, error) {
+	return p.MarshalMsg(nil)
+}
+
+func (p *MultiSigProposal) Deserialize(text []byte) error {
+	_, err := p.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
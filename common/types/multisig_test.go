@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newTestAccounts(t *testing.T, n int) []*Account {
+	seed, err := NewSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts := make([]*Account, n)
+	for i := range accounts {
+		if accounts[i], err = seed.Account(uint32(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return accounts
+}
+
+func TestNewMultiSig(t *testing.T) {
+	accounts := newTestAccounts(t, 3)
+	a1, a2, a3 := accounts[0].Address(), accounts[1].Address(), accounts[2].Address()
+
+	addr1, err := MultiSigAddress(2, []Address{a1, a2, a3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr2, err := MultiSigAddress(2, []Address{a3, a1, a2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr1 != addr2 {
+		t.Fatal("address should not depend on the order of owners")
+	}
+	if addr3, _ := MultiSigAddress(1, []Address{a1, a2, a3}); addr3 == addr1 {
+		t.Fatal("address should depend on the threshold")
+	}
+
+	for _, c := range []struct {
+		threshold uint8
+		owners    []Address
+	}{
+		{0, []Address{a1, a2}},
+		{3, []Address{a1, a2}},
+		{1, nil},
+		{1, []Address{a1, a1}},
+		{1, make([]Address, MaxMultiSigOwners+1)},
+	} {
+		if _, err := NewMultiSig(c.threshold, c.owners); err == nil {
+			t.Fatal("multisig should be invalid", c.threshold, c.owners)
+		}
+	}
+}
+
+func TestMultiSig_Verify(t *testing.T) {
+	accounts := newTestAccounts(t, 4)
+	m, err := NewMultiSig(2, []Address{accounts[0].Address(), accounts[1].Address(), accounts[2].Address()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &StateBlock{
+		Type:    Send,
+		Address: m.Address(),
+		Balance: ZeroBalance, Vote: ZeroBalance, Network: ZeroBalance, Storage: ZeroBalance, Oracle: ZeroBalance,
+		MultiSig: m,
+	}
+	hash := blk.GetHash()
+
+	if err := m.AddSignature(accounts[3].Address(), hash, accounts[3].Sign(hash)); err != ErrMultiSigNotOwner {
+		t.Fatal(err)
+	}
+	if err := m.AddSignature(accounts[0].Address(), hash, accounts[1].Sign(hash)); err == nil {
+		t.Fatal("signature should be invalid")
+	}
+	if err := m.AddSignature(accounts[0].Address(), hash, accounts[0].Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	// signing again does not count twice
+	if err := m.AddSignature(accounts[0].Address(), hash, accounts[0].Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Verify(blk.Address, hash); err != ErrMultiSigSignatures {
+		t.Fatal(err)
+	}
+	if err := m.AddSignature(accounts[2].Address(), hash, accounts[2].Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Verify(blk.Address, hash); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Verify(accounts[0].Address(), hash); err != ErrMultiSigAddress {
+		t.Fatal(err)
+	}
+
+	// multisig is serialized with the block but not hashed
+	data, err := blk.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk2 := new(StateBlock)
+	if err := blk2.Deserialize(data); err != nil {
+		t.Fatal(err)
+	}
+	if blk2.GetHash() != hash || blk2.MultiSig == nil || blk2.MultiSig.Verify(blk2.Address, hash) != nil {
+		t.Fatal("invalid deserialized block")
+	}
+	if blk3 := blk.Clone(); blk3.MultiSig == nil || blk3.MultiSig.Signed(hash) != 2 {
+		t.Fatal("invalid cloned block")
+	}
+}
+
+func TestStateBlock_SerializeMultiSig(t *testing.T) {
+	accounts := newTestAccounts(t, 2)
+	b := &StateBlock{Type: Send, Address: accounts[0].Address()}
+
+	buff, err := b.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buff, []byte("multiSig")) {
+		t.Fatal("nil multisig should be omitted")
+	}
+	b2 := new(StateBlock)
+	if err := b2.Deserialize(buff); err != nil || b2.MultiSig != nil || b2.GetHash() != b.GetHash() {
+		t.Fatal("invalid block", err, b2)
+	}
+
+	if b.MultiSig, err = NewMultiSig(1, []Address{accounts[0].Address(), accounts[1].Address()}); err != nil {
+		t.Fatal(err)
+	}
+	if buff, err = b.Serialize(); err != nil {
+		t.Fatal(err)
+	}
+	b3 := new(StateBlock)
+	if err := b3.Deserialize(buff); err != nil || b3.MultiSig == nil || b3.MultiSig.Address() != b.MultiSig.Address() {
+		t.Fatal("invalid multisig block", err, b3)
+	}
+}
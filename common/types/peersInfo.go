@@ -7,6 +7,15 @@ type PeerInfo struct {
 	Version        string  `json:"version"`
 	Rtt            float64 `json:"rtt"`
 	LastUpdateTime string  `json:"lastUpdateTime"`
+	// unix seconds the peer is banned until, it is not banned if the time is passed
+	BannedUntil int64  `json:"bannedUntil,omitempty"`
+	BanReason   string `json:"banReason,omitempty"`
+	// bandwidth and messages dropped for exceeding the limits of the connected peer, they are not persisted
+	TotalIn  int64             `json:"totalIn,omitempty" msg:"-"`
+	TotalOut int64             `json:"totalOut,omitempty" msg:"-"`
+	RateIn   float64           `json:"rateIn,omitempty" msg:"-"`
+	RateOut  float64           `json:"rateOut,omitempty" msg:"-"`
+	Dropped  map[string]uint64 `json:"dropped,omitempty" msg:"-"`
 }
 
 func (p *PeerInfo) Serialize() ([]byte, error) {
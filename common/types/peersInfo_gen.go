@@ -54,6 +54,18 @@ func (z *PeerInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "LastUpdateTime")
 				return
 			}
+		case "BannedUntil":
+			z.BannedUntil, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "BannedUntil")
+				return
+			}
+		case "BanReason":
+			z.BanReason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "BanReason")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -67,9 +79,9 @@ func (z *PeerInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *PeerInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 5
+	// map header, size 7
 	// write "PeerID"
-	err = en.Append(0x85, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
+	err = en.Append(0x87, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -118,15 +130,35 @@ func (z *PeerInfo) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "LastUpdateTime")
 		return
 	}
+	// write "BannedUntil"
+	err = en.Append(0xab, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.BannedUntil)
+	if err != nil {
+		err = msgp.WrapError(err, "BannedUntil")
+		return
+	}
+	// write "BanReason"
+	err = en.Append(0xa9, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.BanReason)
+	if err != nil {
+		err = msgp.WrapError(err, "BanReason")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *PeerInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
+	// map header, size 7
 	// string "PeerID"
-	o = append(o, 0x85, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
+	o = append(o, 0x87, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
 	o = msgp.AppendString(o, z.PeerID)
 	// string "Address"
 	o = append(o, 0xa7, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
@@ -140,6 +172,12 @@ func (z *PeerInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "LastUpdateTime"
 	o = append(o, 0xae, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65)
 	o = msgp.AppendString(o, z.LastUpdateTime)
+	// string "BannedUntil"
+	o = append(o, 0xab, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c)
+	o = msgp.AppendInt64(o, z.BannedUntil)
+	// string "BanReason"
+	o = append(o, 0xa9, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.BanReason)
 	return
 }
 
@@ -191,6 +229,18 @@ func (z *PeerInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "LastUpdateTime")
 				return
 			}
+		case "BannedUntil":
+			z.BannedUntil, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BannedUntil")
+				return
+			}
+		case "BanReason":
+			z.BanReason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BanReason")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -205,6 +255,6 @@ func (z *PeerInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *PeerInfo) Msgsize() (s int) {
-	s = 1 + 7 + msgp.StringPrefixSize + len(z.PeerID) + 8 + msgp.StringPrefixSize + len(z.Address) + 8 + msgp.StringPrefixSize + len(z.Version) + 4 + msgp.Float64Size + 15 + msgp.StringPrefixSize + len(z.LastUpdateTime)
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.PeerID) + 8 + msgp.StringPrefixSize + len(z.Address) + 8 + msgp.StringPrefixSize + len(z.Version) + 4 + msgp.Float64Size + 15 + msgp.StringPrefixSize + len(z.LastUpdateTime) + 12 + msgp.Int64Size + 10 + msgp.StringPrefixSize + len(z.BanReason)
 	return
 }
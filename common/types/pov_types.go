@@ -59,3 +59,17 @@ func NewPovMineBlock() *PovMineBlock {
 	mineBlock.Body = mineBlock.Block.GetBody()
 	return mineBlock
 }
+
+type PovStratumWorkerStat struct {
+	Worker         string      `json:"worker"`
+	MinerAddr      Address     `json:"minerAddr"`
+	AlgoType       PovAlgoType `json:"algoType"`
+	Connections    int         `json:"connections"`
+	Difficulty     float64     `json:"difficulty"`
+	AcceptedShares uint64      `json:"acceptedShares"`
+	AcceptedDiff   float64     `json:"acceptedDiff"`
+	RejectedShares uint64      `json:"rejectedShares"`
+	StaleShares    uint64      `json:"staleShares"`
+	BlocksFound    uint64      `json:"blocksFound"`
+	LastShareTime  int64       `json:"lastShareTime"`
+}
@@ -0,0 +1,31 @@
+package types
+
+//go:generate msgp
+
+// RepPeriodStat is the heartbeats, votes and vote latency of a representative in an online period
+type RepPeriodStat struct {
+	Account    Address `msg:"a,extension" json:"account"`
+	HeartCount uint64  `msg:"h" json:"heartCount"`
+	VoteCount  uint64  `msg:"v" json:"voteCount"`
+	// sum of milliseconds from the start of the elections to the votes
+	LatencySum uint64 `msg:"l" json:"latencySum"`
+}
+
+// RepOnlineStats is the statistics of all representatives in an online period, a period is DPosOnlinePeriod pov blocks
+type RepOnlineStats struct {
+	Period     uint64           `msg:"p" json:"period"`
+	BlockCount uint64           `msg:"b" json:"blockCount"`
+	Stats      []*RepPeriodStat `msg:"s" json:"stats"`
+}
+
+func (s *RepOnlineStats) Serialize() ([]byte, error) {
+	return s.MarshalMsg(nil)
+}
+
+func (s *RepOnlineStats) Deserialize(text []byte) error {
+	_, err := s.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -335,6 +336,17 @@ func (cm *CfgManager) DiffOther(cfg *Config) (string, error) {
 	return diff, nil
 }
 
+// Pending returns the changed config not committed yet
+func (cm *CfgManager) Pending() (*Config, error) {
+	cm.locker.Lock()
+	defer cm.locker.Unlock()
+
+	if cm.isDirty.Load() && cm.cfgB != nil {
+		return cm.cfgB.Clone()
+	}
+	return nil, errors.New("cfg not changed")
+}
+
 // Diff the changed config
 func (cm *CfgManager) Diff() (string, error) {
 	cm.locker.Lock()
@@ -352,6 +364,68 @@ func (cm *CfgManager) Diff() (string, error) {
 	return "", errors.New("cfg not changed")
 }
 
+// ChangedKeys returns the sorted json keys changed between the two configs, nested keys are joined by dot,
+// like p2p.bootNode or metrics.influx.url
+func ChangedKeys(old, new *Config) ([]string, error) {
+	o, err := toMap(old)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toMap(new)
+	if err != nil {
+		return nil, err
+	}
+	keys := diffMap("", o, n)
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func toMap(cfg *Config) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	if cfg == nil {
+		return m, nil
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func diffMap(prefix string, o, n map[string]interface{}) []string {
+	var keys []string
+	for k, ov := range o {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		nv, ok := n[k]
+		if !ok {
+			keys = append(keys, key)
+			continue
+		}
+		om, ok1 := ov.(map[string]interface{})
+		nm, ok2 := nv.(map[string]interface{})
+		if ok1 && ok2 {
+			keys = append(keys, diffMap(key, om, nm)...)
+		} else if !reflect.DeepEqual(ov, nv) {
+			keys = append(keys, key)
+		}
+	}
+	for k := range n {
+		if _, ok := o[k]; !ok {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
 func (cm *CfgManager) backUp(content []byte) {
 	backup := filepath.Join(filepath.Dir(cm.ConfigFile),
 		fmt.Sprintf("qlc_back_%s.json", time.Now().Format("2006-01-02T15-04")))
@@ -463,3 +463,35 @@ func TestCfgManager_PatchParams(t *testing.T) {
 		t.Fatal("invalid cfg")
 	}
 }
+
+func TestChangedKeys(t *testing.T) {
+	cfg, err := DefaultConfig(configDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg2, err := cfg.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys, err := ChangedKeys(cfg, cfg2); err != nil || len(keys) != 0 {
+		t.Fatal(err, keys)
+	}
+
+	cfg2.LogLevel = "debug"
+	cfg2.P2P.BootNodes = append(cfg2.P2P.BootNodes, "127.0.0.1:19737/msg")
+	cfg2.Metrics.Influx.URL = "http://127.0.0.1:8086"
+	cfg2.Privacy = nil
+	keys, err := ChangedKeys(cfg, cfg2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{"logLevel", "metrics.influx.url", "p2p.bootNode", "privacy"}
+	if len(keys) != len(expect) {
+		t.Fatal(keys)
+	}
+	for i := range keys {
+		if keys[i] != expect[i] {
+			t.Fatal(keys)
+		}
+	}
+}
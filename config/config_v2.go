@@ -47,6 +47,52 @@ type RPCConfigV2 struct {
 	IPCEnabled    bool        `json:"ipcEnabled"`
 	PublicModules []string    `json:"publicModules"`
 	GRPCConfig    *GRPCConfig `json:"gRPCConfig"`
+	Auth          *RPCAuth    `json:"auth"`
+	// TLS of HTTP and WebSocket endpoints
+	TLS *TLSConfig `json:"tls"`
+}
+
+// TLSConfig serves the endpoint over TLS, client certificates are required and verified if ClientCAFile is set
+type TLSConfig struct {
+	Enable   bool   `json:"enable"`
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	// PEM bundle of CAs to verify client certificates, mutual TLS is disabled if empty
+	ClientCAFile string `json:"clientCAFile"`
+	// interval in seconds to check whether the files are modified and reload them, reload is disabled if 0
+	ReloadInterval int `json:"reloadInterval"`
+}
+
+// RPCAuth authenticates and authorizes calls of HTTP, WebSocket, IPC and gRPC endpoints
+type RPCAuth struct {
+	Enable bool `json:"enable"`
+	// HMAC secret to verify HS256 JWT bearer tokens, JWT is disabled if empty
+	JWTSecret string `json:"jwtSecret"`
+	// role of calls without credentials, they are rejected if empty
+	AnonymousRole string `json:"anonymousRole"`
+	// role of IPC connections, the access to the IPC endpoint is the credential
+	IPCRole string     `json:"ipcRole"`
+	APIKeys []*APIKey  `json:"apiKeys"`
+	Roles   []*RPCRole `json:"roles"`
+}
+
+type APIKey struct {
+	Name string `json:"name" validate:"nonzero"`
+	Key  string `json:"key" validate:"nonzero"`
+	Role string `json:"role" validate:"nonzero"`
+	// calls per second and burst of the key, the limit of the role is used if Rate is 0
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+type RPCRole struct {
+	Name string `json:"name" validate:"nonzero"`
+	// rules like ledger.*, net.peers or *, deny rules take precedence over allow rules
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+	// calls per second and burst of each key of the role, no limit if Rate is 0
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
 }
 
 type GRPCConfig struct {
@@ -57,6 +103,8 @@ type GRPCConfig struct {
 	// TCP or UNIX socket address for the Restful server to listen on
 	HTTPListenAddress      string `json:"httpListenAddress"`
 	MaxSubscriptionClients int    `json:"maxSubClients"`
+	// TLS of the gRPC server and the Restful server
+	TLS *TLSConfig `json:"tls"`
 }
 
 type DiscoveryConfigV2 struct {
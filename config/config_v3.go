@@ -40,6 +40,8 @@ var (
 type DBConfig struct {
 	ConnectionString string `json:"connectionString"`
 	Driver           string `json:"driver"`
+	// key-value storage engine of ledger, badger/leveldb/memory, empty means badger
+	Engine string `json:"engine"`
 }
 
 func defaultDb(dir string) *DBConfig {
@@ -51,5 +53,6 @@ func defaultDb(dir string) *DBConfig {
 	return &DBConfig{
 		ConnectionString: fmt.Sprintf("file:%s?_auth&_auth_user=qlcchain&_auth_pass=%s", d, pw),
 		Driver:           "sqlite3",
+		Engine:           "badger",
 	}
 }
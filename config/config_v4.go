@@ -16,6 +16,26 @@ type PoVConfig struct {
 	Coinbase     string       `json:"coinbase" validate:"address"`
 	AlgoName     string       `json:"algoName"`
 	ChainParams  *ChainParams `json:"chainParams"`
+	Stratum      *Stratum     `json:"stratum"`
+}
+
+type Stratum struct {
+	Enable bool           `json:"enable"`
+	Ports  []*StratumPort `json:"ports"`
+	// Time in seconds between shares that vardiff aims for, 0 means fixed difficulty
+	TargetShareTime int `json:"targetShareTime"`
+	// Time in seconds between share difficulty adjustments of a connection
+	RetargetTime   int `json:"retargetTime"`
+	MaxConnections int `json:"maxConnections"`
+}
+
+type StratumPort struct {
+	// TCP address for the stratum server to listen on, like tcp://0.0.0.0:3333
+	ListenAddress string  `json:"listenAddress"`
+	AlgoName      string  `json:"algoName"`
+	Difficulty    float64 `json:"difficulty"`
+	MinDifficulty float64 `json:"minDifficulty"`
+	MaxDifficulty float64 `json:"maxDifficulty"`
 }
 
 type ChainParams struct {
@@ -44,5 +64,20 @@ func defaultPoV() *PoVConfig {
 		ChainParams: &ChainParams{
 			MinerPledge: common.PovMinerPledgeAmountMin,
 		},
+		Stratum: defaultStratum(),
+	}
+}
+
+func defaultStratum() *Stratum {
+	return &Stratum{
+		Enable: false,
+		Ports: []*StratumPort{
+			{ListenAddress: "tcp://0.0.0.0:3333", AlgoName: "SHA256D", Difficulty: 65536, MinDifficulty: 1024, MaxDifficulty: 1 << 32},
+			{ListenAddress: "tcp://0.0.0.0:3334", AlgoName: "X11", Difficulty: 64, MinDifficulty: 1, MaxDifficulty: 1 << 24},
+			{ListenAddress: "tcp://0.0.0.0:3335", AlgoName: "SCRYPT", Difficulty: 64, MinDifficulty: 1, MaxDifficulty: 1 << 24},
+		},
+		TargetShareTime: 15,
+		RetargetTime:    90,
+		MaxConnections:  1024,
 	}
 }
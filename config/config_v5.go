@@ -18,9 +18,16 @@ type ConfigV5 struct {
 }
 
 type MetricsConfig struct {
-	Enable         bool    `json:"enable"`
-	SampleInterval int     `json:"sampleInterval" validate:"min=1"`
-	Influx         *Influx `json:"influx"`
+	Enable         bool        `json:"enable"`
+	SampleInterval int         `json:"sampleInterval" validate:"min=1"`
+	Influx         *Influx     `json:"influx"`
+	Prometheus     *Prometheus `json:"prometheus"`
+}
+
+type Prometheus struct {
+	Enable bool `json:"enable"`
+	// HTTP address for the /metrics endpoint to listen on
+	ListenAddress string `json:"listenAddress"`
 }
 
 type Influx struct {
@@ -60,5 +67,9 @@ func defaultMetrics() *MetricsConfig {
 			Password: "",
 			Interval: 10,
 		},
+		Prometheus: &Prometheus{
+			Enable:        false,
+			ListenAddress: "127.0.0.1:9747",
+		},
 	}
 }
@@ -1,7 +1,42 @@
 package config
 
+import "github.com/qlcchain/go-qlc/common/util"
+
 type ConfigV8 struct {
 	ConfigV7 `mapstructure:",squash"`
+	Log      *LogConfig      `json:"log"`
+	P2PLimit *P2PLimitConfig `json:"p2pLimit"`
+	// votes and decisions of the latest DPoS elections
+	ElectionTrace *ElectionTraceConfig `json:"electionTrace"`
+}
+
+type LogConfig struct {
+	// encoding of console output, console or json, the log file is always encoded by json
+	Encoding string `json:"encoding" validate:"regexp=^(console|json)?$"`
+	// level of the named loggers, in the format of module:level, e.g. dpos:debug
+	Levels []string `json:"levels"`
+}
+
+// P2PLimitConfig limits the messages received from peers by message type, messages exceeding the limits are dropped.
+// It is disabled by default, the default limits are a starting point to be tuned against the traffic of the node
+type P2PLimitConfig struct {
+	Enable bool `json:"enable"`
+	// limit of each peer on direct streams, in the format of type:rate:burst, e.g. publishReq:100:200, rate is
+	// messages per second, gossip relayed by the peer is only limited by globalLimits
+	PeerLimits []string `json:"peerLimits"`
+	// limit of all peers, in the same format as peerLimits
+	GlobalLimits []string `json:"globalLimits"`
+	// max milliseconds to stop reading the stream of a peer exceeding the limits before its message is dropped
+	MaxWait int `json:"maxWait" validate:"min=0"`
+}
+
+// ElectionTraceConfig keeps the traces of the latest elections in a ring buffer
+type ElectionTraceConfig struct {
+	Enable bool `json:"enable"`
+	// count of the latest elections traced
+	Size int `json:"size" validate:"min=1"`
+	// persist the traces in the ledger, so they survive restarts
+	Persist bool `json:"persist"`
 }
 
 func DefaultConfigV8(dir string) (*ConfigV8, error) {
@@ -10,5 +45,66 @@ func DefaultConfigV8(dir string) (*ConfigV8, error) {
 	cfg.ConfigV7 = *cfg7
 	cfg.RPC.PublicModules = defaultModules()
 	cfg.RPC.GRPCConfig = defaultGRPCConfig()
+	cfg.RPC.Auth = defaultRPCAuth()
+	cfg.RPC.TLS = defaultTLSConfig()
+	cfg.RPC.GRPCConfig.TLS = defaultTLSConfig()
+	cfg.Log = defaultLogConfig()
+	cfg.P2PLimit = defaultP2PLimitConfig()
+	cfg.ElectionTrace = defaultElectionTraceConfig()
 	return &cfg, nil
 }
+
+func defaultLogConfig() *LogConfig {
+	return &LogConfig{
+		Encoding: "console",
+		Levels:   []string{},
+	}
+}
+
+func defaultP2PLimitConfig() *P2PLimitConfig {
+	return &P2PLimitConfig{
+		Enable: false,
+		PeerLimits: []string{
+			"publishReq:100:200", "confirmReq:100:200", "confirmAck:500:1000", "frontierReq:1:5",
+			"bulkPullReq:20:50", "povPublishReq:20:50", "povBulkPullReq:20:50",
+		},
+		GlobalLimits: []string{"publishReq:1000:2000", "confirmReq:1000:2000", "bulkPullReq:100:200"},
+		MaxWait:      1000,
+	}
+}
+
+func defaultElectionTraceConfig() *ElectionTraceConfig {
+	return &ElectionTraceConfig{
+		Enable:  true,
+		Size:    1024,
+		Persist: false,
+	}
+}
+
+func defaultTLSConfig() *TLSConfig {
+	return &TLSConfig{
+		Enable:         false,
+		ReloadInterval: 60,
+	}
+}
+
+func defaultRPCAuth() *RPCAuth {
+	return &RPCAuth{
+		Enable:        false,
+		AnonymousRole: "public",
+		IPCRole:       "admin",
+		APIKeys: []*APIKey{
+			{Name: "admin", Key: util.RandomFixedString(tokenLength), Role: "admin"},
+		},
+		Roles: []*RPCRole{
+			{Name: "admin", Allow: []string{"*"}},
+			{
+				Name:  "public",
+				Allow: []string{"*"},
+				Deny:  []string{"wallet.*", "config.*", "debug.*", "ptmkey.*", "privacy.*", "net.banPeer", "net.unbanPeer"},
+				Rate:  20,
+				Burst: 40,
+			},
+		},
+	}
+}
@@ -127,8 +127,10 @@ func (act *ActiveTrx) checkVotes() {
 		}
 
 		act.roots.Delete(el.vote.id)
+		el.traceDecide(DecisionExpired, types.ZeroHash)
 		el.cleanBlockInfo()
 		act.dps.lv.RollbackUnchecked(hash)
+		electionExpiredCounter.Inc(1)
 
 		if dps.isReceivedFrontier(hash) {
 			dps.logger.Warnf("frontier[%s] was not confirmed in %d seconds", hash, waitingVoteMaxTime)
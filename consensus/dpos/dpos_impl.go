@@ -119,6 +119,7 @@ type DPoS struct {
 	syncStateNotifyWait *sync.WaitGroup
 	totalVote           map[types.Address]types.Balance
 	online              gcache.Cache
+	savedOnline         map[uint64]*types.RepOnlineStats // saved statistics of the periods before restart
 	confirmedBlocks     *cache
 	lastSendHeight      uint64
 	curPovHeight        uint64
@@ -134,6 +135,7 @@ type DPoS struct {
 	tps                 [10]uint32
 	block2Ledger        chan struct{}
 	pf                  *perfInfo
+	traces              *electionTraces
 	lockPool            *sync.Map
 	feb                 *event.FeedEventBus
 	febRpcMsgCh         chan *topic.EventRPCSyncCallMsg
@@ -212,6 +214,13 @@ func NewDPoS(cfgFile string) *DPoS {
 	if err == nil {
 		dps.curPovHeight = pb.Header.BasHdr.Height
 	}
+	dps.loadOnlineStats()
+
+	if cfg != nil {
+		if dps.traces, err = newElectionTraces(cfg.ElectionTrace, l.DBStore()); err != nil {
+			dps.logger.Errorf("load election traces: %s", err)
+		}
+	}
 
 	// dps.confirmedBlocks.evictedFunc = func(key interface{}, val interface{}) {
 	// 	hash := key.(types.Hash)
@@ -299,7 +308,9 @@ func (dps *DPoS) Start() {
 			dps.checkSyncFinished()
 		case pb := <-dps.povChange:
 			dps.logger.Infof("pov height changed [%d]->[%d]", dps.curPovHeight, pb.Header.BasHdr.Height)
+			period := dps.curPovHeight / common.DPosOnlinePeriod
 			dps.curPovHeight = pb.Header.BasHdr.Height
+			dps.saveOnlineStats(period)
 
 			if dps.povSyncState == topic.SyncDone {
 				// need calculate heart num, so use the pov height to trigger online
@@ -377,7 +388,7 @@ func (dps *DPoS) Start() {
 		case <-timerGC.C:
 			dps.confirmedBlocks.gc()
 		case vh := <-dps.repVH:
-			dps.heartAndVoteIncDo(vh.hash, vh.addr, vh.kind, vh.height)
+			dps.heartAndVoteIncDo(vh.hash, vh.addr, vh.kind, vh.height, vh.time)
 		case height := <-dps.gapHeight:
 			err := dps.ledger.PovHeightAddGap(height)
 			if err != nil {
@@ -443,6 +454,8 @@ func (dps *DPoS) RPC(kind uint, in, out interface{}) {
 		go dps.feedBlocks()
 	case common.RpcDPoSDebug:
 		dps.debug()
+	case common.RpcDPoSElectionTrace:
+		dps.electionTrace(in, out)
 	}
 }
 
@@ -26,10 +26,12 @@ type Election struct {
 	status   electionStatus
 	dps      *DPoS
 	lastTime int64
+	start    time.Time
 	voteHash types.Hash //vote for this hash
 	blocks   *sync.Map
 	frontier *sync.Map
 	valid    int32
+	trace    *electionTrace
 }
 
 func newElection(dps *DPoS, block *types.StateBlock) *Election {
@@ -42,14 +44,20 @@ func newElection(dps *DPoS, block *types.StateBlock) *Election {
 		status:   status,
 		dps:      dps,
 		lastTime: time.Now().Unix(),
+		start:    time.Now(),
 		voteHash: types.ZeroHash,
 		blocks:   new(sync.Map),
 		valid:    1,
 		frontier: new(sync.Map),
 	}
 
+	if dps.traces != nil {
+		el.trace = newElectionTrace(hash, dps.voteThreshold)
+	}
+
 	el.blocks.Store(hash, block)
 	dps.hash2el.Store(hash, el)
+	electionStartedCounter.Inc(1)
 
 	return el
 }
@@ -64,6 +72,7 @@ func (el *Election) voteAction(vi *voteInfo) {
 		el.dps.logger.Infof("recv same ack %s", vi.account)
 		return
 	}
+	el.traceVote(vi)
 
 	el.haveQuorum()
 }
@@ -91,11 +100,13 @@ func (el *Election) voteFrontier(vi *voteInfo) bool {
 		el.dps.logger.Infof("recv same ack %s", vi.account)
 		return false
 	}
+	el.traceVote(vi)
 
 	t := el.tally(true)
 	if !(len(t) > 0) {
 		return false
 	}
+	el.traceTally(t)
 
 	var balance = types.ZeroBalance
 	for _, value := range t {
@@ -117,9 +128,11 @@ func (el *Election) voteFrontier(vi *voteInfo) bool {
 			return true
 		})
 
+		el.traceDecide(DecisionConfirmed, vi.hash)
 		el.cleanBlockInfo()
 		el.dps.acTrx.rollBack(loser)
 		el.dps.acTrx.roots.Delete(el.vote.id)
+		el.confirmed()
 		return true
 	}
 
@@ -133,6 +146,7 @@ func (el *Election) haveQuorum() {
 	if !(len(t) > 0) {
 		return
 	}
+	el.traceTally(t)
 
 	var balance = types.ZeroBalance
 	blk := new(types.StateBlock)
@@ -151,6 +165,7 @@ func (el *Election) haveQuorum() {
 
 		dps.acTrx.roots.Delete(el.vote.id)
 		el.dps.logger.Infof("hash:%s block has confirmed,total vote is [%s]", confirmedHash, balance)
+		el.traceDecide(DecisionConfirmed, confirmedHash)
 
 		if el.status.winner.GetHash() != confirmedHash {
 			dps.logger.Infof("hash:%s ...is loser", el.status.winner.GetHash().String())
@@ -172,6 +187,7 @@ func (el *Election) haveQuorum() {
 		dps.dispatchAckedBlock(blk, confirmedHash, -1)
 		dps.eb.Publish(topic.EventConfirmedBlock, blk)
 		el.cleanBlockInfo()
+		el.confirmed()
 	} else {
 		dps.logger.Infof("wait for enough rep vote for block [%s],current vote is [%s]", confirmedHash, balance)
 	}
@@ -225,6 +241,11 @@ func (el *Election) ifValidAndSetInvalid() bool {
 	return atomic.CompareAndSwapInt32(&el.valid, 1, 0)
 }
 
+func (el *Election) confirmed() {
+	electionConfirmedCounter.Inc(1)
+	confirmationTimer.UpdateSince(el.start)
+}
+
 func (el *Election) isValid() bool {
 	return atomic.LoadInt32(&el.valid) == 1
 }
@@ -237,3 +258,37 @@ func (el *Election) cleanBlockInfo() {
 		return true
 	})
 }
+
+func (el *Election) traceVote(vi *voteInfo) {
+	if el.trace != nil {
+		el.trace.addVote(vi.account, vi.hash, el.dps.ledger.Weight(vi.account))
+	}
+}
+
+func (el *Election) traceTally(t map[types.Hash]*BlockReceivedVotes) {
+	if el.trace != nil {
+		el.trace.update(el.forks(), t)
+	}
+}
+
+// traceDecide keeps the trace of the decided election and notifies it to subscribers
+func (el *Election) traceDecide(decision ElectionDecision, winner types.Hash) {
+	if el.trace == nil {
+		return
+	}
+	el.trace.update(el.forks(), nil)
+	t := el.trace.decide(decision, winner)
+	if err := el.dps.traces.add(t); err != nil {
+		el.dps.logger.Errorf("add election trace of %s: %s", t.Hash, err)
+	}
+	el.dps.eb.Publish(topic.EventElectionTrace, t)
+}
+
+func (el *Election) forks() []types.Hash {
+	forks := make([]types.Hash, 0)
+	el.blocks.Range(func(key, value interface{}) bool {
+		forks = append(forks, key.(types.Hash))
+		return true
+	})
+	return forks
+}
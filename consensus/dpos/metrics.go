@@ -0,0 +1,15 @@
+package dpos
+
+import (
+	"github.com/rcrowley/go-metrics"
+
+	"github.com/qlcchain/go-qlc/monitor"
+)
+
+var (
+	electionStartedCounter   = metrics.NewRegisteredCounter("dpos/election/started", monitor.ChainRegistry)
+	electionConfirmedCounter = metrics.NewRegisteredCounter("dpos/election/confirmed", monitor.ChainRegistry)
+	electionExpiredCounter   = metrics.NewRegisteredCounter("dpos/election/expired", monitor.ChainRegistry)
+	// time from the start of an election to the confirmation of the block
+	confirmationTimer = metrics.NewRegisteredTimer("dpos/confirmation", monitor.ChainRegistry)
+)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/topic"
@@ -23,6 +24,8 @@ type RepAckStatistics struct {
 	HeartCount      uint64 `json:"heartCount"`
 	LastHeartHeight uint64 `json:"-"`
 	VoteCount       uint64 `json:"voteCount"`
+	// sum of milliseconds from the start of the elections to the valid votes
+	LatencySum uint64 `json:"latencySum"`
 }
 
 type RepOnlinePeriod struct {
@@ -37,15 +40,18 @@ type repVoteHeart struct {
 	addr   types.Address
 	kind   onlineKind
 	height uint64
+	time   time.Time
 }
 
 type voteHistory struct {
-	reps map[types.Address]struct{}
+	reps  map[types.Address]struct{}
+	start time.Time
 }
 
 func newVoteHistory() *voteHistory {
 	vh := new(voteHistory)
 	vh.reps = make(map[types.Address]struct{})
+	vh.start = time.Now()
 	return vh
 }
 
@@ -101,6 +107,7 @@ func (dps *DPoS) heartAndVoteInc(hash types.Hash, addr types.Address, kind onlin
 		addr:   addr,
 		kind:   kind,
 		height: dps.curPovHeight,
+		time:   time.Now(),
 	}
 
 	select {
@@ -109,7 +116,7 @@ func (dps *DPoS) heartAndVoteInc(hash types.Hash, addr types.Address, kind onlin
 	}
 }
 
-func (dps *DPoS) heartAndVoteIncDo(hash types.Hash, addr types.Address, kind onlineKind, height uint64) {
+func (dps *DPoS) heartAndVoteIncDo(hash types.Hash, addr types.Address, kind onlineKind, height uint64, voteTime time.Time) {
 	period := dps.curPovHeight / common.DPosOnlinePeriod
 	var repPeriod *RepOnlinePeriod
 
@@ -141,7 +148,7 @@ func (dps *DPoS) heartAndVoteIncDo(hash types.Hash, addr types.Address, kind onl
 			stat.LastHeartHeight = height
 		} else if kind == onlineKindVote {
 			if dps.isValidVote(hash, addr) {
-				stat.VoteCount++
+				dps.voteInc(stat, hash, voteTime)
 			}
 		}
 	} else {
@@ -150,15 +157,30 @@ func (dps *DPoS) heartAndVoteIncDo(hash types.Hash, addr types.Address, kind onl
 			stat.LastHeartHeight = dps.curPovHeight
 		} else {
 			if dps.isValidVote(hash, addr) {
-				stat.VoteCount++
+				dps.voteInc(stat, hash, voteTime)
 			}
 		}
 	}
 }
 
+// voteInc counts the valid vote and its latency from the start of the election of the confirmed block
+func (dps *DPoS) voteInc(stat *RepAckStatistics, hash types.Hash, voteTime time.Time) {
+	stat.VoteCount++
+
+	if val := dps.confirmedBlocks.get(hash); val != nil {
+		if d := voteTime.Sub(val.(*voteHistory).start); d > 0 {
+			stat.LatencySum += uint64(d / time.Millisecond)
+		}
+	}
+}
+
 func (dps *DPoS) confirmedBlockInc(hash types.Hash) {
 	period := dps.curPovHeight / common.DPosOnlinePeriod
-	dps.confirmedBlocks.set(hash, newVoteHistory())
+	vh := newVoteHistory()
+	if el, ok := dps.hash2el.Load(hash); ok {
+		vh.start = el.(*Election).start
+	}
+	dps.confirmedBlocks.set(hash, vh)
 
 	if s, err := dps.online.Get(period); err == nil {
 		repPeriod := s.(*RepOnlinePeriod)
@@ -177,6 +199,75 @@ func (dps *DPoS) confirmedBlockInc(hash types.Hash) {
 	}
 }
 
+// saveOnlineStats saves the statistics of the period to ledger, it is called on every pov height change,
+// so the performance of representatives is queried from ledger without scanning votes. The statistics
+// saved before restart are added, so the saved statistics cover the whole period.
+func (dps *DPoS) saveOnlineStats(period uint64) {
+	s, err := dps.online.Get(period)
+	if err != nil {
+		return
+	}
+	repPeriod := s.(*RepOnlinePeriod)
+
+	stats := &types.RepOnlineStats{
+		Period:     period,
+		BlockCount: atomic.LoadUint64(&repPeriod.BlockCount),
+		Stats:      make([]*types.RepPeriodStat, 0),
+	}
+	saved := make(map[types.Address]*types.RepPeriodStat)
+	if ss, ok := dps.savedOnline[period]; ok {
+		stats.BlockCount += ss.BlockCount
+		for _, rs := range ss.Stats {
+			saved[rs.Account] = rs
+		}
+	}
+	repPeriod.Statistic.Range(func(key, value interface{}) bool {
+		addr := key.(types.Address)
+		ras := value.(*RepAckStatistics)
+		rs := &types.RepPeriodStat{
+			Account:    addr,
+			HeartCount: ras.HeartCount,
+			VoteCount:  ras.VoteCount,
+			LatencySum: ras.LatencySum,
+		}
+		if ss, ok := saved[addr]; ok {
+			rs.HeartCount += ss.HeartCount
+			rs.VoteCount += ss.VoteCount
+			rs.LatencySum += ss.LatencySum
+			delete(saved, addr)
+		}
+		stats.Stats = append(stats.Stats, rs)
+		return true
+	})
+	for _, ss := range saved {
+		stats.Stats = append(stats.Stats, ss)
+	}
+
+	if err := dps.ledger.AddOrUpdateRepOnlineStats(stats); err != nil {
+		dps.logger.Errorf("save online stats of period %d err %s", period, err)
+	}
+}
+
+// loadOnlineStats loads the saved statistics of the current and the previous period after restart. They are
+// kept apart from the online statistics, so representatives are online only by the hearts and votes received
+// after restart, and are only added to the statistics saved later.
+func (dps *DPoS) loadOnlineStats() {
+	period := dps.curPovHeight / common.DPosOnlinePeriod
+	start := uint64(0)
+	if period > 0 {
+		start = period - 1
+	}
+
+	dps.savedOnline = make(map[uint64]*types.RepOnlineStats)
+	err := dps.ledger.GetRepOnlineStatsByRange(start, period, func(stats *types.RepOnlineStats) error {
+		dps.savedOnline[stats.Period] = stats
+		return nil
+	})
+	if err != nil {
+		dps.logger.Error("load online stats err", err)
+	}
+}
+
 func (dps *DPoS) isOnline(addr types.Address) bool {
 	period := dps.curPovHeight/common.DPosOnlinePeriod - 1
 
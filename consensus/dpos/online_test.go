@@ -3,6 +3,7 @@ package dpos
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/mock"
@@ -68,3 +69,67 @@ func TestVoteHistoryUpdate(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestOnlineStats(t *testing.T) {
+	dps := getTestDpos()
+	dps.curPovHeight = common.DPosOnlinePeriod*2 + 1
+	period := dps.curPovHeight / common.DPosOnlinePeriod
+
+	hash := mock.Hash()
+	addr := mock.Address()
+	dps.confirmedBlockInc(hash)
+	vh := dps.confirmedBlocks.get(hash).(*voteHistory)
+	dps.heartAndVoteIncDo(hash, addr, onlineKindVote, dps.curPovHeight, vh.start.Add(300*time.Millisecond))
+	dps.heartAndVoteIncDo(hash, addr, onlineKindHeart, dps.curPovHeight, time.Now())
+
+	dps.saveOnlineStats(period)
+	stats, err := dps.ledger.GetRepOnlineStats(period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.BlockCount != 1 || len(stats.Stats) != 1 {
+		t.Fatal("invalid stats", stats)
+	}
+	s := stats.Stats[0]
+	if s.Account != addr || s.VoteCount != 1 || s.HeartCount != 1 || s.LatencySum != 300 {
+		t.Fatal("invalid rep stats", s)
+	}
+
+	// restart in the same period, the saved stats are added to the stats saved later
+	dps.online.Purge()
+	dps.loadOnlineStats()
+	if _, err := dps.online.Get(period); err == nil {
+		t.Fatal("saved stats should not be used to check online")
+	}
+	hash = mock.Hash()
+	dps.confirmedBlockInc(hash)
+	vh = dps.confirmedBlocks.get(hash).(*voteHistory)
+	dps.heartAndVoteIncDo(hash, addr, onlineKindVote, dps.curPovHeight, vh.start.Add(100*time.Millisecond))
+
+	dps.saveOnlineStats(period)
+	stats, err = dps.ledger.GetRepOnlineStats(period)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.BlockCount != 2 || len(stats.Stats) != 1 {
+		t.Fatal("invalid stats", stats)
+	}
+	s = stats.Stats[0]
+	if s.Account != addr || s.VoteCount != 2 || s.HeartCount != 1 || s.LatencySum != 400 {
+		t.Fatal("invalid rep stats", s)
+	}
+
+	// restart in the next period, the rep is offline until it votes or sends hearts again
+	dps.curPovHeight += common.DPosOnlinePeriod
+	if !dps.isOnline(addr) {
+		t.Fatal("rep should be online before restart")
+	}
+	dps.online.Purge()
+	dps.loadOnlineStats()
+	if _, ok := dps.savedOnline[period]; !ok {
+		t.Fatal("stats of the previous period should be loaded")
+	}
+	if dps.isOnline(addr) {
+		t.Fatal("rep should not be online by the saved stats")
+	}
+}
@@ -488,6 +488,14 @@ func (p *Processor) processMsgDo(bs *consensus.BlockSource) {
 	}
 }
 
+// penalizeSender reports the invalid block to p2p to lower the score of the peer sending it, a block relayed by
+// pubsub is not reported, the relaying peer is not the author of it
+func (p *Processor) penalizeSender(bs *consensus.BlockSource) {
+	if bs.MsgFrom != "" && bs.MsgDirect {
+		p.dps.eb.Publish(topic.EventPeerMisbehave, &p2p.EventPeerMisbehaveMsg{PeerID: bs.MsgFrom, Misbehavior: p2p.MisbehaviorInvalidBlock})
+	}
+}
+
 func (p *Processor) processResult(result process.ProcessResult, bs *consensus.BlockSource) {
 	blk := bs.Block
 	hash := blk.GetHash()
@@ -510,8 +518,10 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 		}
 	case process.BadSignature:
 		dps.logger.Errorf("Bad signature for block: %s", hash)
+		p.penalizeSender(bs)
 	case process.BadWork:
 		dps.logger.Errorf("Bad work for block: %s", hash)
+		p.penalizeSender(bs)
 	case process.BalanceMismatch:
 		dps.logger.Errorf("Balance mismatch for block: %s", hash)
 	case process.Old:
@@ -523,6 +533,7 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 		// dps.processGapSmartContract(blk)
 	case process.InvalidData:
 		dps.logger.Errorf("InvalidData for block: %s", hash)
+		p.penalizeSender(bs)
 	case process.Other:
 		dps.logger.Errorf("UnKnow process result for block: %s", hash)
 	case process.Fork:
@@ -0,0 +1,307 @@
+package dpos
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+var (
+	ErrElectionTraceDisabled = errors.New("election trace is disabled")
+	ErrElectionTraceNotFound = errors.New("election trace not found")
+)
+
+type ElectionDecision string
+
+const (
+	DecisionPending   ElectionDecision = "pending"
+	DecisionConfirmed ElectionDecision = "confirmed"
+	DecisionExpired   ElectionDecision = "expired"
+)
+
+// ElectionVote is the latest vote of a representative, a representative may change its vote to another fork
+type ElectionVote struct {
+	Account types.Address `json:"account"`
+	Hash    types.Hash    `json:"hash"`
+	Weight  types.Balance `json:"weight"`
+	// unix milliseconds the vote arrived
+	Time int64 `json:"time"`
+}
+
+type ElectionTally struct {
+	Hash   types.Hash    `json:"hash"`
+	Weight types.Balance `json:"weight"`
+}
+
+// ElectionTrace records the forks, votes and decision of an election
+type ElectionTrace struct {
+	// block started the election
+	Hash      types.Hash       `json:"hash"`
+	Forks     []types.Hash     `json:"forks"`
+	Votes     []*ElectionVote  `json:"votes"`
+	Tally     []*ElectionTally `json:"tally"`
+	Threshold types.Balance    `json:"threshold"`
+	Decision  ElectionDecision `json:"decision"`
+	Winner    types.Hash       `json:"winner"`
+	// unix milliseconds the election started and ended
+	Start int64 `json:"start"`
+	End   int64 `json:"end,omitempty"`
+}
+
+// electionTrace is updated by the election until it is decided
+type electionTrace struct {
+	lock  sync.Mutex
+	trace *ElectionTrace
+}
+
+func newElectionTrace(hash types.Hash, threshold types.Balance) *electionTrace {
+	return &electionTrace{
+		trace: &ElectionTrace{
+			Hash:      hash,
+			Forks:     []types.Hash{hash},
+			Votes:     make([]*ElectionVote, 0),
+			Tally:     make([]*ElectionTally, 0),
+			Threshold: threshold,
+			Decision:  DecisionPending,
+			Start:     time.Now().UnixNano() / int64(time.Millisecond),
+		},
+	}
+}
+
+// addVote records the vote, it replaces the previous vote of the representative, a nil trace ignores all updates
+func (et *electionTrace) addVote(account types.Address, hash types.Hash, weight types.Balance) {
+	if et == nil {
+		return
+	}
+	et.lock.Lock()
+	defer et.lock.Unlock()
+	v := &ElectionVote{Account: account, Hash: hash, Weight: weight, Time: time.Now().UnixNano() / int64(time.Millisecond)}
+	for i, ev := range et.trace.Votes {
+		if ev.Account == account {
+			et.trace.Votes = append(et.trace.Votes[:i], et.trace.Votes[i+1:]...)
+			break
+		}
+	}
+	et.trace.Votes = append(et.trace.Votes, v)
+}
+
+// update records the forks and tally of the election
+func (et *electionTrace) update(forks []types.Hash, totals map[types.Hash]*BlockReceivedVotes) {
+	if et == nil {
+		return
+	}
+	et.lock.Lock()
+	defer et.lock.Unlock()
+	for _, f := range forks {
+		found := false
+		for _, h := range et.trace.Forks {
+			if h == f {
+				found = true
+				break
+			}
+		}
+		if !found {
+			et.trace.Forks = append(et.trace.Forks, f)
+		}
+	}
+	if totals == nil {
+		return
+	}
+	tally := make([]*ElectionTally, 0, len(totals))
+	for h, t := range totals {
+		tally = append(tally, &ElectionTally{Hash: h, Weight: t.balance})
+	}
+	sort.Slice(tally, func(i, j int) bool {
+		return tally[i].Weight.Compare(tally[j].Weight) == types.BalanceCompBigger
+	})
+	et.trace.Tally = tally
+}
+
+// decide ends the trace and returns the decided trace
+func (et *electionTrace) decide(decision ElectionDecision, winner types.Hash) *ElectionTrace {
+	if et == nil {
+		return nil
+	}
+	et.lock.Lock()
+	defer et.lock.Unlock()
+	et.trace.Decision = decision
+	et.trace.Winner = winner
+	et.trace.End = time.Now().UnixNano() / int64(time.Millisecond)
+	return et.copy()
+}
+
+func (et *electionTrace) snapshot() *ElectionTrace {
+	if et == nil {
+		return nil
+	}
+	et.lock.Lock()
+	defer et.lock.Unlock()
+	return et.copy()
+}
+
+func (et *electionTrace) copy() *ElectionTrace {
+	t := *et.trace
+	t.Forks = append([]types.Hash(nil), et.trace.Forks...)
+	t.Votes = make([]*ElectionVote, 0, len(et.trace.Votes))
+	for _, v := range et.trace.Votes {
+		vc := *v
+		t.Votes = append(t.Votes, &vc)
+	}
+	t.Tally = make([]*ElectionTally, 0, len(et.trace.Tally))
+	for _, tl := range et.trace.Tally {
+		tc := *tl
+		t.Tally = append(t.Tally, &tc)
+	}
+	return &t
+}
+
+// electionTraces keeps the traces of the latest decided elections in a ring buffer, indexed by all forks,
+// the persisted traces are kept the same as the ring buffer
+type electionTraces struct {
+	lock  sync.RWMutex
+	ring  []*ElectionTrace
+	next  int
+	index map[types.Hash]*ElectionTrace
+	store storage.Store
+}
+
+// newElectionTraces returns nil if trace is disabled, traces are loaded from the store if they are persisted
+func newElectionTraces(cfg *config.ElectionTraceConfig, store storage.Store) (*electionTraces, error) {
+	if cfg == nil || !cfg.Enable || cfg.Size <= 0 {
+		return nil, nil
+	}
+	ets := &electionTraces{
+		ring:  make([]*ElectionTrace, cfg.Size),
+		index: make(map[types.Hash]*ElectionTrace),
+	}
+	if !cfg.Persist {
+		return ets, nil
+	}
+	ets.store = store
+
+	traces := make([]*ElectionTrace, 0)
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixElectionTrace)
+	err := store.Iterator(prefix, nil, func(k, v []byte) error {
+		t := new(ElectionTrace)
+		if err := json.Unmarshal(v, t); err != nil {
+			return err
+		}
+		traces = append(traces, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(traces, func(i, j int) bool {
+		return traces[i].End < traces[j].End
+	})
+	for _, t := range traces {
+		if err := ets.add(t); err != nil {
+			return nil, err
+		}
+	}
+	return ets, nil
+}
+
+// add puts the decided trace into the ring buffer, the oldest trace is evicted if the buffer is full,
+// the trace of a previous election of the same block is replaced
+func (ets *electionTraces) add(t *ElectionTrace) error {
+	if ets == nil || t == nil {
+		return nil
+	}
+	ets.lock.Lock()
+	defer ets.lock.Unlock()
+
+	for i, old := range ets.ring {
+		if old != nil && old.Hash == t.Hash {
+			ets.remove(i)
+		}
+	}
+	if old := ets.ring[ets.next]; old != nil {
+		ets.remove(ets.next)
+		if err := ets.delete(old); err != nil {
+			return err
+		}
+	}
+	ets.ring[ets.next] = t
+	ets.next = (ets.next + 1) % len(ets.ring)
+	for _, h := range t.Forks {
+		ets.index[h] = t
+	}
+	return ets.persist(t)
+}
+
+func (ets *electionTraces) remove(i int) {
+	old := ets.ring[i]
+	for _, h := range old.Forks {
+		if ets.index[h] == old {
+			delete(ets.index, h)
+		}
+	}
+	ets.ring[i] = nil
+}
+
+func (ets *electionTraces) get(hash types.Hash) *ElectionTrace {
+	if ets == nil {
+		return nil
+	}
+	ets.lock.RLock()
+	defer ets.lock.RUnlock()
+	return ets.index[hash]
+}
+
+func (ets *electionTraces) persist(t *ElectionTrace) error {
+	if ets.store == nil {
+		return nil
+	}
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixElectionTrace, t.Hash)
+	if err != nil {
+		return err
+	}
+	v, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return ets.store.Put(k, v)
+}
+
+func (ets *electionTraces) delete(t *ElectionTrace) error {
+	if ets.store == nil {
+		return nil
+	}
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixElectionTrace, t.Hash)
+	if err != nil {
+		return err
+	}
+	return ets.store.Delete(k)
+}
+
+// electionTrace returns the trace of the election voting or decided for the block hash
+func (dps *DPoS) electionTrace(in interface{}, out interface{}) {
+	hash := in.(types.Hash)
+	rsp := out.(map[string]interface{})
+
+	if dps.traces == nil {
+		rsp["err"] = ErrElectionTraceDisabled
+		return
+	}
+	if v, ok := dps.hash2el.Load(hash); ok {
+		if t := v.(*Election).trace.snapshot(); t != nil {
+			rsp["err"] = nil
+			rsp["trace"] = t
+			return
+		}
+	}
+	if t := dps.traces.get(hash); t != nil {
+		rsp["err"] = nil
+		rsp["trace"] = t
+		return
+	}
+	rsp["err"] = ErrElectionTraceNotFound
+}
@@ -0,0 +1,115 @@
+package dpos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestElectionTraces(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "trace", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+	defer func() {
+		_ = l.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	cfg := &config.ElectionTraceConfig{Enable: true, Size: 2, Persist: true}
+	ets, err := newElectionTraces(cfg, l.DBStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	traces := make([]*ElectionTrace, 0)
+	for i := 0; i < 3; i++ {
+		et := newElectionTrace(mock.Hash(), types.NewBalance(100))
+		fork := mock.Hash()
+		et.update([]types.Hash{fork}, nil)
+		tr := et.decide(DecisionConfirmed, fork)
+		if err := ets.add(tr); err != nil {
+			t.Fatal(err)
+		}
+		traces = append(traces, tr)
+	}
+
+	// the first trace is evicted
+	if ets.get(traces[0].Hash) != nil || ets.get(traces[0].Forks[1]) != nil {
+		t.Fatal("trace should be evicted")
+	}
+	if tr := ets.get(traces[2].Forks[1]); tr == nil || tr.Hash != traces[2].Hash {
+		t.Fatal("trace should be found by fork")
+	}
+
+	// the trace of a previous election of the same block is replaced
+	et := newElectionTrace(traces[1].Hash, types.NewBalance(100))
+	tr := et.decide(DecisionExpired, types.ZeroHash)
+	if err := ets.add(tr); err != nil {
+		t.Fatal(err)
+	}
+	if ets.get(traces[1].Forks[1]) != nil || ets.get(traces[1].Hash).Decision != DecisionExpired {
+		t.Fatal("trace should be replaced")
+	}
+
+	// reload the persisted traces
+	ets, err = newElectionTraces(cfg, l.DBStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ets.get(traces[0].Hash) != nil || ets.get(traces[2].Hash) == nil || ets.get(traces[1].Hash).Decision != DecisionExpired {
+		t.Fatal("invalid persisted traces")
+	}
+
+	if ets, _ := newElectionTraces(&config.ElectionTraceConfig{Enable: false, Size: 2}, nil); ets != nil {
+		t.Fatal("trace should be disabled")
+	}
+}
+
+func TestElection_trace(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "transaction", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	dps := NewDPoS(cm.ConfigFile)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	dps.voteThreshold = types.NewBalance(100)
+
+	blk := mock.StateBlock()
+	hash := blk.GetHash()
+	el := newElection(dps, blk)
+	account := mock.Address()
+	el.voteAction(&voteInfo{account: account, hash: hash})
+
+	out := make(map[string]interface{})
+	dps.electionTrace(hash, out)
+	if out["err"] != nil {
+		t.Fatal(out["err"])
+	}
+	tr := out["trace"].(*ElectionTrace)
+	if tr.Decision != DecisionPending || len(tr.Votes) != 1 || tr.Votes[0].Account != account || len(tr.Tally) != 1 ||
+		tr.Threshold.Compare(dps.voteThreshold) != types.BalanceCompEqual {
+		t.Fatal("invalid pending trace", tr)
+	}
+
+	el.traceDecide(DecisionExpired, types.ZeroHash)
+	el.cleanBlockInfo()
+	out = make(map[string]interface{})
+	dps.electionTrace(hash, out)
+	if out["err"] != nil || out["trace"].(*ElectionTrace).Decision != DecisionExpired {
+		t.Fatal("invalid decided trace", out)
+	}
+
+	out = make(map[string]interface{})
+	dps.electionTrace(mock.Hash(), out)
+	if out["err"] != ErrElectionTraceNotFound {
+		t.Fatal("trace should not be found")
+	}
+}
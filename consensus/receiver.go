@@ -31,11 +31,11 @@ func (r *Receiver) start() error {
 	r.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
 		switch msg := c.Message().(type) {
 		case *topic.EventPublishMsg:
-			r.ReceivePublish(msg.Block, msg.From)
+			r.ReceivePublish(msg.Block, msg.From, msg.Direct)
 		case *topic.EventConfirmReqMsg:
-			r.ReceiveConfirmReq(msg.Blocks, msg.From)
+			r.ReceiveConfirmReq(msg.Blocks, msg.From, msg.Direct)
 		case *p2p.EventConfirmAckMsg:
-			r.ReceiveConfirmAck(msg.Block, msg.From)
+			r.ReceiveConfirmAck(msg.Block, msg.From, msg.Direct)
 		case types.StateBlockList:
 			r.ReceiveSyncBlock(msg)
 		case *types.StateBlock:
@@ -56,18 +56,20 @@ func (r *Receiver) stop() error {
 	return r.subscriber.UnsubscribeAll()
 }
 
-func (r *Receiver) ReceivePublish(blk *types.StateBlock, msgFrom string) {
+func (r *Receiver) ReceivePublish(blk *types.StateBlock, msgFrom string, direct bool) {
 	r.c.logger.Debugf("receive publish block [%s] from [%s]", blk.GetHash(), msgFrom)
 
 	bs := &BlockSource{
 		Block:     blk,
 		BlockFrom: types.UnSynchronized,
 		Type:      MsgPublishReq,
+		MsgFrom:   msgFrom,
+		MsgDirect: direct,
 	}
 	r.c.ca.ProcessMsg(bs)
 }
 
-func (r *Receiver) ReceiveConfirmReq(blk []*types.StateBlock, msgFrom string) {
+func (r *Receiver) ReceiveConfirmReq(blk []*types.StateBlock, msgFrom string, direct bool) {
 	for _, b := range blk {
 		r.c.logger.Debugf("receive ConfirmReq block [%s] from [%s]", b.GetHash(), msgFrom)
 
@@ -75,17 +77,23 @@ func (r *Receiver) ReceiveConfirmReq(blk []*types.StateBlock, msgFrom string) {
 			Block:     b,
 			BlockFrom: types.UnSynchronized,
 			Type:      MsgConfirmReq,
+			MsgFrom:   msgFrom,
+			MsgDirect: direct,
 		}
 		r.c.ca.ProcessMsg(bs)
 	}
 }
 
-func (r *Receiver) ReceiveConfirmAck(ack *protos.ConfirmAckBlock, msgFrom string) {
+func (r *Receiver) ReceiveConfirmAck(ack *protos.ConfirmAckBlock, msgFrom string, direct bool) {
 	r.c.logger.Debugf("receive ConfirmAck for %d blocks [%s] from [%s]", len(ack.Hash), ack.Hash, msgFrom)
 
 	valid := IsAckSignValidate(ack)
 	if !valid {
 		r.c.logger.Error("ack sign err")
+		if !direct {
+			return
+		}
+		r.eb.Publish(topic.EventPeerMisbehave, &p2p.EventPeerMisbehaveMsg{PeerID: msgFrom, Misbehavior: p2p.MisbehaviorInvalidMessage})
 		return
 	}
 
@@ -20,6 +20,11 @@ type BlockSource struct {
 	BlockFrom types.SynchronizedKind
 	Type      MsgType
 	Para      interface{}
+	// peer the block is received from, it is empty for local and synchronized blocks
+	MsgFrom string
+	// MsgDirect is true if the block is received on a stream from MsgFrom, a block relayed by pubsub is not
+	// authored by MsgFrom, so MsgFrom is not penalized for it
+	MsgDirect bool
 }
 
 func IsAckSignValidate(va *protos.ConfirmAckBlock) bool {
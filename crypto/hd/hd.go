@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package hd implements BIP-39 mnemonics and SLIP-10 ed25519 key derivation.
+// Derived private keys are used as seeds of qlc ed25519 keys, the same way as types.Seed.Account
+package hd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/ed25519"
+)
+
+const (
+	// HardenedOffset is the index offset of hardened keys, SLIP-10 ed25519 only supports hardened derivation
+	HardenedOffset uint32 = 0x80000000
+	// CoinType is the coin type level of the default path
+	CoinType = 1995
+	// SeedSize is the size of the seed generated from a mnemonic
+	SeedSize = 64
+)
+
+// DefaultPath is the path prefix of accounts, the account index is appended as the last hardened level
+var DefaultPath = fmt.Sprintf("m/44'/%d'", CoinType)
+
+var (
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	ErrInvalidPath     = errors.New("invalid derivation path")
+	ErrNotHardened     = errors.New("ed25519 only supports hardened derivation")
+)
+
+var curveKey = []byte("ed25519 seed")
+
+// NewMnemonic generates a mnemonic of 12, 15, 18, 21 or 24 words
+func NewMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", fmt.Errorf("invalid mnemonic words %d", words)
+	}
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic checks the words and the checksum of the mnemonic
+func ValidateMnemonic(mnemonic string) bool {
+	return bip39.IsMnemonicValid(normalize(mnemonic))
+}
+
+// MnemonicToSeed returns the seed of the mnemonic protected by the passphrase, which can be empty
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(normalize(mnemonic), passphrase)
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	return seed, nil
+}
+
+func normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// ParsePath parses path like m/44'/1995'/0', all levels must be hardened
+func ParsePath(path string) ([]uint32, error) {
+	levels := strings.Split(strings.TrimSpace(path), "/")
+	if len(levels) == 0 || levels[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+	indexes := make([]uint32, 0, len(levels)-1)
+	for _, level := range levels[1:] {
+		if !strings.HasSuffix(level, "'") && !strings.HasSuffix(level, "h") {
+			return nil, ErrNotHardened
+		}
+		i, err := strconv.ParseUint(level[:len(level)-1], 10, 31)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+		indexes = append(indexes, uint32(i)+HardenedOffset)
+	}
+	return indexes, nil
+}
+
+// AccountPath returns the path of the account index under the path prefix
+func AccountPath(prefix string, index uint32) string {
+	return fmt.Sprintf("%s/%d'", strings.TrimSuffix(prefix, "/"), index)
+}
+
+// DeriveKey derives the private key and chain code of the path from the seed
+func DeriveKey(seed []byte, path string) (key []byte, chainCode []byte, err error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	mac := hmac.New(sha512.New, curveKey)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode = sum[:32], sum[32:]
+
+	for _, i := range indexes {
+		data := make([]byte, 0, 37)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = append(data, make([]byte, 4)...)
+		binary.BigEndian.PutUint32(data[33:], i)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+		key, chainCode = sum[:32], sum[32:]
+	}
+	return key, chainCode, nil
+}
+
+// DeriveAccount derives the account of the path from the seed
+func DeriveAccount(seed []byte, path string) (*types.Account, error) {
+	key, _, err := DeriveKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	_, priv, err := ed25519.GenerateKey(bytes.NewReader(key))
+	if err != nil {
+		return nil, err
+	}
+	return types.NewAccount(priv), nil
+}
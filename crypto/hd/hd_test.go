@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package hd
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestMnemonic(t *testing.T) {
+	for _, words := range []int{12, 24} {
+		m, err := NewMnemonic(words)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(strings.Fields(m)) != words || !ValidateMnemonic(m) {
+			t.Fatal("invalid mnemonic", m)
+		}
+	}
+	if _, err := NewMnemonic(13); err == nil {
+		t.Fatal("13 words should be invalid")
+	}
+
+	// BIP-39 test vector
+	m := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := MnemonicToSeed("  "+m+" ", "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(seed) != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatal("invalid seed", hex.EncodeToString(seed))
+	}
+	if _, err := MnemonicToSeed(strings.Replace(m, "about", "abandon", 1), ""); err != ErrInvalidMnemonic {
+		t.Fatal("checksum should be invalid", err)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	// SLIP-10 ed25519 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	cases := []struct {
+		path, key, chainCode string
+	}{
+		{"m", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb"},
+		{"m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69"},
+	}
+	for _, c := range cases {
+		key, chainCode, err := DeriveKey(seed, c.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(key) != c.key || hex.EncodeToString(chainCode) != c.chainCode {
+			t.Fatal("invalid key of", c.path, hex.EncodeToString(key), hex.EncodeToString(chainCode))
+		}
+	}
+
+	if _, _, err := DeriveKey(seed, "m/0"); err != ErrNotHardened {
+		t.Fatal("expect not hardened error, got", err)
+	}
+	if _, _, err := DeriveKey(seed, "44'/0'"); err != ErrInvalidPath {
+		t.Fatal("expect invalid path, got", err)
+	}
+
+	a1, err := DeriveAccount(seed, AccountPath(DefaultPath, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, _ := DeriveAccount(seed, DefaultPath+"/1'")
+	if a1.Address() == a2.Address() {
+		t.Fatal("accounts should be different")
+	}
+}
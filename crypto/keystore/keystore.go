@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package keystore implements a versioned JSON keystore, the secret is encrypted by AES-256-GCM
+// with the key derived from the password by scrypt, the GCM tag is stored as the MAC and
+// the address is authenticated as additional data.
+//
+//	{
+//	  "version": 1,
+//	  "id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+//	  "type": "account",
+//	  "address": "qlc_...",
+//	  "crypto": {
+//	    "cipher": "aes-256-gcm",
+//	    "ciphertext": "...",
+//	    "cipherparams": {"nonce": "..."},
+//	    "kdf": "scrypt",
+//	    "kdfparams": {"n": 262144, "r": 8, "p": 1, "dklen": 32, "salt": "..."},
+//	    "mac": "..."
+//	  }
+//	}
+//
+// The secret of an account keystore is the 64 bytes ed25519 private key, the secret of a wallet
+// keystore is the wallet seed, and hdPath is set if the wallet is a HD wallet.
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto"
+	"github.com/qlcchain/go-qlc/crypto/ed25519"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+)
+
+const (
+	// Version is the current version of the keystore format
+	Version = 1
+
+	TypeAccount = "account"
+	TypeWallet  = "wallet"
+
+	CipherAES256GCM = "aes-256-gcm"
+	KDFScrypt       = "scrypt"
+
+	nonceSize = 12
+	macSize   = 16
+	saltSize  = 32
+	keySize   = 32
+
+	// limits of the scrypt parameters of a keystore, which bound the memory of 128*N*R bytes and the CPU time
+	maxScryptN  = 1 << 20
+	maxScryptRP = 64
+)
+
+var (
+	// StandardScrypt uses 256MB memory and takes approximately 1s CPU time on a modern processor
+	StandardScrypt = ScryptParams{N: 1 << 18, R: 8, P: 1}
+	// LightScrypt uses 4MB memory and takes approximately 100ms CPU time on a modern processor
+	LightScrypt = ScryptParams{N: 1 << 12, R: 8, P: 6}
+)
+
+var (
+	ErrDecrypt         = errors.New("could not decrypt keystore with the password")
+	ErrVersion         = errors.New("unsupported keystore version")
+	ErrAddressMismatch = errors.New("address of the keystore does not match the key")
+	ErrScryptParams    = errors.New("invalid keystore scrypt params")
+)
+
+type ScryptParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// Validate checks that N is a power of two and the memory and CPU time of scrypt are bounded
+func (p ScryptParams) Validate() error {
+	if p.N <= 1 || p.N > maxScryptN || p.N&(p.N-1) != 0 {
+		return ErrScryptParams
+	}
+	if p.R <= 0 || p.P <= 0 || p.R > maxScryptRP || p.P > maxScryptRP || p.R*p.P > maxScryptRP {
+		return ErrScryptParams
+	}
+	return nil
+}
+
+type CipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+type CryptoJSON struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	KDF          string       `json:"kdf"`
+	KDFParams    ScryptParams `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+// Keystore is an encrypted account private key or wallet seed
+type Keystore struct {
+	Version int           `json:"version"`
+	ID      string        `json:"id"`
+	Type    string        `json:"type"`
+	Address types.Address `json:"address"`
+	HDPath  string        `json:"hdPath,omitempty"`
+	Crypto  CryptoJSON    `json:"crypto"`
+}
+
+// EncryptAccount encrypts the private key of the account
+func EncryptAccount(account *types.Account, password string, params ScryptParams) (*Keystore, error) {
+	return encrypt(TypeAccount, account.Address(), "", account.PrivateKey(), password, params)
+}
+
+// EncryptWallet encrypts the seed of the wallet, the id is the master address of the wallet
+// and path is the derivation path prefix of a HD wallet
+func EncryptWallet(id types.Address, seed []byte, path, password string, params ScryptParams) (*Keystore, error) {
+	return encrypt(TypeWallet, id, path, seed, password, params)
+}
+
+func encrypt(typ string, address types.Address, path string, secret []byte, password string, params ScryptParams) (*Keystore, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	salt := crypto.GetEntropyCSPRNG(saltSize)
+	key, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, keySize)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := crypto.GetEntropyCSPRNG(nonceSize)
+	sealed := gcm.Seal(nil, nonce, secret, address.Bytes())
+	cipherText, mac := sealed[:len(sealed)-macSize], sealed[len(sealed)-macSize:]
+
+	params.DKLen = keySize
+	params.Salt = hex.EncodeToString(salt)
+	return &Keystore{
+		Version: Version,
+		ID:      uuid.New().String(),
+		Type:    typ,
+		Address: address,
+		HDPath:  path,
+		Crypto: CryptoJSON{
+			Cipher:       CipherAES256GCM,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: CipherParams{Nonce: hex.EncodeToString(nonce)},
+			KDF:          KDFScrypt,
+			KDFParams:    params,
+			MAC:          hex.EncodeToString(mac),
+		},
+	}, nil
+}
+
+// Parse parses the keystore json and checks the version, cipher and kdf
+func Parse(data []byte) (*Keystore, error) {
+	ks := new(Keystore)
+	if err := json.Unmarshal(data, ks); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %s", err)
+	}
+	if ks.Version != Version {
+		return nil, ErrVersion
+	}
+	if ks.Type != TypeAccount && ks.Type != TypeWallet {
+		return nil, fmt.Errorf("invalid keystore type %s", ks.Type)
+	}
+	if ks.Crypto.Cipher != CipherAES256GCM {
+		return nil, fmt.Errorf("unsupported cipher %s", ks.Crypto.Cipher)
+	}
+	if ks.Crypto.KDF != KDFScrypt {
+		return nil, fmt.Errorf("unsupported kdf %s", ks.Crypto.KDF)
+	}
+	return ks, nil
+}
+
+// Decrypt returns the secret of the keystore
+func (ks *Keystore) Decrypt(password string) ([]byte, error) {
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, errors.New("invalid keystore cipher text")
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil || len(mac) != macSize {
+		return nil, errors.New("invalid keystore mac")
+	}
+	nonce, err := hex.DecodeString(ks.Crypto.CipherParams.Nonce)
+	if err != nil || len(nonce) != nonceSize {
+		return nil, errors.New("invalid keystore nonce")
+	}
+	params := ks.Crypto.KDFParams
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.New("invalid keystore salt")
+	}
+	if params.DKLen != keySize {
+		return nil, fmt.Errorf("invalid keystore dklen %d", params.DKLen)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := gcm.Open(nil, nonce, append(cipherText, mac...), ks.Address.Bytes())
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return secret, nil
+}
+
+// Account decrypts the account of an account keystore
+func (ks *Keystore) Account(password string) (*types.Account, error) {
+	if ks.Type != TypeAccount {
+		return nil, fmt.Errorf("keystore type %s is not %s", ks.Type, TypeAccount)
+	}
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key size %d", len(secret))
+	}
+	// the public key half must be derived from the seed half
+	if _, priv, err := ed25519.GenerateKey(bytes.NewReader(secret[:32])); err != nil || !bytes.Equal(priv, secret) {
+		return nil, errors.New("invalid private key")
+	}
+	account := types.NewAccount(secret)
+	if account.Address() != ks.Address {
+		return nil, ErrAddressMismatch
+	}
+	return account, nil
+}
+
+// FindAccount decrypts the account of the address, the account of an account keystore must be the address,
+// and the first limit accounts of a wallet keystore are searched
+func (ks *Keystore) FindAccount(password string, address types.Address, limit uint32) (*types.Account, error) {
+	if ks.Type == TypeAccount {
+		account, err := ks.Account(password)
+		if err != nil {
+			return nil, err
+		}
+		if account.Address() != address {
+			return nil, fmt.Errorf("keystore account %s is not %s", account.Address(), address)
+		}
+		return account, nil
+	}
+
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	derive := func(index uint32) (*types.Account, error) {
+		return hd.DeriveAccount(secret, hd.AccountPath(ks.HDPath, index))
+	}
+	if ks.HDPath == "" {
+		seed, err := types.BytesToSeed(secret)
+		if err != nil {
+			return nil, err
+		}
+		derive = seed.Account
+	}
+	for i := uint32(0); i < limit; i++ {
+		account, err := derive(i)
+		if err != nil {
+			return nil, err
+		}
+		if account.Address() == address {
+			return account, nil
+		}
+	}
+	return nil, fmt.Errorf("account %s is not found in the first %d accounts of wallet %s", address, limit, ks.Address)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestEncryptAccount(t *testing.T) {
+	account := mock.Account()
+	ks, err := EncryptAccount(account, "123456", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(ks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(string(data))
+
+	ks2, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ks2.Address != account.Address() || ks2.Type != TypeAccount {
+		t.Fatal("invalid keystore", ks2)
+	}
+	a, err := ks2.Account("123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a.PrivateKey(), account.PrivateKey()) {
+		t.Fatal("invalid private key")
+	}
+	if _, err := ks2.Account("654321"); err != ErrDecrypt {
+		t.Fatal("password should be invalid", err)
+	}
+
+	// address is authenticated by the mac
+	ks2.Address = mock.Address()
+	if _, err := ks2.Decrypt("123456"); err != ErrDecrypt {
+		t.Fatal("address should be authenticated", err)
+	}
+}
+
+func TestEncryptWallet(t *testing.T) {
+	seed, err := types.NewSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := seed.MasterAddress()
+	ks, err := EncryptWallet(id, seed[:], "m/44'/1995'", "", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Account(""); err == nil {
+		t.Fatal("wallet keystore should not be an account")
+	}
+	data, _ := json.Marshal(ks)
+	ks2, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := ks2.Decrypt("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(secret, seed[:]) || ks2.HDPath != "m/44'/1995'" || ks2.Address != id {
+		t.Fatal("invalid wallet keystore", ks2)
+	}
+}
+
+func TestParse(t *testing.T) {
+	ks, err := EncryptAccount(mock.Account(), "123456", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []func(k *Keystore){
+		func(k *Keystore) { k.Version = 2 },
+		func(k *Keystore) { k.Type = "unknown" },
+		func(k *Keystore) { k.Crypto.Cipher = "aes-128-ctr" },
+		func(k *Keystore) { k.Crypto.KDF = "pbkdf2" },
+	} {
+		k := *ks
+		f(&k)
+		data, _ := json.Marshal(&k)
+		if _, err := Parse(data); err == nil {
+			t.Fatal("keystore should be invalid", string(data))
+		}
+	}
+	if _, err := Parse([]byte("{")); err == nil {
+		t.Fatal("json should be invalid")
+	}
+}
+
+func TestKeystore_FindAccount(t *testing.T) {
+	account := mock.Account()
+	ks, err := EncryptAccount(account, "123456", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := ks.FindAccount("123456", account.Address(), 10); err != nil || a.Address() != account.Address() {
+		t.Fatal(err)
+	}
+	if _, err := ks.FindAccount("123456", mock.Address(), 10); err == nil {
+		t.Fatal("address should not be found")
+	}
+
+	seed, err := types.NewSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a3, _ := seed.Account(3)
+	ks, err = EncryptWallet(seed.MasterAddress(), seed[:], "", "", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := ks.FindAccount("", a3.Address(), 10); err != nil || a.Address() != a3.Address() {
+		t.Fatal(err)
+	}
+	if _, err := ks.FindAccount("", a3.Address(), 3); err == nil {
+		t.Fatal("address should be out of limit")
+	}
+
+	hdSeed := bytes.Repeat([]byte{1}, hd.SeedSize)
+	a2, err := hd.DeriveAccount(hdSeed, hd.AccountPath(hd.DefaultPath, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, err = EncryptWallet(mock.Address(), hdSeed, hd.DefaultPath, "", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := ks.FindAccount("", a2.Address(), 10); err != nil || a.Address() != a2.Address() {
+		t.Fatal(err)
+	}
+}
+
+func TestKeystore_ScryptParams(t *testing.T) {
+	ks, err := EncryptAccount(mock.Account(), "123456", LightScrypt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []ScryptParams{
+		{N: 1 << 30, R: 8, P: 1},
+		{N: 1<<12 + 1, R: 8, P: 1},
+		{N: 1 << 12, R: 0, P: 1},
+		{N: 1 << 12, R: 1 << 20, P: 1},
+		{N: 1 << 12, R: 8, P: 1 << 20},
+	} {
+		ks2 := *ks
+		p.DKLen = ks.Crypto.KDFParams.DKLen
+		p.Salt = ks.Crypto.KDFParams.Salt
+		ks2.Crypto.KDFParams = p
+		if _, err := ks2.Decrypt("123456"); err != ErrScryptParams {
+			t.Fatal("expect invalid scrypt params", p, err)
+		}
+	}
+	if _, err := EncryptAccount(mock.Account(), "123456", ScryptParams{N: 1 << 21, R: 8, P: 1}); err != ErrScryptParams {
+		t.Fatal("expect invalid scrypt params", err)
+	}
+}
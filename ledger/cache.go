@@ -430,7 +430,13 @@ func (c *Cache) dumpToLevelDb(key []byte, v interface{}, b storage.Batch) error
 
 func (c *Cache) dumpToRelation(key []byte, v interface{}, l *Ledger) error {
 	if !isDeleteKey(v) {
-		if val, ok := v.(types.Convert); ok {
+		if blk, ok := v.(*types.StateBlock); ok {
+			objs, err := l.blockToSchema(blk)
+			if err != nil {
+				return fmt.Errorf("table convert: %s", err)
+			}
+			l.relation.Add(objs)
+		} else if val, ok := v.(types.Convert); ok {
 			objs, err := val.ConvertToSchema()
 			if err != nil {
 				return fmt.Errorf("table convert: %s", err)
@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package eventlog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	// DefaultRetention is the count of latest events kept in the log
+	DefaultRetention = 1 << 20
+	pruneInterval    = 1024
+	subscriptionSize = 4096
+)
+
+var (
+	ErrCursorExpired = errors.New("events after the cursor have been pruned")
+	ErrInvalidCursor = errors.New("cursor is larger than the latest event")
+	ErrLagged        = errors.New("subscription is too slow to receive events")
+)
+
+type Kind byte
+
+const (
+	KindBlock Kind = iota + 1
+	KindPovBlock
+	KindRollback
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBlock:
+		return "block"
+	case KindPovBlock:
+		return "povBlock"
+	case KindRollback:
+		return "rollback"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a confirmed block, a pov best block or a rolled back block, identified by a sequence number
+type Event struct {
+	Seq     uint64
+	Kind    Kind
+	Hash    types.Hash
+	Address types.Address // account of the block, zero for pov block and rollback
+	Height  uint64        // height of the pov block
+}
+
+const eventSize = 1 + types.HashSize + types.AddressSize + 8
+
+func (e *Event) serialize() []byte {
+	buf := make([]byte, 0, eventSize)
+	buf = append(buf, byte(e.Kind))
+	buf = append(buf, e.Hash[:]...)
+	buf = append(buf, e.Address[:]...)
+	return append(buf, util.BE_Uint64ToBytes(e.Height)...)
+}
+
+func (e *Event) deserialize(seq uint64, data []byte) error {
+	if len(data) != eventSize {
+		return fmt.Errorf("invalid event size %d", len(data))
+	}
+	e.Seq = seq
+	e.Kind = Kind(data[0])
+	copy(e.Hash[:], data[1:1+types.HashSize])
+	copy(e.Address[:], data[1+types.HashSize:1+types.HashSize+types.AddressSize])
+	e.Height = util.BE_BytesToUint64(data[eventSize-8:])
+	return nil
+}
+
+func latestKey() []byte {
+	return []byte{byte(storage.KeyPrefixEventLog)}
+}
+
+func eventKey(seq uint64) []byte {
+	return append(latestKey(), util.BE_Uint64ToBytes(seq)...)
+}
+
+// EventLog persists chain events with increasing sequence numbers, so subscribers can resume from a cursor
+type EventLog struct {
+	store      storage.Store
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	retention  uint64
+	mu         sync.RWMutex
+	first      uint64
+	latest     uint64
+	subs       map[*Subscription]struct{}
+	logger     *zap.SugaredLogger
+}
+
+func NewEventLog(store storage.Store, eb event.EventBus, retention uint64) (*EventLog, error) {
+	el := &EventLog{
+		store:     store,
+		eb:        eb,
+		retention: retention,
+		subs:      make(map[*Subscription]struct{}),
+		logger:    log.NewLogger("event_log"),
+	}
+	if v, err := store.Get(latestKey()); err == nil {
+		el.latest = util.BE_BytesToUint64(v)
+	} else if err != storage.KeyNotFound {
+		return nil, err
+	}
+	el.first = el.latest + 1
+	errStop := errors.New("stop")
+	err := store.Iterator(eventKey(0), eventKey(el.latest+1), func(k, v []byte) error {
+		el.first = util.BE_BytesToUint64(k[1:])
+		return errStop
+	})
+	if err != nil && err != errStop {
+		return nil, err
+	}
+	return el, nil
+}
+
+// Start records events published to the event bus
+func (el *EventLog) Start() error {
+	el.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		var e *Event
+		switch msg := c.Message().(type) {
+		case *types.StateBlock:
+			e = &Event{Kind: KindBlock, Hash: msg.GetHash(), Address: msg.GetAddress()}
+		case *types.PovBlock:
+			e = &Event{Kind: KindPovBlock, Hash: msg.GetHash(), Height: msg.GetHeight()}
+		case types.Hash:
+			e = &Event{Kind: KindRollback, Hash: msg}
+		default:
+			return
+		}
+		if err := el.Append(e); err != nil {
+			el.logger.Errorf("append %s event %s: %s", e.Kind, e.Hash, err)
+		}
+	}), el.eb)
+	return el.subscriber.Subscribe(topic.EventConfirmedBlock, topic.EventPovConnectBestBlock, topic.EventRollback)
+}
+
+func (el *EventLog) Stop() error {
+	el.mu.Lock()
+	for sub := range el.subs {
+		delete(el.subs, sub)
+		close(sub.live)
+	}
+	el.mu.Unlock()
+	if el.subscriber != nil {
+		return el.subscriber.UnsubscribeAll()
+	}
+	return nil
+}
+
+// Latest returns the sequence number of the latest event
+func (el *EventLog) Latest() uint64 {
+	el.mu.RLock()
+	defer el.mu.RUnlock()
+	return el.latest
+}
+
+// Append assigns the next sequence number to the event, persists and delivers it to subscriptions
+func (el *EventLog) Append(e *Event) error {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	e.Seq = el.latest + 1
+	batch := el.store.Batch(true)
+	if err := batch.Put(eventKey(e.Seq), e.serialize()); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := batch.Put(latestKey(), util.BE_Uint64ToBytes(e.Seq)); err != nil {
+		batch.Discard()
+		return err
+	}
+	if err := el.store.PutBatch(batch); err != nil {
+		return err
+	}
+	el.latest = e.Seq
+	if el.first > el.latest {
+		el.first = el.latest
+	}
+
+	for sub := range el.subs {
+		select {
+		case sub.live <- e:
+		default:
+			delete(el.subs, sub)
+			close(sub.live)
+		}
+	}
+
+	if el.retention > 0 && e.Seq%pruneInterval == 0 && e.Seq > el.retention {
+		el.prune(e.Seq - el.retention + 1)
+	}
+	return nil
+}
+
+// prune deletes events before seq
+func (el *EventLog) prune(seq uint64) {
+	if seq <= el.first {
+		return
+	}
+	batch := el.store.Batch(true)
+	for i := el.first; i < seq; i++ {
+		if err := batch.Delete(eventKey(i)); err != nil {
+			batch.Discard()
+			el.logger.Error(err)
+			return
+		}
+	}
+	if err := el.store.PutBatch(batch); err != nil {
+		el.logger.Error(err)
+		return
+	}
+	el.first = seq
+}
+
+// Range calls fn with events after the cursor, up to the latest one
+func (el *EventLog) Range(cursor uint64, fn func(e *Event) error) error {
+	el.mu.RLock()
+	first, latest := el.first, el.latest
+	el.mu.RUnlock()
+	return el.rangeEvents(cursor, first, latest, fn)
+}
+
+func (el *EventLog) rangeEvents(cursor, first, latest uint64, fn func(e *Event) error) error {
+	if cursor > latest {
+		return ErrInvalidCursor
+	}
+	if cursor == latest {
+		return nil
+	}
+	if cursor+1 < first {
+		return ErrCursorExpired
+	}
+	return el.store.Iterator(eventKey(cursor+1), eventKey(latest+1), func(k, v []byte) error {
+		e := new(Event)
+		if err := e.deserialize(util.BE_BytesToUint64(k[1:]), v); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+// Subscription delivers events after a cursor, replayed events are followed by live ones
+type Subscription struct {
+	el     *EventLog
+	live   chan *Event
+	events chan *Event
+	quit   chan struct{}
+	once   sync.Once
+	err    error
+}
+
+// Subscribe replays events after the cursor and then streams live events, a slow subscription is closed with ErrLagged
+func (el *EventLog) Subscribe(cursor uint64) (*Subscription, error) {
+	el.mu.Lock()
+	first, latest := el.first, el.latest
+	if cursor > latest {
+		el.mu.Unlock()
+		return nil, ErrInvalidCursor
+	}
+	if cursor < latest && cursor+1 < first {
+		el.mu.Unlock()
+		return nil, ErrCursorExpired
+	}
+	sub := &Subscription{
+		el:     el,
+		live:   make(chan *Event, subscriptionSize),
+		events: make(chan *Event),
+		quit:   make(chan struct{}),
+	}
+	el.subs[sub] = struct{}{}
+	el.mu.Unlock()
+
+	go sub.run(cursor, first, latest)
+	return sub, nil
+}
+
+func (s *Subscription) run(cursor, first, latest uint64) {
+	defer close(s.events)
+	errQuit := errors.New("quit")
+	err := s.el.rangeEvents(cursor, first, latest, func(e *Event) error {
+		select {
+		case s.events <- e:
+			return nil
+		case <-s.quit:
+			return errQuit
+		}
+	})
+	if err != nil {
+		if err != errQuit {
+			s.err = err
+		}
+		return
+	}
+	for {
+		select {
+		case e, ok := <-s.live:
+			if !ok {
+				s.err = ErrLagged
+				return
+			}
+			select {
+			case s.events <- e:
+			case <-s.quit:
+				return
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// Events returns the channel of events, which is closed when the subscription ends
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Err returns the reason of the end of the subscription, it should be called after the events channel is closed
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.el.mu.Lock()
+		if _, ok := s.el.subs[s]; ok {
+			delete(s.el.subs, s)
+			close(s.live)
+		}
+		s.el.mu.Unlock()
+		close(s.quit)
+	})
+}
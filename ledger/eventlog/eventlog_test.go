@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func receive(t *testing.T, sub *Subscription) *Event {
+	select {
+	case e, ok := <-sub.Events():
+		if !ok {
+			t.Fatal("subscription closed", sub.Err())
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("receive event timeout")
+	}
+	return nil
+}
+
+func TestEventLog_ReplayAndLive(t *testing.T) {
+	teardown, l := ledger.NewTestLedger()
+	defer teardown()
+
+	el, err := NewEventLog(l.DBStore(), l.EventBus(), DefaultRetention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := el.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer el.Stop()
+
+	blk := mock.StateBlockWithoutWork()
+	povBlk, _ := mock.GeneratePovBlock(nil, 0)
+	rollback := mock.Hash()
+	l.EventBus().Publish(topic.EventConfirmedBlock, blk)
+	l.EventBus().Publish(topic.EventPovConnectBestBlock, povBlk)
+	l.EventBus().Publish(topic.EventRollback, rollback)
+	for i := 0; el.Latest() < 3; i++ {
+		if i > 100 {
+			t.Fatal("events are not recorded", el.Latest())
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// reopen from store
+	el2, err := NewEventLog(l.DBStore(), l.EventBus(), DefaultRetention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if el2.Latest() != 3 || el2.first != 1 {
+		t.Fatal("invalid event log state", el2.Latest(), el2.first)
+	}
+
+	sub, err := el.Subscribe(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	if e := receive(t, sub); e.Seq != 2 || e.Kind != KindPovBlock || e.Hash != povBlk.GetHash() || e.Height != povBlk.GetHeight() {
+		t.Fatal("invalid pov event", e)
+	}
+	if e := receive(t, sub); e.Seq != 3 || e.Kind != KindRollback || e.Hash != rollback {
+		t.Fatal("invalid rollback event", e)
+	}
+
+	blk2 := mock.StateBlockWithoutWork()
+	l.EventBus().Publish(topic.EventConfirmedBlock, blk2)
+	if e := receive(t, sub); e.Seq != 4 || e.Kind != KindBlock || e.Hash != blk2.GetHash() || e.Address != blk2.GetAddress() {
+		t.Fatal("invalid live event", e)
+	}
+
+	if _, err := el.Subscribe(10); err != ErrInvalidCursor {
+		t.Fatal("expect invalid cursor, got", err)
+	}
+}
+
+func TestEventLog_Prune(t *testing.T) {
+	teardown, l := ledger.NewTestLedger()
+	defer teardown()
+
+	el, err := NewEventLog(l.DBStore(), l.EventBus(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < pruneInterval; i++ {
+		if err := el.Append(&Event{Kind: KindRollback, Hash: mock.Hash()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if el.first != pruneInterval-9 {
+		t.Fatal("invalid first event", el.first)
+	}
+	if _, err := el.Subscribe(1); err != ErrCursorExpired {
+		t.Fatal("expect cursor expired, got", err)
+	}
+	var count int
+	if err := el.Range(pruneInterval-10, func(e *Event) error {
+		count++
+		return nil
+	}); err != nil || count != 10 {
+		t.Fatal("invalid range", count, err)
+	}
+}
+
+func TestEventLog_Lagged(t *testing.T) {
+	teardown, l := ledger.NewTestLedger()
+	defer teardown()
+
+	el, err := NewEventLog(l.DBStore(), l.EventBus(), DefaultRetention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err := el.Subscribe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	// the subscription is never read, so it is closed after the buffer is full
+	for i := 0; i < subscriptionSize+2; i++ {
+		if err := el.Append(&Event{Kind: KindBlock, Hash: mock.Hash(), Address: types.ZeroAddress}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var count int
+	for range sub.Events() {
+		count++
+	}
+	if sub.Err() != ErrLagged || count > subscriptionSize+1 {
+		t.Fatal("expect lagged subscription", sub.Err(), count)
+	}
+}
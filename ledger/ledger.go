@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -58,6 +59,8 @@ type Ledger struct {
 	rcache         *rCache
 	cacheStats     []*CacheStat
 	relation       *relation.Relation
+	relationStale  bool         // relation is rebuilt without method names
+	methodResolver atomic.Value // MethodNameResolver
 	EB             event.EventBus
 	blockConfirmed chan *types.StateBlock
 	ctx            context.Context
@@ -92,6 +95,10 @@ var (
 	ErrLinkNotFound    = errors.New("link not found")
 	ErrPeerExists      = errors.New("peer already exists")
 	ErrPeerNotFound    = errors.New("peer not found")
+
+	ErrMultiSigProposalNotFound = errors.New("multisig proposal not found")
+	ErrMultiSigProposalExists   = errors.New("multisig proposal already exists")
+	ErrRepOnlineStatsNotFound   = errors.New("representative online statistics not found")
 )
 
 var (
@@ -99,7 +106,7 @@ var (
 	lock   = sync.RWMutex{}
 )
 
-const version = 16
+const version = 17
 
 func NewLedger(cfgFile string) *Ledger {
 	lock.Lock()
@@ -120,7 +127,11 @@ func NewLedger(cfgFile string) *Ledger {
 			logger:         log.NewLogger("ledger"),
 			tokenCache:     sync.Map{},
 		}
-		store, err := db.NewBadgerStore(dir)
+		var engine string
+		if cfg.DB != nil {
+			engine = cfg.DB.Engine
+		}
+		store, err := db.NewStore(engine, dir)
 		if err != nil {
 			l.logger.Fatal(err.Error())
 		}
@@ -266,6 +277,7 @@ func (l *Ledger) upgrade() error {
 			new(migration.MigrationV13ToV14),
 			new(migration.MigrationV14ToV15),
 			new(migration.MigrationV15ToV16),
+			new(migration.MigrationV16ToV17),
 		}
 
 		err = migration.Upgrade(ms, l.store)
@@ -288,21 +300,26 @@ func (l *Ledger) initRelation() error {
 	}
 
 	if count1 != count2 {
-		if err := l.relation.EmptyStore(); err != nil {
-			return fmt.Errorf("relation emptystore, %s ", err)
-		}
-		return l.GetStateBlocksConfirmed(func(block *types.StateBlock) error {
-			c, err := block.ConvertToSchema()
-			if err != nil {
-				return fmt.Errorf("relation convert, %s ", err)
-			}
-			l.relation.Add(c)
-			return nil
-		})
+		l.relationStale = l.methodResolver.Load() == nil
+		return l.rebuildRelation()
 	}
 	return nil
 }
 
+func (l *Ledger) rebuildRelation() error {
+	if err := l.relation.EmptyStore(); err != nil {
+		return fmt.Errorf("relation emptystore, %s ", err)
+	}
+	return l.GetStateBlocksConfirmed(func(block *types.StateBlock) error {
+		c, err := l.blockToSchema(block)
+		if err != nil {
+			return fmt.Errorf("relation convert, %s ", err)
+		}
+		l.relation.Add(c)
+		return nil
+	})
+}
+
 //CloseLedger force release all ledger instance
 func CloseLedger() {
 	for k, v := range lcache {
@@ -328,7 +345,7 @@ func (l *Ledger) Close() error {
 		if err := l.store.Close(); err != nil {
 			return err
 		}
-		l.logger.Info("badger closed")
+		l.logger.Info("store closed")
 		delete(lcache, l.dir)
 		return nil
 	}
@@ -729,7 +746,9 @@ func NewTestLedger() (func(), *Ledger) {
 	dir := filepath.Join(config.QlcTestDataDir(), "ledger", uuid.New().String())
 	_ = os.RemoveAll(dir)
 	cm := config.NewCfgManager(dir)
-	_, _ = cm.Load()
+	cfg, _ := cm.Load()
+	cfg.DB.Engine = db.MemoryEngine
+	_ = cm.Save(cfg)
 	l := NewLedger(cm.ConfigFile)
 
 	return func() {
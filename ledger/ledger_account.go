@@ -46,6 +46,7 @@ type AccountStore interface {
 	GetAccountMetaByPovHeight(address types.Address, height uint64) (*types.AccountMeta, error)
 	GetTokenMetaByPovHeight(address types.Address, token types.Hash, height uint64) (*types.TokenMeta, error)
 	GetTokenMetaByBlockHash(hash types.Hash) (*types.TokenMeta, error)
+	GetTokenBlockHashBySeq(address types.Address, token types.Hash, seq uint64) (types.Hash, error)
 
 	ContractAddress(b *types.StateBlock) (*types.Address, error)
 }
@@ -456,7 +457,16 @@ func (l *Ledger) AddAccountMetaHistory(tm *types.TokenMeta, block *types.StateBl
 	if err != nil {
 		return err
 	}
-	return cache.Put(kp, tm.Clone())
+	if err := cache.Put(kp, tm.Clone()); err != nil {
+		return err
+	}
+	// the block count of the token chain is the seq of the block, a rolled back seq is overwritten by the next block
+	ks, err := storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, tm.BelongTo, tm.Type, uint64(tm.BlockCount))
+	if err != nil {
+		return err
+	}
+	hash := block.GetHash()
+	return cache.Put(ks, &hash)
 }
 
 func (l *Ledger) UpdateAccountMetaHistory(tm *types.TokenMeta, block *types.StateBlock, cache storage.Cache) error {
@@ -580,6 +590,30 @@ func (l *Ledger) GetTokenMetaByBlockHash(hash types.Hash) (*types.TokenMeta, err
 	}
 }
 
+// GetTokenBlockHashBySeq returns the hash of the confirmed block at the seq of the token chain of the account,
+// seq starts from 1 at the open block and ends at the block count of the token
+func (l *Ledger) GetTokenBlockHashBySeq(address types.Address, token types.Hash, seq uint64) (types.Hash, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, address, token, seq)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	i, r, err := l.GetObject(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return types.ZeroHash, ErrBlockNotFound
+		}
+		return types.ZeroHash, err
+	}
+	if i != nil {
+		return *i.(*types.Hash), nil
+	}
+	hash := new(types.Hash)
+	if err := hash.Deserialize(r); err != nil {
+		return types.ZeroHash, fmt.Errorf("hash deserialize: %s", err)
+	}
+	return *hash, nil
+}
+
 func (l *Ledger) IsUserAccount(address types.Address) (bool, error) {
 	if b, err := l.HasAccountMetaConfirmed(address); b {
 		return true, nil
@@ -438,6 +438,34 @@ func TestLedger_GetTokenMetaByBlockHash(t *testing.T) {
 	}
 }
 
+func TestLedger_GetTokenBlockHashBySeq(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	tm := mock.TokenMeta(addr)
+	tm.BlockCount = 3
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = addr
+	blk.Token = tm.Type
+	if err := l.AddAccountMetaHistory(tm, blk, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+
+	if r, err := l.GetTokenBlockHashBySeq(addr, tm.Type, 3); err != nil || r != blk.GetHash() {
+		t.Fatal(r, err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := l.GetTokenBlockHashBySeq(addr, tm.Type, 3); err != nil || r != blk.GetHash() {
+		t.Fatal(r, err)
+	}
+	if r, err := l.GetTokenBlockHashBySeq(addr, tm.Type, 2); err != ErrBlockNotFound {
+		t.Fatal(r, err)
+	}
+}
+
 func TestLedger_GetTokenMetaByPovHeight(t *testing.T) {
 	teardownTestCase, l := setupTestCase(t)
 	defer teardownTestCase(t)
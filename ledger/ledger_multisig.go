@@ -0,0 +1,65 @@
+package ledger
+
+import (
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// MultiSigStore keeps blocks of multi-signature accounts while the owners are signing them
+type MultiSigStore interface {
+	AddOrUpdateMultiSigProposal(proposal *types.MultiSigProposal) error
+	GetMultiSigProposal(hash types.Hash) (*types.MultiSigProposal, error)
+	GetMultiSigProposals(fn func(proposal *types.MultiSigProposal) error) error
+	DeleteMultiSigProposal(hash types.Hash) error
+}
+
+func (l *Ledger) AddOrUpdateMultiSigProposal(proposal *types.MultiSigProposal) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixMultiSigProposal, proposal.Block.GetHash())
+	if err != nil {
+		return err
+	}
+	v, err := proposal.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) GetMultiSigProposal(hash types.Hash) (*types.MultiSigProposal, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixMultiSigProposal, hash)
+	if err != nil {
+		return nil, err
+	}
+	val, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrMultiSigProposalNotFound
+		}
+		return nil, err
+	}
+	p := new(types.MultiSigProposal)
+	if err := p.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (l *Ledger) GetMultiSigProposals(fn func(proposal *types.MultiSigProposal) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixMultiSigProposal)
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		p := new(types.MultiSigProposal)
+		if err := p.Deserialize(val); err != nil {
+			l.logger.Errorf("deserialize multisig proposal error: %s", err)
+			return nil
+		}
+		return fn(p)
+	})
+}
+
+func (l *Ledger) DeleteMultiSigProposal(hash types.Hash) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixMultiSigProposal, hash)
+	if err != nil {
+		return err
+	}
+	return l.store.Delete(k)
+}
@@ -1,7 +1,11 @@
 package ledger
 
 import (
+	"errors"
+	"math"
+
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger/relation"
 )
 
 type Relation interface {
@@ -9,10 +13,28 @@ type Relation interface {
 	BlocksByAccount(address types.Address, limit int, offset int) ([]types.Hash, error)
 	BlocksCount() (uint64, error)
 	BlocksCountByType() (map[string]uint64, error)
+	SearchBlocks(q *relation.BlockQuery) ([]types.Hash, string, error)
 
 	EmptyRelation() error
 }
 
+// MethodNameResolver returns the chain contract method name called by the data
+type MethodNameResolver func(addr types.Address, data []byte) (string, bool, error)
+
+// SetMethodNameResolver sets the resolver to index contract method names of blocks in relation,
+// the relation rebuilt without method names when the ledger was opened is rebuilt again
+func (l *Ledger) SetMethodNameResolver(resolver MethodNameResolver) error {
+	if resolver == nil {
+		return errors.New("nil method name resolver")
+	}
+	l.methodResolver.Store(resolver)
+	if !l.relationStale {
+		return nil
+	}
+	l.relationStale = false
+	return l.rebuildRelation()
+}
+
 func (l *Ledger) Blocks(limit int, offset int) ([]types.Hash, error) {
 	return l.relation.Blocks(limit, offset)
 }
@@ -21,6 +43,10 @@ func (l *Ledger) BlocksByAccount(address types.Address, limit int, offset int) (
 	return l.relation.BlocksByAccount(address, limit, offset)
 }
 
+func (l *Ledger) SearchBlocks(q *relation.BlockQuery) ([]types.Hash, string, error) {
+	return l.relation.SearchBlocks(q)
+}
+
 func (l *Ledger) BlocksCount() (uint64, error) {
 	return l.relation.BlocksCount()
 }
@@ -29,6 +55,33 @@ func (l *Ledger) BlocksCountByType() (map[string]uint64, error) {
 	return l.relation.BlocksCountByType()
 }
 
+// blockToSchema converts the block to relation schemas, with amount and contract method name filled
+func (l *Ledger) blockToSchema(block *types.StateBlock) ([]types.Schema, error) {
+	schemas, err := block.ConvertToSchema()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schemas {
+		bh, ok := s.(*types.BlockHash)
+		if !ok {
+			continue
+		}
+		if amount, err := l.CalculateAmount(block); err == nil && amount.Int != nil {
+			if amount.IsInt64() {
+				bh.Amount = amount.Int64()
+			} else {
+				bh.Amount = math.MaxInt64
+			}
+		}
+		if resolver, ok := l.methodResolver.Load().(MethodNameResolver); ok && block.GetType() == types.ContractSend {
+			if name, _, err := resolver(types.Address(block.GetLink()), block.GetData()); err == nil {
+				bh.Method = name
+			}
+		}
+	}
+	return schemas, nil
+}
+
 func (l *Ledger) EmptyRelation() error {
 	return l.relation.EmptyStore()
 }
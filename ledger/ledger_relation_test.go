@@ -98,3 +98,32 @@ func TestLedger_Relation(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestLedger_SetMethodNameResolver(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = types.ContractSend
+	method := func() string {
+		schemas, err := l.blockToSchema(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schemas[0].(*types.BlockHash).Method
+	}
+	if m := method(); m != "" {
+		t.Fatal("method should be empty without resolver", m)
+	}
+	if err := l.SetMethodNameResolver(nil); err == nil {
+		t.Fatal("nil resolver should be invalid")
+	}
+	if err := l.SetMethodNameResolver(func(addr types.Address, data []byte) (string, bool, error) {
+		return "Mintage", true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if m := method(); m != "Mintage" {
+		t.Fatal("invalid method", m)
+	}
+}
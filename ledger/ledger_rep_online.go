@@ -0,0 +1,71 @@
+package ledger
+
+import (
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// RepOnlineStore keeps the online statistics of representatives by period, which are updated by consensus
+type RepOnlineStore interface {
+	AddOrUpdateRepOnlineStats(stats *types.RepOnlineStats) error
+	GetRepOnlineStats(period uint64) (*types.RepOnlineStats, error)
+	GetRepOnlineStatsByRange(start, end uint64, fn func(stats *types.RepOnlineStats) error) error
+}
+
+func (l *Ledger) AddOrUpdateRepOnlineStats(stats *types.RepOnlineStats) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixRepOnlineStats, stats.Period)
+	if err != nil {
+		return err
+	}
+	v, err := stats.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) GetRepOnlineStats(period uint64) (*types.RepOnlineStats, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixRepOnlineStats, period)
+	if err != nil {
+		return nil, err
+	}
+	val, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrRepOnlineStatsNotFound
+		}
+		return nil, err
+	}
+	stats := new(types.RepOnlineStats)
+	if err := stats.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetRepOnlineStatsByRange iterates the statistics of periods from start to end, both inclusive
+func (l *Ledger) GetRepOnlineStatsByRange(start, end uint64, fn func(stats *types.RepOnlineStats) error) error {
+	if start > end {
+		return nil
+	}
+	startKey, err := storage.GetKeyOfParts(storage.KeyPrefixRepOnlineStats, start)
+	if err != nil {
+		return err
+	}
+	var endKey []byte
+	if end < ^uint64(0) {
+		if endKey, err = storage.GetKeyOfParts(storage.KeyPrefixRepOnlineStats, end+1); err != nil {
+			return err
+		}
+	} else {
+		endKey = []byte{byte(storage.KeyPrefixRepOnlineStats) + 1}
+	}
+	return l.store.Iterator(startKey, endKey, func(key []byte, val []byte) error {
+		stats := new(types.RepOnlineStats)
+		if err := stats.Deserialize(val); err != nil {
+			l.logger.Errorf("deserialize representative online statistics error: %s", err)
+			return nil
+		}
+		return fn(stats)
+	})
+}
@@ -0,0 +1,57 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestLedger_RepOnlineStats(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	account := mock.Address()
+	for _, p := range []uint64{1, 2, 3, 300} {
+		stats := &types.RepOnlineStats{
+			Period:     p,
+			BlockCount: p * 10,
+			Stats:      []*types.RepPeriodStat{{Account: account, HeartCount: p, VoteCount: p * 5, LatencySum: p * 100}},
+		}
+		if err := l.AddOrUpdateRepOnlineStats(stats); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := l.GetRepOnlineStats(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.BlockCount != 20 || len(stats.Stats) != 1 || stats.Stats[0].Account != account || stats.Stats[0].LatencySum != 200 {
+		t.Fatal("invalid stats", stats)
+	}
+	if _, err := l.GetRepOnlineStats(4); err != ErrRepOnlineStatsNotFound {
+		t.Fatal(err)
+	}
+
+	periods := make([]uint64, 0)
+	err = l.GetRepOnlineStatsByRange(2, 300, func(stats *types.RepOnlineStats) error {
+		periods = append(periods, stats.Period)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 3 || periods[0] != 2 || periods[2] != 300 {
+		t.Fatal("invalid periods", periods)
+	}
+
+	count := 0
+	err = l.GetRepOnlineStatsByRange(1, ^uint64(0), func(stats *types.RepOnlineStats) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 4 {
+		t.Fatal(err, count)
+	}
+}
@@ -22,10 +22,12 @@ type Store interface {
 	RepresentationStore
 	UncheckedBlockStore
 	PeerInfoStore
+	MultiSigStore
 	SyncStore
 	DposStore
 	PovStore
 	VoteStore
+	RepOnlineStore
 	Relation
 	CacheStore
 	LedgerStore
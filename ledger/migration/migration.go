@@ -393,6 +393,98 @@ func (m MigrationV15ToV16) EndVersion() int {
 	return 16
 }
 
+type MigrationV16ToV17 struct {
+}
+
+// Migrate indexes blocks of each token chain by seq, a chain whose blocks do not match the block count of
+// the token is left without the index
+func (m MigrationV16ToV17) Migrate(store storage.Store) error {
+	b, err := checkVersion(m, store)
+	if err != nil {
+		return err
+	}
+	if b {
+		fmt.Println("migrate ledger v16 to v17 ")
+		tms := make([]*types.TokenMeta, 0)
+		prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixAccount)
+		err := store.Iterator(prefix, nil, func(k, v []byte) error {
+			am := new(types.AccountMeta)
+			if err := am.Deserialize(v); err != nil {
+				return fmt.Errorf("accountMeta deserialize err: %s", err)
+			}
+			tms = append(tms, am.Tokens...)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("get account metas err: %s", err)
+		}
+
+		return store.BatchWrite(false, func(batch storage.Batch) error {
+			for _, tm := range tms {
+				hashes, err := tokenChainHashes(store, tm)
+				if err != nil {
+					return err
+				}
+				if int64(len(hashes)) != tm.BlockCount {
+					continue
+				}
+				for i, hash := range hashes {
+					k, err := storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, tm.BelongTo, tm.Type, uint64(tm.BlockCount-int64(i)))
+					if err != nil {
+						return err
+					}
+					v, err := hash.Serialize()
+					if err != nil {
+						return err
+					}
+					if err := batch.Put(k, v); err != nil {
+						return err
+					}
+				}
+			}
+			return updateVersion(m, batch)
+		})
+	}
+	return nil
+}
+
+// tokenChainHashes returns hashes of blocks of the token chain from the header to the open block,
+// nil if a block is missing
+func tokenChainHashes(store storage.Store, tm *types.TokenMeta) ([]types.Hash, error) {
+	hashes := make([]types.Hash, 0, tm.BlockCount)
+	for hash := tm.Header; int64(len(hashes)) <= tm.BlockCount; {
+		k, err := storage.GetKeyOfParts(storage.KeyPrefixBlock, hash)
+		if err != nil {
+			return nil, err
+		}
+		v, err := store.Get(k)
+		if err != nil {
+			if err == storage.KeyNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		blk := new(types.StateBlock)
+		if err := blk.Deserialize(v); err != nil {
+			return nil, fmt.Errorf("block deserialize err: %s", err)
+		}
+		hashes = append(hashes, hash)
+		if blk.IsOpen() {
+			break
+		}
+		hash = blk.GetPrevious()
+	}
+	return hashes, nil
+}
+
+func (m MigrationV16ToV17) StartVersion() int {
+	return 16
+}
+
+func (m MigrationV16ToV17) EndVersion() int {
+	return 17
+}
+
 func checkVersion(m Migration, s storage.Store) (bool, error) {
 	v, err := getVersion(s)
 	if err != nil {
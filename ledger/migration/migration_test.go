@@ -73,7 +73,7 @@ func TestMigration_Migrate(t *testing.T) {
 	if err := store.Put(frontierK, frontierV); err != nil {
 		t.Fatal(err)
 	}
-	migrations := []Migration{MigrationV11ToV12{}, MigrationV12ToV13{}, MigrationV13ToV14{}, MigrationV14ToV15{}, MigrationV15ToV16{}}
+	migrations := []Migration{MigrationV11ToV12{}, MigrationV12ToV13{}, MigrationV13ToV14{}, MigrationV14ToV15{}, MigrationV15ToV16{}, MigrationV16ToV17{}}
 	if err := Upgrade(migrations, store); err != nil {
 		t.Fatal(err)
 	}
@@ -168,3 +168,83 @@ func TestMigration_MigrateV15ToV16(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMigration_MigrateV16ToV17(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "store", uuid.New().String())
+	store, err := db.NewBadgerStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.Remove(dir)
+	}()
+
+	key := []byte{byte(storage.KeyPrefixVersion)}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, 16)
+	if err := store.Put(key, buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+
+	// a token chain of three blocks and another one whose block count does not match its blocks
+	putBlock := func(blk *types.StateBlock) {
+		k, _ := storage.GetKeyOfParts(storage.KeyPrefixBlock, blk.GetHash())
+		v, _ := blk.Serialize()
+		if err := store.Put(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	am := mock.AccountMeta(mock.Address())
+	tm := am.Tokens[0]
+	hashes := make([]types.Hash, 0)
+	previous := types.ZeroHash
+	for i := 0; i < 3; i++ {
+		blk := mock.StateBlockWithoutWork()
+		blk.Address = am.Address
+		blk.Token = tm.Type
+		blk.Previous = previous
+		putBlock(blk)
+		previous = blk.GetHash()
+		hashes = append(hashes, previous)
+	}
+	tm.BelongTo = am.Address
+	tm.OpenBlock = hashes[0]
+	tm.Header = hashes[2]
+	tm.BlockCount = 3
+
+	blk := mock.StateBlockWithoutWork()
+	blk.Previous = types.ZeroHash
+	putBlock(blk)
+	tm2 := mock.TokenMeta(am.Address)
+	tm2.OpenBlock = blk.GetHash()
+	tm2.Header = blk.GetHash()
+	tm2.BlockCount = 2
+	am.Tokens = []*types.TokenMeta{tm, tm2}
+
+	k, _ := storage.GetKeyOfParts(storage.KeyPrefixAccount, am.Address)
+	v, _ := am.Serialize()
+	if err := store.Put(k, v); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations := []Migration{MigrationV16ToV17{}}
+	if err := Upgrade(migrations, store); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, hash := range hashes {
+		k, _ := storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, am.Address, tm.Type, uint64(i+1))
+		v, err := store.Get(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := new(types.Hash)
+		if err := h.Deserialize(v); err != nil || *h != hash {
+			t.Fatal("invalid seq index", i+1, err)
+		}
+	}
+	k, _ = storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, am.Address, tm2.Type, uint64(1))
+	if _, err := store.Get(k); err != storage.KeyNotFound {
+		t.Fatal("chain with mismatched block count should not be indexed", err)
+	}
+}
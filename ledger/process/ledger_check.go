@@ -71,11 +71,8 @@ func (blockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBlock)
 		return errorP(block, BadWork, nil)
 	}
 
-	if checkSign {
-		signature := block.GetSignature()
-		if !address.Verify(hash[:], signature[:]) {
-			return errorP(block, BadSignature, nil)
-		}
+	if checkSign && !checkSignature(block, address, hash) {
+		return errorP(block, BadSignature, nil)
 	}
 
 	return Progress, nil
@@ -141,16 +138,26 @@ func (cacheBlockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBl
 		return errorP(block, BadWork, nil)
 	}
 
-	if checkSign {
-		signature := block.GetSignature()
-		if !address.Verify(hash[:], signature[:]) {
-			return errorP(block, BadSignature, nil)
-		}
+	if checkSign && !checkSignature(block, address, hash) {
+		return errorP(block, BadSignature, nil)
 	}
 
 	return Progress, nil
 }
 
+// checkSignature verifies the signature of the address, the block of a multi-signature account
+// must be signed by at least threshold owners instead, which is only valid since the multisig fork
+func checkSignature(block *types.StateBlock, address types.Address, hash types.Hash) bool {
+	if block.MultiSig != nil {
+		if block.PoVHeight < common.PovMultiSigForkHeight {
+			return false
+		}
+		return block.MultiSig.Verify(address, hash) == nil
+	}
+	signature := block.GetSignature()
+	return address.Verify(hash[:], signature[:])
+}
+
 func checkReceiveBlockRepeat(lv *LedgerVerifier, block *types.StateBlock) ProcessResult {
 	r := Progress
 	if block.IsReceiveBlock() {
@@ -255,12 +255,13 @@ func (c *onlineBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (P
 }
 
 func (lv *LedgerVerifier) BlockProcess(block *types.StateBlock) error {
-	lv.logger.Infof("block  process: %s(%s) ", block.GetHash().String(), block.GetType().String())
+	logger := lv.logger.With(log.BlockHash(block.GetHash()), log.Account(block.GetAddress()))
+	logger.Infof("block  process: %s(%s) ", block.GetHash().String(), block.GetType().String())
 	lv.lock(block)
 	err := lv.l.Cache().BatchUpdate(func(c *ledger.Cache) error {
 		err := lv.processStateBlock(block, c)
 		if err != nil {
-			lv.logger.Errorf("block  process error: %s, block:%s", err.Error(), block.GetHash().String())
+			logger.Errorf("block  process error: %s, block:%s", err.Error(), block.GetHash().String())
 			return err
 		}
 		return nil
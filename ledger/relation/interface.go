@@ -11,6 +11,7 @@ type Store interface {
 	BlocksByAccount(address types.Address, limit int, offset int) ([]types.Hash, error)
 	BlocksCount() (uint64, error)
 	BlocksCountByType() (map[string]uint64, error)
+	SearchBlocks(q *BlockQuery) ([]types.Hash, string, error)
 
 	Select(dest interface{}, query string) error
 	Get(dest interface{}, query string) error
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/mock"
 )
@@ -78,6 +79,64 @@ func TestRelation_Blocks(t *testing.T) {
 
 }
 
+func TestRelation_SearchBlocks(t *testing.T) {
+	teardownTestCase, r := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	token := mock.Hash()
+	for i := 0; i < 5; i++ {
+		blk := mock.StateBlockWithoutWork()
+		blk.Timestamp = int64(1000 + i)
+		if i%2 == 0 {
+			blk.Type = types.Send
+			blk.Address = addr
+			blk.Token = token
+		} else {
+			blk.Type = types.ContractSend
+		}
+		objs, _ := blk.ConvertToSchema()
+		bh := objs[0].(*types.BlockHash)
+		bh.Amount = int64(i * 100)
+		if blk.Type == types.ContractSend {
+			bh.Method = "Mintage"
+		}
+		r.Add(objs)
+	}
+	time.Sleep(1 * time.Second)
+
+	search := func(q *BlockQuery, exp int) string {
+		hs, cursor, err := r.SearchBlocks(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hs) != exp {
+			t.Fatalf("exp %d blocks, got %d", exp, len(hs))
+		}
+		return cursor
+	}
+	search(&BlockQuery{Limit: 10}, 5)
+	search(&BlockQuery{Types: []types.BlockType{types.Send}, Limit: 10}, 3)
+	search(&BlockQuery{Types: []types.BlockType{types.Send, types.ContractSend}, Limit: 10}, 5)
+	search(&BlockQuery{Address: addr, Token: token, Limit: 10}, 3)
+	search(&BlockQuery{Token: token, MinAmount: 100, MaxAmount: 300, Limit: 10}, 1)
+	if _, _, err := r.SearchBlocks(&BlockQuery{MinAmount: 100, Limit: 10}); err == nil {
+		t.Fatal("amount range without token should be invalid")
+	}
+	search(&BlockQuery{StartTime: 1001, EndTime: 1002, Limit: 10}, 2)
+	search(&BlockQuery{Method: "Mintage", Limit: 10}, 2)
+
+	cursor := search(&BlockQuery{Limit: 2}, 2)
+	cursor = search(&BlockQuery{Cursor: cursor, Limit: 2}, 2)
+	if cursor = search(&BlockQuery{Cursor: cursor, Limit: 2}, 1); cursor != "" {
+		t.Fatal("cursor should be empty at the last page")
+	}
+
+	if _, _, err := r.SearchBlocks(&BlockQuery{Cursor: "1000' or 1=1", Limit: 2}); err == nil {
+		t.Fatal("invalid cursor should fail")
+	}
+}
+
 //
 //func TestRelation_CreateData(t *testing.T) {
 //	teardownTestCase, dir := setupTestCase(t)
@@ -319,25 +319,29 @@ func getIdentityID(obj types.Schema) string {
 	return reflect.TypeOf(obj).String()
 }
 
-const version = 1
+const version = 2
 
 func (r *Relation) init() error {
 	var v int
-	err := r.db.Get(&v, fmt.Sprintf("select v from version"))
-	if err != nil {
-		if _, err := r.db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS version (v int)`)); err != nil {
+	if err := r.db.Get(&v, "select v from version"); err != nil {
+		if _, err := r.db.Exec(`CREATE TABLE IF NOT EXISTS version (v int)`); err != nil {
 			return fmt.Errorf("create table err: %s", err.Error())
 		}
-		if _, err := r.db.Exec(fmt.Sprintf(`INSERT INTO version (v) VALUES (0)`)); err != nil {
+		if _, err := r.db.Exec(`INSERT INTO version (v) VALUES (0)`); err != nil {
 			return fmt.Errorf("add version err: %s", err.Error())
 		}
-		if _, err := r.db.Exec("drop table if exists blockhash"); err != nil {
-			return fmt.Errorf("drop err: %s", err.Error())
-		}
-		r.logger.Info("update blockhash schema")
-		return nil
-	} else {
+	}
+	if v >= version {
 		r.logger.Info("blockhash schema updated")
 		return nil
 	}
+	// blockhash table will be rebuilt by ledger because of the mismatched block count
+	if _, err := r.db.Exec("drop table if exists blockhash"); err != nil {
+		return fmt.Errorf("drop err: %s", err.Error())
+	}
+	if _, err := r.db.Exec(fmt.Sprintf("UPDATE version SET v = %d", version)); err != nil {
+		return fmt.Errorf("update version err: %s", err.Error())
+	}
+	r.logger.Info("update blockhash schema")
+	return nil
 }
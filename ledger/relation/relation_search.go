@@ -0,0 +1,128 @@
+package relation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// BlockQuery filters blocks in relation store, zero value fields are ignored
+type BlockQuery struct {
+	Types          []types.BlockType
+	Token          types.Hash
+	Address        types.Address
+	Link           types.Hash
+	Representative types.Address
+	// amounts are in the raw unit of Token, which is required by them, amounts beyond int64 are indexed as max int64
+	MinAmount int64
+	MaxAmount int64
+	StartTime int64
+	EndTime   int64
+	Method    string
+	// Cursor is returned by the previous page, empty means the first page
+	Cursor string
+	Limit  int
+}
+
+// SearchBlocks returns hashes of the blocks matched the query, ordered by timestamp desc,
+// and the cursor of the next page, which is empty if there are no more blocks
+func (r *Relation) SearchBlocks(q *BlockQuery) ([]types.Hash, string, error) {
+	if q.Limit < 1 {
+		return nil, "", errors.New("invalid limit")
+	}
+	if (q.MinAmount > 0 || q.MaxAmount > 0) && q.Token.IsZero() {
+		return nil, "", errors.New("token is required by amount range")
+	}
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, values ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, values...)
+	}
+
+	if len(q.Types) > 0 {
+		placeholders := make([]string, 0, len(q.Types))
+		for _, t := range q.Types {
+			placeholders = append(placeholders, "?")
+			args = append(args, t.String())
+		}
+		conditions = append(conditions, fmt.Sprintf("type in (%s)", strings.Join(placeholders, ",")))
+	}
+	if !q.Token.IsZero() {
+		addCondition("token = ?", q.Token.String())
+	}
+	if !q.Address.IsZero() {
+		addCondition("address = ?", q.Address.String())
+	}
+	if !q.Link.IsZero() {
+		addCondition("link = ?", q.Link.String())
+	}
+	if !q.Representative.IsZero() {
+		addCondition("representative = ?", q.Representative.String())
+	}
+	if q.MinAmount > 0 {
+		addCondition("amount >= ?", q.MinAmount)
+	}
+	if q.MaxAmount > 0 {
+		addCondition("amount <= ?", q.MaxAmount)
+	}
+	if q.StartTime > 0 {
+		addCondition("timestamp >= ?", q.StartTime)
+	}
+	if q.EndTime > 0 {
+		addCondition("timestamp <= ?", q.EndTime)
+	}
+	if q.Method != "" {
+		addCondition("method = ?", q.Method)
+	}
+	if q.Cursor != "" {
+		timestamp, hash, err := parseBlockCursor(q.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		addCondition("(timestamp < ? or (timestamp = ? and hash < ?))", timestamp, timestamp, hash)
+	}
+
+	sql := fmt.Sprintf("select hash, timestamp from %s", r.tables[getIdentityID(new(types.BlockHash))].tableName)
+	if len(conditions) > 0 {
+		sql = sql + " where " + strings.Join(conditions, " and ")
+	}
+	sql = sql + fmt.Sprintf(" order by timestamp desc, hash desc limit %d", q.Limit+1)
+	sql = r.db.Rebind(sql)
+	r.logger.Debug(sql, args)
+
+	var h []types.BlockHash
+	if err := r.db.Select(&h, sql, args...); err != nil {
+		return nil, "", fmt.Errorf("read error, sql: %s, err: %s", sql, err.Error())
+	}
+	cursor := ""
+	if len(h) > q.Limit {
+		h = h[:q.Limit]
+		last := h[len(h)-1]
+		cursor = fmt.Sprintf("%d:%s", last.Timestamp, last.Hash)
+	}
+	hashes, err := blockHash(h)
+	if err != nil {
+		return nil, "", err
+	}
+	return hashes, cursor, nil
+}
+
+func parseBlockCursor(cursor string) (int64, string, error) {
+	s := strings.Split(cursor, ":")
+	if len(s) != 2 {
+		return 0, "", fmt.Errorf("invalid cursor %s", cursor)
+	}
+	timestamp, err := strconv.ParseInt(s[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid cursor %s", cursor)
+	}
+	var hash types.Hash
+	if err := hash.Of(s[1]); err != nil {
+		return 0, "", fmt.Errorf("invalid cursor %s", cursor)
+	}
+	return timestamp, hash.String(), nil
+}
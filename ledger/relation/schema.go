@@ -19,6 +19,9 @@ func convertSchemaType(db string, typ string) string {
 	case "mysql":
 		// TODO mysql type may different
 		return typ
+	case "postgres":
+		// numeric columns must keep their type for range queries
+		return typ
 	default:
 		return "varchar(100)"
 	}
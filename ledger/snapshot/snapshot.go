@@ -0,0 +1,548 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package snapshot exports the frontier state of a ledger as of the PoV state at a height, the PoV state
+// trie and the PoV blocks of the last target cycle to a versioned and checksummed archive, and imports it
+// to bootstrap a node from the checkpoint.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/storage/db"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+// Version of the archive format
+const Version = 1
+
+var magic = []byte("QLCSNAP\x00")
+
+var (
+	ErrInvalidMagic      = errors.New("invalid snapshot archive")
+	ErrInvalidVersion    = errors.New("unsupported snapshot version")
+	ErrInvalidChecksum   = errors.New("snapshot checksum mismatch")
+	ErrInvalidPovBlock   = errors.New("invalid pov block in snapshot")
+	ErrStateHashMismatch = errors.New("snapshot state hash mismatch")
+	ErrStateMismatch     = errors.New("frontier state does not match the pov state")
+	ErrLedgerNotEmpty    = errors.New("snapshot can only be imported to an empty ledger")
+	ErrContractChanged   = errors.New("vm storage is changed after the pov height")
+)
+
+const (
+	recordKV byte = iota + 1
+	recordTrie
+	recordPovBlock
+	recordPovTD
+	recordEnd = byte(0xff)
+)
+
+// prefixes of the frontier state which are copied as they are
+var statePrefixes = []storage.KeyPrefix{
+	storage.KeyPrefixAccount,
+	storage.KeyPrefixFrontier,
+	storage.KeyPrefixPending,
+	storage.KeyPrefixRepresentation,
+	storage.KeyPrefixVMStorage,
+}
+
+// Meta describes the content of a snapshot archive
+type Meta struct {
+	Version   uint32     `json:"version"`
+	Height    uint64     `json:"height"`
+	Hash      types.Hash `json:"hash"`
+	StateHash types.Hash `json:"stateHash"`
+	Timestamp int64      `json:"timestamp"`
+}
+
+// Export writes the frontier state of l at the PoV state of height and the PoV state to w, the blocks
+// confirmed after height must not be contract blocks. The PoV blocks of the last target cycle are
+// exported with the genesis block, so the chain can be validated from height after import.
+func Export(l ledger.Store, w io.Writer, height uint64) (*Meta, error) {
+	if err := l.Flush(); err != nil {
+		return nil, fmt.Errorf("flush ledger: %s", err)
+	}
+	store := l.DBStore()
+
+	var povBlocks []*types.PovBlock
+	var tds []*types.PovTD
+	for _, h := range povHeights(height) {
+		blk, err := l.GetPovBlockByHeight(h)
+		if err != nil {
+			return nil, fmt.Errorf("get pov block %d: %s", h, err)
+		}
+		td, err := l.GetPovTD(blk.GetHash(), h)
+		if err != nil {
+			return nil, fmt.Errorf("get pov td %d: %s", h, err)
+		}
+		povBlocks = append(povBlocks, blk)
+		tds = append(tds, td)
+	}
+	povBlock := povBlocks[len(povBlocks)-1]
+
+	meta := &Meta{
+		Version:   Version,
+		Height:    height,
+		Hash:      povBlock.GetHash(),
+		StateHash: povBlock.GetStateHash(),
+		Timestamp: time.Now().Unix(),
+	}
+
+	states, err := loadPovStates(store, meta.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	kvs := db.NewMemoryStore()
+	defer func() {
+		_ = kvs.Close()
+	}()
+	if err := exportFrontier(l, states, kvs); err != nil {
+		return nil, err
+	}
+	if err := checkState(kvs, states); err != nil {
+		return nil, err
+	}
+
+	gw := gzip.NewWriter(w)
+	sw := newRecordWriter(gw)
+	if err := sw.writeMeta(meta); err != nil {
+		return nil, err
+	}
+
+	for _, p := range append(statePrefixes, storage.KeyPrefixBlock) {
+		if err := kvs.Iterator([]byte{byte(p)}, nil, func(k, v []byte) error {
+			return sw.writeRecord(recordKV, k, v)
+		}); err != nil {
+			return nil, fmt.Errorf("export prefix %d: %s", p, err)
+		}
+	}
+
+	if err := exportPovState(store, meta.StateHash, sw); err != nil {
+		return nil, err
+	}
+
+	for i, blk := range povBlocks {
+		blkBytes, err := blk.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		if err := sw.writeRecord(recordPovBlock, nil, blkBytes); err != nil {
+			return nil, err
+		}
+		tdBytes, err := tds[i].Serialize()
+		if err != nil {
+			return nil, err
+		}
+		if err := sw.writeRecord(recordPovTD, nil, tdBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sw.finish(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// povHeights returns the heights of the PoV blocks in the snapshot of height, the genesis block and the blocks
+// of the last target cycle, which are needed by the retarget and the median time of the next blocks
+func povHeights(height uint64) []uint64 {
+	heights := []uint64{0}
+	start := uint64(1)
+	if cycle := uint64(common.PovChainTargetCycle); height > cycle {
+		start = height - cycle
+	}
+	for h := start; h <= height; h++ {
+		heights = append(heights, h)
+	}
+	return heights
+}
+
+func exportPovState(store storage.Store, stateHash types.Hash, sw *recordWriter) error {
+	if stateHash.IsZero() {
+		return nil
+	}
+	writeNode := func(k, v []byte) error {
+		return sw.writeRecord(recordTrie, k, v)
+	}
+
+	gsTrie := trie.NewTrie(store, &stateHash, nil)
+	if gsTrie.Root == nil {
+		return fmt.Errorf("can not find pov state %s", stateHash)
+	}
+	if err := gsTrie.Export(writeNode); err != nil {
+		return fmt.Errorf("export pov state: %s", err)
+	}
+
+	it := gsTrie.NewIterator(statedb.PovCreateGlobalStateKey(statedb.PovGlobalStatePrefixCS, nil))
+	for key, value, ok := it.Next(); ok; key, value, ok = it.Next() {
+		cs := types.NewPovContractState()
+		if err := cs.Deserialize(value); err != nil {
+			return fmt.Errorf("deserialize contract state %x: %s", key, err)
+		}
+		if cs.StateHash.IsZero() {
+			continue
+		}
+		csTrie := trie.NewTrie(store, &cs.StateHash, nil)
+		if err := csTrie.Export(writeNode); err != nil {
+			return fmt.Errorf("export contract state %x: %s", key, err)
+		}
+	}
+	return nil
+}
+
+// Import reads a snapshot from r, verifies it, and writes it to l, which must not have any block.
+// The account metas and representations of the snapshot are verified against its PoV state, and if
+// stateHash is not zero, the PoV state must match it, otherwise nothing is written.
+func Import(l ledger.Store, r io.Reader, stateHash types.Hash) (*Meta, error) {
+	if err := l.Flush(); err != nil {
+		return nil, fmt.Errorf("flush ledger: %s", err)
+	}
+	if c, err := l.CountStateBlocks(); err != nil {
+		return nil, err
+	} else if c > 0 {
+		return nil, ErrLedgerNotEmpty
+	}
+	if _, err := l.GetPovLatestHeight(); err == nil {
+		return nil, ErrLedgerNotEmpty
+	}
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, ErrInvalidMagic
+	}
+	defer gr.Close()
+	sr := newRecordReader(gr)
+
+	meta, err := sr.readMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	// stage all records in memory until the archive is verified
+	kvs := db.NewMemoryStore()
+	nodes := db.NewMemoryStore()
+	defer func() {
+		_ = kvs.Close()
+		_ = nodes.Close()
+	}()
+	var povBlocks []*types.PovBlock
+	var tds []*types.PovTD
+
+	for {
+		kind, k, v, err := sr.readRecord()
+		if err != nil {
+			return nil, err
+		}
+		if kind == recordEnd {
+			break
+		}
+		switch kind {
+		case recordKV:
+			if len(k) == 0 || !isStateKey(k) {
+				return nil, fmt.Errorf("invalid state key %x", k)
+			}
+			err = kvs.Put(k, v)
+		case recordTrie:
+			if len(k) == 0 || k[0] != byte(storage.KeyPrefixTrie) {
+				return nil, fmt.Errorf("invalid trie key %x", k)
+			}
+			err = nodes.Put(k, v)
+		case recordPovBlock:
+			blk := new(types.PovBlock)
+			err = blk.Deserialize(v)
+			povBlocks = append(povBlocks, blk)
+		case recordPovTD:
+			td := new(types.PovTD)
+			err = td.Deserialize(v)
+			tds = append(tds, td)
+		default:
+			err = fmt.Errorf("invalid record type %d", kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := sr.verifyChecksum(); err != nil {
+		return nil, err
+	}
+
+	states, err := verify(meta, povBlocks, tds, nodes, stateHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkState(kvs, states); err != nil {
+		return nil, err
+	}
+	if err := checkBlocks(kvs); err != nil {
+		return nil, err
+	}
+
+	if err := l.DBStore().BatchWrite(false, func(batch storage.Batch) error {
+		put := func(k, v []byte) error {
+			return batch.Put(k, v)
+		}
+		for _, p := range statePrefixes {
+			if err := kvs.Iterator([]byte{byte(p)}, nil, put); err != nil {
+				return err
+			}
+		}
+		if err := kvs.Iterator([]byte{byte(storage.KeyPrefixBlock)}, nil, put); err != nil {
+			return err
+		}
+		return nodes.Iterator([]byte{byte(storage.KeyPrefixTrie)}, nil, put)
+	}); err != nil {
+		return nil, fmt.Errorf("write snapshot: %s", err)
+	}
+
+	for i, blk := range povBlocks {
+		if err := l.AddPovBlock(blk, tds[i]); err != nil {
+			return nil, err
+		}
+		if err := l.AddPovBestHash(blk.GetHeight(), blk.GetHash()); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.SetPovLatestHeight(meta.Height); err != nil {
+		return nil, err
+	}
+	if err := l.SetPovTxlScanCursor(meta.Height); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func isStateKey(k []byte) bool {
+	if k[0] == byte(storage.KeyPrefixBlock) {
+		return true
+	}
+	for _, p := range statePrefixes {
+		if k[0] == byte(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// verify checks the PoV blocks and the state trie of the snapshot, and returns the account and rep states
+func verify(meta *Meta, povBlocks []*types.PovBlock, tds []*types.PovTD, nodes storage.Store, stateHash types.Hash) (*povStates, error) {
+	if err := verifyPovBlocks(meta, povBlocks, tds); err != nil {
+		return nil, err
+	}
+	if povBlocks[len(povBlocks)-1].GetStateHash() != meta.StateHash {
+		return nil, ErrStateHashMismatch
+	}
+	if !stateHash.IsZero() && stateHash != meta.StateHash {
+		return nil, ErrStateHashMismatch
+	}
+	states := newPovStates()
+	if meta.StateHash.IsZero() {
+		return states, nil
+	}
+
+	csPrefix := statedb.PovCreateGlobalStateKey(statedb.PovGlobalStatePrefixCS, nil)
+	if err := trie.VerifyNodes(meta.StateHash, nodes.Get, func(key, value []byte) error {
+		if !bytes.HasPrefix(key, csPrefix) {
+			return states.add(key, value)
+		}
+		cs := types.NewPovContractState()
+		if err := cs.Deserialize(value); err != nil {
+			return fmt.Errorf("deserialize contract state %x: %s", key, err)
+		}
+		if cs.StateHash.IsZero() {
+			return nil
+		}
+		return trie.VerifyNodes(cs.StateHash, nodes.Get, func(key, value []byte) error {
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// verifyPovBlocks checks that the PoV blocks are the genesis block and the chain of the last target cycle
+// ending at the snapshot block
+func verifyPovBlocks(meta *Meta, povBlocks []*types.PovBlock, tds []*types.PovTD) error {
+	heights := povHeights(meta.Height)
+	if len(povBlocks) != len(heights) || len(tds) != len(heights) {
+		return ErrInvalidPovBlock
+	}
+	if !common.IsGenesisPovBlock(povBlocks[0]) {
+		return ErrInvalidPovBlock
+	}
+	for i, blk := range povBlocks {
+		if blk.GetHeight() != heights[i] || blk.ComputeHash() != blk.GetHash() {
+			return ErrInvalidPovBlock
+		}
+		if i > 1 && blk.GetPrevious() != povBlocks[i-1].GetHash() {
+			return ErrInvalidPovBlock
+		}
+	}
+	if last := povBlocks[len(povBlocks)-1]; last.GetHash() != meta.Hash {
+		return ErrInvalidPovBlock
+	}
+	return nil
+}
+
+// recordWriter writes records of the archive and hashes them for the checksum
+type recordWriter struct {
+	w  *bufio.Writer
+	h  hash.Hash
+	hw io.Writer
+}
+
+func newRecordWriter(w io.Writer) *recordWriter {
+	bw := bufio.NewWriter(w)
+	h := sha256.New()
+	return &recordWriter{w: bw, h: h, hw: io.MultiWriter(bw, h)}
+}
+
+func (sw *recordWriter) writeBytes(b []byte) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(b)))
+	if _, err := sw.hw.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := sw.hw.Write(b)
+	return err
+}
+
+func (sw *recordWriter) writeMeta(meta *Meta) error {
+	if _, err := sw.hw.Write(magic); err != nil {
+		return err
+	}
+	var v [4]byte
+	binary.BigEndian.PutUint32(v[:], meta.Version)
+	if _, err := sw.hw.Write(v[:]); err != nil {
+		return err
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return sw.writeBytes(data)
+}
+
+func (sw *recordWriter) writeRecord(kind byte, k, v []byte) error {
+	if _, err := sw.hw.Write([]byte{kind}); err != nil {
+		return err
+	}
+	if err := sw.writeBytes(k); err != nil {
+		return err
+	}
+	return sw.writeBytes(v)
+}
+
+// finish writes the end mark and the checksum of all bytes before it
+func (sw *recordWriter) finish() error {
+	if _, err := sw.hw.Write([]byte{recordEnd}); err != nil {
+		return err
+	}
+	if _, err := sw.w.Write(sw.h.Sum(nil)); err != nil {
+		return err
+	}
+	return sw.w.Flush()
+}
+
+// recordReader reads records of the archive and hashes the consumed bytes
+type recordReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func newRecordReader(r io.Reader) *recordReader {
+	return &recordReader{r: bufio.NewReader(r), h: sha256.New()}
+}
+
+func (sr *recordReader) readFull(n uint64) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		return nil, fmt.Errorf("read snapshot: %s", err)
+	}
+	sr.h.Write(b)
+	return b, nil
+}
+
+func (sr *recordReader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %s", err)
+	}
+	var buf [binary.MaxVarintLen64]byte
+	sr.h.Write(buf[:binary.PutUvarint(buf[:], n)])
+	return sr.readFull(n)
+}
+
+func (sr *recordReader) readMeta() (*Meta, error) {
+	m, err := sr.readFull(uint64(len(magic)))
+	if err != nil || !bytes.Equal(m, magic) {
+		return nil, ErrInvalidMagic
+	}
+	v, err := sr.readFull(4)
+	if err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(v) != Version {
+		return nil, ErrInvalidVersion
+	}
+	data, err := sr.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	meta := new(Meta)
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("invalid snapshot meta: %s", err)
+	}
+	return meta, nil
+}
+
+func (sr *recordReader) readRecord() (byte, []byte, []byte, error) {
+	kind, err := sr.readFull(1)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if kind[0] == recordEnd {
+		return recordEnd, nil, nil, nil
+	}
+	k, err := sr.readBytes()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	v, err := sr.readBytes()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return kind[0], k, v, nil
+}
+
+func (sr *recordReader) verifyChecksum() error {
+	sum := sr.h.Sum(nil)
+	checksum := make([]byte, len(sum))
+	if _, err := io.ReadFull(sr.r, checksum); err != nil || !bytes.Equal(sum, checksum) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
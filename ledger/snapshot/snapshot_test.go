@@ -0,0 +1,426 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+type snapshotData struct {
+	am       *types.AccountMeta
+	block    *types.StateBlock
+	povBlock *types.PovBlock
+	csKey    []byte
+	csVal    []byte
+}
+
+func setupSnapshotLedger(t *testing.T, l *ledger.Ledger) *snapshotData {
+	// state trie nodes are cached in a global pool, so contract state must be unique in each test
+	csKey := mock.Hash()
+	csVal := mock.Hash()
+	d := &snapshotData{
+		block: mock.StateBlockWithoutWork(),
+		csKey: csKey[:],
+		csVal: csVal[:],
+	}
+	if err := l.AddStateBlock(d.block); err != nil {
+		t.Fatal(err)
+	}
+	d.am = mock.AccountMeta(d.block.Address)
+	d.am.Tokens[0].Header = d.block.GetHash()
+	d.am.Tokens[0].Type = config.ChainToken()
+	d.am.CoinBalance = d.am.Tokens[0].Balance
+	if err := l.AddAccountMeta(d.am, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddAccountMetaHistory(d.am.Tokens[0], d.block, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	rep := d.am.Tokens[0].Representative
+	benefit := &types.Benefit{
+		Balance: d.am.CoinBalance,
+		Vote:    types.ZeroBalance,
+		Network: types.ZeroBalance,
+		Storage: types.ZeroBalance,
+		Oracle:  types.ZeroBalance,
+		Total:   d.am.CoinBalance,
+	}
+	if err := l.AddRepresentation(rep, benefit, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+
+	// pov state of the account meta and representation above
+	gsdb := statedb.NewPovGlobalStateDB(l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = d.am.CoinBalance
+	for _, tm := range d.am.Tokens {
+		as.TokenStates = append(as.TokenStates, &types.PovTokenState{
+			Type:           tm.Type,
+			Hash:           tm.Header,
+			Representative: tm.Representative,
+			Balance:        tm.Balance,
+		})
+	}
+	_ = gsdb.SetAccountState(d.am.Address, as)
+	rs := types.NewPovRepState()
+	rs.Balance = benefit.Balance
+	rs.Total = benefit.Total
+	_ = gsdb.SetRepState(rep, rs)
+	// online rep without weight
+	online := types.NewPovRepState()
+	online.Status = statedb.PovStatusOnline
+	_ = gsdb.SetRepState(mock.Address(), online)
+	_ = gsdb.SetContractValue(contractaddress.PubKeyDistributionAddress, d.csKey, d.csVal)
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	// more blocks than a target cycle, the state is at the latest one
+	blk, td := mock.GenerateGenesisPovBlock()
+	for i := 0; ; i++ {
+		if err := l.AddPovBlock(blk, td); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.AddPovBestHash(blk.GetHeight(), blk.GetHash()); err != nil {
+			t.Fatal(err)
+		}
+		if i == common.PovChainTargetCycle+5 {
+			break
+		}
+		blk, td = mock.GeneratePovBlock(blk, 0)
+		if i == common.PovChainTargetCycle+4 {
+			blk.Header.CbTx.StateHash = gsdb.GetCurHash()
+			mock.UpdatePovHash(blk)
+		}
+	}
+	if err := l.SetPovLatestHeight(blk.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	d.povBlock = blk
+	return d
+}
+
+func TestSnapshot_ExportImport(t *testing.T) {
+	teardown1, l1 := ledger.NewTestLedger()
+	defer teardown1()
+	teardown2, l2 := ledger.NewTestLedger()
+	defer teardown2()
+
+	d := setupSnapshotLedger(t, l1)
+
+	buf := new(bytes.Buffer)
+	meta, err := Export(l1, buf, d.povBlock.GetHeight())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.StateHash != d.povBlock.GetStateHash() || meta.Hash != d.povBlock.GetHash() {
+		t.Fatal("invalid meta", meta)
+	}
+
+	if _, err := Import(l2, bytes.NewReader(buf.Bytes()), mock.Hash()); err != ErrStateHashMismatch {
+		t.Fatal("expect state hash mismatch, got", err)
+	}
+	if _, err := l2.GetAccountMeta(d.am.Address); err == nil {
+		t.Fatal("nothing should be written by failed import")
+	}
+
+	if _, err := Import(l2, bytes.NewReader(buf.Bytes()), meta.StateHash); err != nil {
+		t.Fatal(err)
+	}
+
+	am, err := l2.GetAccountMeta(d.am.Address)
+	if err != nil || len(am.Tokens) != len(d.am.Tokens) {
+		t.Fatal("invalid account meta", err)
+	}
+	if _, err := l2.GetStateBlockConfirmed(d.block.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := l2.GetPovLatestHeight(); err != nil || h != meta.Height {
+		t.Fatal("invalid pov latest height", h, err)
+	}
+	if blk, err := l2.GetLatestPovBlock(); err != nil || blk.GetHash() != meta.Hash {
+		t.Fatal("invalid pov latest block", err)
+	}
+
+	gsdb := statedb.NewPovGlobalStateDB(l2.DBStore(), meta.StateHash)
+	as, err := gsdb.GetAccountState(d.am.Address)
+	if err != nil || as.Balance.Compare(d.am.CoinBalance) != types.BalanceCompEqual {
+		t.Fatal("invalid account state", err)
+	}
+	if b, err := l2.GetRepresentation(d.am.Tokens[0].Representative); err != nil || !b.Total.Equal(d.am.CoinBalance) {
+		t.Fatal("invalid representation", err)
+	}
+	if v, err := gsdb.GetContractValue(contractaddress.PubKeyDistributionAddress, d.csKey); err != nil || !bytes.Equal(v, d.csVal) {
+		t.Fatal("invalid contract value", err)
+	}
+}
+
+func TestSnapshot_Corrupted(t *testing.T) {
+	teardown1, l1 := ledger.NewTestLedger()
+	defer teardown1()
+	teardown2, l2 := ledger.NewTestLedger()
+	defer teardown2()
+
+	d := setupSnapshotLedger(t, l1)
+
+	buf := new(bytes.Buffer)
+	if _, err := Export(l1, buf, d.povBlock.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := ioutil.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pack := func(data []byte) *bytes.Reader {
+		b := new(bytes.Buffer)
+		gw := gzip.NewWriter(b)
+		_, _ = gw.Write(data)
+		_ = gw.Close()
+		return bytes.NewReader(b.Bytes())
+	}
+
+	forged := append([]byte{}, raw...)
+	forged[len(forged)-40] ^= 0xff
+	if _, err := Import(l2, pack(forged), types.ZeroHash); err != ErrInvalidChecksum {
+		t.Fatal("expect checksum error, got", err)
+	}
+
+	forged = append([]byte{}, raw...)
+	forged[len(magic)+3] = 0xff
+	if _, err := Import(l2, pack(forged), types.ZeroHash); err != ErrInvalidVersion {
+		t.Fatal("expect version error, got", err)
+	}
+
+	if _, err := Import(l2, pack(raw[:len(raw)/2]), types.ZeroHash); err == nil {
+		t.Fatal("truncated snapshot should fail")
+	}
+}
+
+// rewrite re-encodes the records of a snapshot by fn with a valid checksum
+func rewrite(t *testing.T, data []byte, fn func(kind byte, k, v []byte) []byte) *bytes.Reader {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr := newRecordReader(gr)
+	meta, err := sr.readMeta()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	gw := gzip.NewWriter(b)
+	sw := newRecordWriter(gw)
+	if err := sw.writeMeta(meta); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		kind, k, v, err := sr.readRecord()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kind == recordEnd {
+			break
+		}
+		if err := sw.writeRecord(kind, k, fn(kind, k, v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sw.finish(); err != nil {
+		t.Fatal(err)
+	}
+	_ = gw.Close()
+	return bytes.NewReader(b.Bytes())
+}
+
+func TestSnapshot_StateMismatch(t *testing.T) {
+	teardown1, l1 := ledger.NewTestLedger()
+	defer teardown1()
+	teardown2, l2 := ledger.NewTestLedger()
+	defer teardown2()
+
+	d := setupSnapshotLedger(t, l1)
+
+	buf := new(bytes.Buffer)
+	if _, err := Export(l1, buf, d.povBlock.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+
+	forgeAccount := func(kind byte, k, v []byte) []byte {
+		if kind != recordKV || k[0] != byte(storage.KeyPrefixAccount) {
+			return v
+		}
+		am := new(types.AccountMeta)
+		if err := am.Deserialize(v); err != nil {
+			t.Fatal(err)
+		}
+		am.CoinBalance = am.CoinBalance.Add(types.NewBalance(1))
+		am.Tokens[0].Balance = am.CoinBalance
+		v, _ = am.Serialize()
+		return v
+	}
+	forgeRep := func(kind byte, k, v []byte) []byte {
+		if kind != recordKV || k[0] != byte(storage.KeyPrefixRepresentation) {
+			return v
+		}
+		b := new(types.Benefit)
+		if err := b.Deserialize(v); err != nil {
+			t.Fatal(err)
+		}
+		b.Vote = types.NewBalance(1)
+		v, _ = b.Serialize()
+		return v
+	}
+	forgeBlock := func(kind byte, k, v []byte) []byte {
+		if kind != recordKV || k[0] != byte(storage.KeyPrefixBlock) {
+			return v
+		}
+		blk := new(types.StateBlock)
+		if err := blk.Deserialize(v); err != nil {
+			t.Fatal(err)
+		}
+		blk.Balance = blk.Balance.Add(types.NewBalance(1))
+		v, _ = blk.Serialize()
+		return v
+	}
+	for _, fn := range []func(kind byte, k, v []byte) []byte{forgeAccount, forgeRep, forgeBlock} {
+		if _, err := Import(l2, rewrite(t, buf.Bytes(), fn), types.ZeroHash); err == nil ||
+			!strings.Contains(err.Error(), ErrStateMismatch.Error()) {
+			t.Fatal("expect state mismatch, got", err)
+		}
+	}
+	if _, err := l2.GetAccountMeta(d.am.Address); err == nil {
+		t.Fatal("nothing should be written by failed import")
+	}
+}
+
+func TestSnapshot_LedgerAhead(t *testing.T) {
+	teardown1, l1 := ledger.NewTestLedger()
+	defer teardown1()
+	teardown2, l2 := ledger.NewTestLedger()
+	defer teardown2()
+
+	d := setupSnapshotLedger(t, l1)
+
+	// a send confirmed after the pov state
+	tm := d.am.Tokens[0]
+	send := mock.StateBlockWithoutWork()
+	send.Type = types.Send
+	send.Address = d.am.Address
+	send.Token = tm.Type
+	send.Previous = tm.Header
+	send.Representative = tm.Representative
+	send.Balance = tm.Balance.Sub(types.NewBalance(1))
+	send.Link = mock.Hash()
+	if err := l1.AddStateBlock(send); err != nil {
+		t.Fatal(err)
+	}
+	am := d.am.Clone()
+	am.Tokens[0].Header = send.GetHash()
+	am.Tokens[0].Balance = send.Balance
+	am.CoinBalance = send.Balance
+	if err := l1.UpdateAccountMeta(am, l1.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	pk := &types.PendingKey{Address: types.Address(send.Link), Hash: send.GetHash()}
+	if err := l1.AddPending(pk, &types.PendingInfo{Source: send.Address, Type: send.Token, Amount: types.NewBalance(1)},
+		l1.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := Export(l1, buf, d.povBlock.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(l2, bytes.NewReader(buf.Bytes()), types.ZeroHash); err != nil {
+		t.Fatal(err)
+	}
+	am2, err := l2.GetAccountMeta(d.am.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm2 := am2.Token(tm.Type); tm2 == nil || tm2.Header != tm.Header || !am2.CoinBalance.Equal(d.am.CoinBalance) {
+		t.Fatal("account meta should be at the pov state", am2)
+	}
+	if _, err := l2.GetPending(pk); err == nil {
+		t.Fatal("pending of the send after the pov state should not be exported")
+	}
+	for h := uint64(0); h <= d.povBlock.GetHeight(); h++ {
+		if _, err := l2.GetPovBlockByHeight(h); (err == nil) != (h == 0 || h >= d.povBlock.GetHeight()-uint64(common.PovChainTargetCycle)) {
+			t.Fatal("invalid pov block", h, err)
+		}
+	}
+
+	// vm storage may be changed by contract blocks
+	cs := mock.StateBlockWithoutWork()
+	cs.Type = types.ContractSend
+	cs.Address = d.am.Address
+	cs.Token = tm.Type
+	cs.Previous = send.GetHash()
+	cs.Representative = tm.Representative
+	cs.Balance = send.Balance
+	if err := l1.AddStateBlock(cs); err != nil {
+		t.Fatal(err)
+	}
+	am.Tokens[0].Header = cs.GetHash()
+	if err := l1.UpdateAccountMeta(am, l1.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Export(l1, new(bytes.Buffer), d.povBlock.GetHeight()); err == nil ||
+		!strings.Contains(err.Error(), ErrContractChanged.Error()) {
+		t.Fatal("expect contract changed, got", err)
+	}
+}
+
+func TestSnapshot_LedgerNotEmpty(t *testing.T) {
+	teardown1, l1 := ledger.NewTestLedger()
+	defer teardown1()
+	teardown2, l2 := ledger.NewTestLedger()
+	defer teardown2()
+
+	d := setupSnapshotLedger(t, l1)
+
+	buf := new(bytes.Buffer)
+	if _, err := Export(l1, buf, d.povBlock.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(l1, bytes.NewReader(buf.Bytes()), types.ZeroHash); err != ErrLedgerNotEmpty {
+		t.Fatal("expect ledger not empty, got", err)
+	}
+
+	if err := l2.AddStateBlock(mock.StateBlockWithoutWork()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(l2, bytes.NewReader(buf.Bytes()), types.ZeroHash); err != ErrLedgerNotEmpty {
+		t.Fatal("expect ledger not empty, got", err)
+	}
+}
@@ -0,0 +1,403 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+// povStates are the account and rep states of the PoV state trie, which the frontier state is checked against
+type povStates struct {
+	accounts map[types.Address]*types.PovAccountState
+	reps     map[types.Address]*types.PovRepState
+}
+
+func newPovStates() *povStates {
+	return &povStates{
+		accounts: make(map[types.Address]*types.PovAccountState),
+		reps:     make(map[types.Address]*types.PovRepState),
+	}
+}
+
+var (
+	povAccPrefix = statedb.PovCreateGlobalStateKey(statedb.PovGlobalStatePrefixAcc, nil)
+	povRepPrefix = statedb.PovCreateGlobalStateKey(statedb.PovGlobalStatePrefixRep, nil)
+)
+
+// add keeps the leaf of the state trie if it is an account or rep state
+func (ps *povStates) add(key, value []byte) error {
+	if len(value) == 0 {
+		return nil
+	}
+	switch {
+	case bytes.HasPrefix(key, povAccPrefix):
+		addr, err := statedb.PovStateKeyToAddress(key)
+		if err != nil {
+			return fmt.Errorf("invalid account state key %x: %s", key, err)
+		}
+		as := types.NewPovAccountState()
+		if err := as.Deserialize(value); err != nil {
+			return fmt.Errorf("deserialize account state %s: %s", addr, err)
+		}
+		ps.accounts[addr] = as
+	case bytes.HasPrefix(key, povRepPrefix):
+		addr, err := statedb.PovStateKeyToAddress(key)
+		if err != nil {
+			return fmt.Errorf("invalid rep state key %x: %s", key, err)
+		}
+		rs := types.NewPovRepState()
+		if err := rs.Deserialize(value); err != nil {
+			return fmt.Errorf("deserialize rep state %s: %s", addr, err)
+		}
+		ps.reps[addr] = rs
+	}
+	return nil
+}
+
+// loadPovStates reads the account and rep states of the state trie of stateHash from store
+func loadPovStates(store storage.Store, stateHash types.Hash) (*povStates, error) {
+	ps := newPovStates()
+	if stateHash.IsZero() {
+		return ps, nil
+	}
+	gsTrie := trie.NewTrie(store, &stateHash, nil)
+	if gsTrie.Root == nil {
+		return nil, fmt.Errorf("can not find pov state %s", stateHash)
+	}
+	for _, prefix := range [][]byte{povAccPrefix, povRepPrefix} {
+		it := gsTrie.NewIterator(prefix)
+		for key, value, ok := it.Next(); ok; key, value, ok = it.Next() {
+			if err := ps.add(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ps, nil
+}
+
+// checkState checks that the account metas and representations in kvs match the account and rep states
+// of the PoV state, so they are covered by its state hash. Frontiers, pendings and VM storage are not
+// in the PoV state and are not checked.
+func checkState(kvs storage.Store, ps *povStates) error {
+	accounts := make(map[types.Address]struct{})
+	if err := kvs.Iterator([]byte{byte(storage.KeyPrefixAccount)}, nil, func(k, v []byte) error {
+		am := new(types.AccountMeta)
+		if err := am.Deserialize(v); err != nil {
+			return fmt.Errorf("deserialize account meta %x: %s", k, err)
+		}
+		if !bytes.Equal(k[1:], am.Address[:]) {
+			return fmt.Errorf("%s: invalid account meta key %x", ErrStateMismatch, k)
+		}
+		if err := checkAccount(am, ps.accounts[am.Address]); err != nil {
+			return fmt.Errorf("%s: account %s %s", ErrStateMismatch, am.Address, err)
+		}
+		accounts[am.Address] = struct{}{}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for addr := range ps.accounts {
+		if _, ok := accounts[addr]; !ok {
+			return fmt.Errorf("%s: account %s not found", ErrStateMismatch, addr)
+		}
+	}
+
+	reps := make(map[types.Address]struct{})
+	if err := kvs.Iterator([]byte{byte(storage.KeyPrefixRepresentation)}, nil, func(k, v []byte) error {
+		addr, err := types.BytesToAddress(k[1:])
+		if err != nil {
+			return fmt.Errorf("invalid representation key %x: %s", k, err)
+		}
+		benefit := new(types.Benefit)
+		if err := benefit.Deserialize(v); err != nil {
+			return fmt.Errorf("deserialize representation %s: %s", addr, err)
+		}
+		if !sameRep(benefit, ps.reps[addr]) {
+			return fmt.Errorf("%s: representation %s", ErrStateMismatch, addr)
+		}
+		reps[addr] = struct{}{}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// reps without weight may be kept in the PoV state only to record that they are online
+	for addr, rs := range ps.reps {
+		if _, ok := reps[addr]; !ok && !sameRep(&types.Benefit{}, rs) {
+			return fmt.Errorf("%s: representation %s not found", ErrStateMismatch, addr)
+		}
+	}
+	return nil
+}
+
+func checkAccount(am *types.AccountMeta, as *types.PovAccountState) error {
+	if as == nil {
+		return fmt.Errorf("not found in pov state")
+	}
+	if !sameBalance(am.CoinBalance, as.Balance) || !sameBalance(am.CoinVote, as.Vote) ||
+		!sameBalance(am.CoinNetwork, as.Network) || !sameBalance(am.CoinStorage, as.Storage) ||
+		!sameBalance(am.CoinOracle, as.Oracle) {
+		return fmt.Errorf("balance mismatch")
+	}
+	if len(am.Tokens) != len(as.TokenStates) {
+		return fmt.Errorf("token count mismatch")
+	}
+	for _, tm := range am.Tokens {
+		ts := as.GetTokenState(tm.Type)
+		if ts == nil {
+			return fmt.Errorf("token %s not found", tm.Type)
+		}
+		if ts.Hash != tm.Header || ts.Representative != tm.Representative || !sameBalance(ts.Balance, tm.Balance) {
+			return fmt.Errorf("token %s mismatch", tm.Type)
+		}
+	}
+	return nil
+}
+
+func sameRep(b *types.Benefit, rs *types.PovRepState) bool {
+	if rs == nil {
+		rs = types.NewPovRepState()
+	}
+	return sameBalance(b.Balance, rs.Balance) && sameBalance(b.Vote, rs.Vote) &&
+		sameBalance(b.Network, rs.Network) && sameBalance(b.Storage, rs.Storage) &&
+		sameBalance(b.Oracle, rs.Oracle) && sameBalance(b.Total, rs.Total)
+}
+
+// sameBalance compares balances, a balance which is not set is zero
+func sameBalance(b1, b2 types.Balance) bool {
+	if b1.Int == nil {
+		b1 = types.ZeroBalance
+	}
+	if b2.Int == nil {
+		b2 = types.ZeroBalance
+	}
+	return b1.Equal(b2)
+}
+
+// checkBlocks checks that every block in kvs is stored by its hash
+func checkBlocks(kvs storage.Store) error {
+	return kvs.Iterator([]byte{byte(storage.KeyPrefixBlock)}, nil, func(k, v []byte) error {
+		blk := new(types.StateBlock)
+		if err := blk.Deserialize(v); err != nil {
+			return fmt.Errorf("deserialize block %x: %s", k, err)
+		}
+		if h := blk.GetHash(); !bytes.Equal(k[1:], h[:]) {
+			return fmt.Errorf("%s: block %x", ErrStateMismatch, k[1:])
+		}
+		return nil
+	})
+}
+
+// exportFrontier puts the frontier state at the PoV state to kvs. Account metas, frontiers and representations
+// are built from the PoV state, and the blocks confirmed after it are rolled back from the pendings. VM storage
+// has no history, so the blocks after the PoV state must not be contract blocks.
+func exportFrontier(l ledger.Store, ps *povStates, kvs storage.Store) error {
+	store := l.DBStore()
+
+	// blocks confirmed after the pov state
+	after := make(map[types.Hash]*types.StateBlock)
+	if err := l.GetAccountMetas(func(am *types.AccountMeta) error {
+		as := ps.accounts[am.Address]
+		for _, tm := range am.Tokens {
+			var stop types.Hash
+			if as != nil {
+				if ts := as.GetTokenState(tm.Type); ts != nil {
+					stop = ts.Hash
+				}
+			}
+			h := tm.Header
+			for h != stop && !h.IsZero() {
+				blk, err := l.GetStateBlockConfirmed(h)
+				if err != nil {
+					return fmt.Errorf("get block %s: %s", h, err)
+				}
+				after[h] = blk
+				h = blk.GetPrevious()
+			}
+			if h != stop {
+				return fmt.Errorf("%s: token %s of account %s is not in the chain", ErrStateMismatch, tm.Type, am.Address)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	putPending := func(pk *types.PendingKey, pi *types.PendingInfo) error {
+		k, err := storage.GetKeyOfParts(storage.KeyPrefixPending, pk)
+		if err != nil {
+			return err
+		}
+		v, err := pi.Serialize()
+		if err != nil {
+			return err
+		}
+		return kvs.Put(k, v)
+	}
+	for h, blk := range after {
+		if blk.IsContractBlock() {
+			return fmt.Errorf("%s: contract block %s is confirmed after the pov state", ErrContractChanged, h)
+		}
+		if blk.GetType() != types.Open && blk.GetType() != types.Receive {
+			continue
+		}
+		link := blk.GetLink()
+		if _, ok := after[link]; ok {
+			continue
+		}
+		// the send is in the pov state, so it is pending at the pov state
+		send, err := l.GetStateBlockConfirmed(link)
+		if err != nil {
+			return fmt.Errorf("get send block %s: %s", link, err)
+		}
+		if send.GetType() != types.Send {
+			return fmt.Errorf("%s: block %s receives contract block %s", ErrContractChanged, h, link)
+		}
+		prev, err := l.GetStateBlockConfirmed(send.GetPrevious())
+		if err != nil {
+			return fmt.Errorf("get block %s: %s", send.GetPrevious(), err)
+		}
+		if err := putPending(&types.PendingKey{Address: blk.GetAddress(), Hash: link}, &types.PendingInfo{
+			Source: send.GetAddress(),
+			Type:   send.GetToken(),
+			Amount: prev.GetBalance().Sub(send.GetBalance()),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := l.GetPendings(func(pk *types.PendingKey, pi *types.PendingInfo) error {
+		if _, ok := after[pk.Hash]; ok {
+			return nil
+		}
+		return putPending(pk, pi)
+	}); err != nil {
+		return err
+	}
+
+	for addr, as := range ps.accounts {
+		am := &types.AccountMeta{
+			Address:     addr,
+			CoinBalance: as.Balance,
+			CoinVote:    as.Vote,
+			CoinNetwork: as.Network,
+			CoinStorage: as.Storage,
+			CoinOracle:  as.Oracle,
+		}
+		for _, ts := range as.TokenStates {
+			tm, err := tokenMetaAt(l, addr, ts)
+			if err != nil {
+				return err
+			}
+			am.Tokens = append(am.Tokens, tm)
+
+			k, _ := storage.GetKeyOfParts(storage.KeyPrefixFrontier, tm.Header)
+			v, err := tm.OpenBlock.Serialize()
+			if err != nil {
+				return err
+			}
+			if err := kvs.Put(k, v); err != nil {
+				return err
+			}
+		}
+		k, _ := storage.GetKeyOfParts(storage.KeyPrefixAccount, addr)
+		v, err := am.Serialize()
+		if err != nil {
+			return err
+		}
+		if err := kvs.Put(k, v); err != nil {
+			return err
+		}
+	}
+
+	zero := new(types.Benefit)
+	for addr, rs := range ps.reps {
+		if sameRep(zero, rs) {
+			continue
+		}
+		benefit := &types.Benefit{
+			Balance: rs.Balance,
+			Vote:    rs.Vote,
+			Network: rs.Network,
+			Storage: rs.Storage,
+			Oracle:  rs.Oracle,
+			Total:   rs.Total,
+		}
+		k, _ := storage.GetKeyOfParts(storage.KeyPrefixRepresentation, addr)
+		v, err := benefit.Serialize()
+		if err != nil {
+			return err
+		}
+		if err := kvs.Put(k, v); err != nil {
+			return err
+		}
+	}
+
+	if err := store.Iterator([]byte{byte(storage.KeyPrefixVMStorage)}, nil, kvs.Put); err != nil {
+		return err
+	}
+
+	// blocks which new blocks of the frontier may refer to
+	blocks := make(map[types.Hash]struct{})
+	if err := kvs.Iterator([]byte{byte(storage.KeyPrefixFrontier)}, nil, func(k, v []byte) error {
+		open := new(types.Hash)
+		if err := open.Deserialize(v); err != nil {
+			return err
+		}
+		header, _ := types.BytesToHash(k[1:])
+		blocks[header] = struct{}{}
+		blocks[*open] = struct{}{}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := kvs.Iterator([]byte{byte(storage.KeyPrefixPending)}, nil, func(k, v []byte) error {
+		pk := new(types.PendingKey)
+		if err := pk.Deserialize(k[1:]); err != nil {
+			return err
+		}
+		blocks[pk.Hash] = struct{}{}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for h := range blocks {
+		k, _ := storage.GetKeyOfParts(storage.KeyPrefixBlock, h)
+		v, err := store.Get(k)
+		if err != nil {
+			if err == storage.KeyNotFound {
+				continue
+			}
+			return fmt.Errorf("export block %s: %s", h, err)
+		}
+		if err := kvs.Put(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tokenMetaAt returns the token meta of the account whose header is the block of the token state
+func tokenMetaAt(l ledger.Store, addr types.Address, ts *types.PovTokenState) (*types.TokenMeta, error) {
+	tm, err := l.GetTokenMetaByBlockHash(ts.Hash)
+	if err != nil {
+		// the history is not kept for blocks processed by old versions
+		if tm, err = l.GetTokenMetaConfirmed(addr, ts.Type); err != nil {
+			return nil, fmt.Errorf("get token %s of account %s: %s", ts.Type, addr, err)
+		}
+	}
+	if tm.Header != ts.Hash || tm.Type != ts.Type || tm.BelongTo != addr {
+		return nil, fmt.Errorf("%s: token %s of account %s at block %s not found", ErrStateMismatch, ts.Type, addr, ts.Hash)
+	}
+	return tm, nil
+}
@@ -0,0 +1,39 @@
+// +build  !debug
+
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package log
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// keys of the fields shared by modules, the name of logger is encoded as module
+const (
+	ModuleKey    = "module"
+	PeerKey      = "peer"
+	BlockHashKey = "hash"
+	AccountKey   = "account"
+)
+
+// Peer is the field of peer id
+func Peer(id string) zap.Field {
+	return zap.String(PeerKey, id)
+}
+
+// BlockHash is the field of block hash
+func BlockHash(hash fmt.Stringer) zap.Field {
+	return zap.Stringer(BlockHashKey, hash)
+}
+
+// Account is the field of account address
+func Account(address fmt.Stringer) zap.Field {
+	return zap.Stringer(AccountKey, address)
+}
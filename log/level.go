@@ -0,0 +1,142 @@
+// +build  !debug
+
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+)
+
+// modules keeps the name of loggers created by NewLogger and their level overrides,
+// the loggers without override use the global level
+var modules = &moduleLevels{names: make(map[string]struct{})}
+
+type moduleLevels struct {
+	locker sync.Mutex
+	names  map[string]struct{}
+	// map[string]zapcore.Level, it is replaced on change so that it can be read without lock
+	levels atomic.Value
+}
+
+func init() {
+	modules.levels.Store(make(map[string]zapcore.Level))
+}
+
+func (m *moduleLevels) add(module string) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+	m.names[module] = struct{}{}
+}
+
+func (m *moduleLevels) enabled(module string, l zapcore.Level) bool {
+	if lv, ok := m.levels.Load().(map[string]zapcore.Level)[module]; ok {
+		return lv.Enabled(l)
+	}
+	return level.Enabled(l)
+}
+
+func (m *moduleLevels) update(fn func(levels map[string]zapcore.Level)) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	levels := make(map[string]zapcore.Level)
+	for k, v := range m.levels.Load().(map[string]zapcore.Level) {
+		levels[k] = v
+	}
+	fn(levels)
+	m.levels.Store(levels)
+}
+
+// SetModuleLevel changes the level of the named logger, the logger uses the global level again if l is empty
+func SetModuleLevel(module string, l string) error {
+	if module == "" {
+		return SetLevel(l)
+	}
+	if l == "" {
+		modules.update(func(levels map[string]zapcore.Level) {
+			delete(levels, module)
+		})
+		return nil
+	}
+	var lv zapcore.Level
+	if err := lv.UnmarshalText([]byte(l)); err != nil {
+		return err
+	}
+	modules.update(func(levels map[string]zapcore.Level) {
+		levels[module] = lv
+	})
+	return nil
+}
+
+// SetModuleLevels replaces all level overrides of the named loggers, each item is in the format of module:level
+func SetModuleLevels(items []string) error {
+	parsed := make(map[string]zapcore.Level)
+	for _, item := range items {
+		s := strings.Split(item, ":")
+		if len(s) != 2 || strings.TrimSpace(s[0]) == "" {
+			return fmt.Errorf("invalid module level %s", item)
+		}
+		var lv zapcore.Level
+		if err := lv.UnmarshalText([]byte(strings.TrimSpace(s[1]))); err != nil {
+			return err
+		}
+		parsed[strings.TrimSpace(s[0])] = lv
+	}
+	modules.update(func(levels map[string]zapcore.Level) {
+		for k := range levels {
+			delete(levels, k)
+		}
+		for k, v := range parsed {
+			levels[k] = v
+		}
+	})
+	return nil
+}
+
+// Levels returns the global level and the level of each named logger
+func Levels() (string, map[string]string) {
+	modules.locker.Lock()
+	defer modules.locker.Unlock()
+
+	global := level.Level().String()
+	result := make(map[string]string, len(modules.names))
+	for name := range modules.names {
+		result[name] = global
+	}
+	for name, lv := range modules.levels.Load().(map[string]zapcore.Level) {
+		result[name] = lv.String()
+	}
+	return global, result
+}
+
+// moduleCore filters the entries by the level of the named logger
+type moduleCore struct {
+	zapcore.Core
+	module string
+}
+
+func (c *moduleCore) Enabled(l zapcore.Level) bool {
+	return modules.enabled(c.module, l)
+}
+
+func (c *moduleCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleCore{Core: c.Core.With(fields), module: c.module}
+}
+
+func (c *moduleCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(ent.Level) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
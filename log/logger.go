@@ -29,6 +29,7 @@ const (
 var (
 	logger *zap.Logger
 	Root   *zap.SugaredLogger
+	level  = zap.NewAtomicLevelAt(zap.ErrorLevel)
 )
 
 func init() {
@@ -60,34 +61,37 @@ func Setup(cfg *config.Config) (err error) {
 		Compress:   true,
 		LocalTime:  true,
 	})
-	l := zap.ErrorLevel
-	if err := l.Set(cfg.LogLevel); err != nil {
+	if err := SetLevel(cfg.LogLevel); err != nil {
 		fmt.Println(err)
 	}
-	consoleDebugging := zapcore.Lock(os.Stdout)
+	jsonEncoder := zapcore.NewJSONEncoder(jsonEncoderConfig())
 	consoleEncoder := zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
-	core := zapcore.NewTee(
-		zapcore.NewCore(consoleEncoder, consoleDebugging, l),
-		zapcore.NewCore(zapcore.NewJSONEncoder(zapcore.EncoderConfig{
-			TimeKey:        "ts",
-			LevelKey:       "level",
-			NameKey:        "logger",
-			CallerKey:      "caller",
-			MessageKey:     "msg",
-			StacktraceKey:  "stacktrace",
-			LineEnding:     zapcore.DefaultLineEnding,
-			EncodeLevel:    zapcore.LowercaseLevelEncoder,
-			EncodeTime:     zapcore.ISO8601TimeEncoder,
-			EncodeDuration: zapcore.SecondsDurationEncoder,
-			EncodeCaller:   zapcore.ShortCallerEncoder,
-		}), w, l),
-	)
+	if cfg.Log != nil {
+		if cfg.Log.Encoding == "json" {
+			consoleEncoder = jsonEncoder
+		}
+		if err := SetModuleLevels(cfg.Log.Levels); err != nil {
+			fmt.Println(err)
+		}
+	}
+	// entries are filtered by moduleCore, so the cores accept all levels
+	all := zap.LevelEnablerFunc(func(zapcore.Level) bool { return true })
+	consoleDebugging := zapcore.Lock(os.Stdout)
+	core := &moduleCore{Core: zapcore.NewTee(
+		zapcore.NewCore(consoleEncoder, consoleDebugging, all),
+		zapcore.NewCore(jsonEncoder, w, all),
+	)}
 
 	logger = zap.New(core, zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
 
 	return nil
 }
 
+// SetLevel changes the global level of the loggers created by Setup, it takes effect immediately
+func SetLevel(l string) error {
+	return level.UnmarshalText([]byte(l))
+}
+
 func Teardown() error {
 	if logger != nil {
 		return logger.Sync()
@@ -95,7 +99,29 @@ func Teardown() error {
 	return nil
 }
 
-//NewLogger create logger by name
+func jsonEncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		LevelKey:       "level",
+		NameKey:        ModuleKey,
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+}
+
+//NewLogger create logger by name, its level can be changed by SetModuleLevel
 func NewLogger(name string) *zap.SugaredLogger {
-	return logger.Sugar().Named(name)
+	modules.add(name)
+	return logger.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		if mc, ok := c.(*moduleCore); ok {
+			return &moduleCore{Core: mc.Core, module: name}
+		}
+		return c
+	})).Sugar().Named(name)
 }
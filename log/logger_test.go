@@ -9,8 +9,11 @@ package log
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/qlcchain/go-qlc/config"
@@ -58,6 +61,50 @@ func TestInit(t *testing.T) {
 	logger.Warn("xxxxxxxxxxxxxxxxxxxxxx")
 }
 
+func TestSetModuleLevel(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "log", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cfg, err := config.DefaultConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.LogLevel = "error"
+	cfg.Log.Encoding = "json"
+	cfg.Log.Levels = []string{"test_dpos:debug"}
+	if err := Setup(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	dpos := NewLogger("test_dpos").Desugar().Core()
+	sync := NewLogger("test_sync").Desugar().Core()
+	if !dpos.Enabled(zap.DebugLevel) || sync.Enabled(zap.InfoLevel) || !sync.Enabled(zap.ErrorLevel) {
+		t.Fatal("invalid level of config")
+	}
+
+	if err := SetModuleLevel("test_sync", "info"); err != nil {
+		t.Fatal(err)
+	}
+	if !sync.Enabled(zap.InfoLevel) || sync.Enabled(zap.DebugLevel) {
+		t.Fatal("module level not changed")
+	}
+	global, levels := Levels()
+	if global != "error" || levels["test_dpos"] != "debug" || levels["test_sync"] != "info" {
+		t.Fatal(global, levels)
+	}
+
+	if err := SetModuleLevels([]string{"test_dpos"}); err == nil {
+		t.Fatal("invalid module level should return error")
+	}
+	if err := SetModuleLevels(nil); err != nil {
+		t.Fatal(err)
+	}
+	if dpos.Enabled(zap.DebugLevel) || sync.Enabled(zap.InfoLevel) {
+		t.Fatal("module level not reset")
+	}
+}
+
 //
 //func TestDynamicLevel(t *testing.T) {
 //	ctx, cancel := context.WithCancel(context.Background())
@@ -99,6 +99,11 @@ func (miner *Miner) Stop() error {
 	return nil
 }
 
+// SetMinerConfig applies the coinbase and algoName of PoV config to the running miner
+func (miner *Miner) SetMinerConfig(cfg *config.PoVConfig) error {
+	return miner.povWorker.SetMinerConfig(cfg)
+}
+
 func (miner *Miner) GetConfig() *config.Config {
 	return miner.cfg
 }
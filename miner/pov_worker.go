@@ -36,6 +36,9 @@ type PovWorker struct {
 	minerAlgoBlocks map[types.Address]map[types.PovAlgoType]*PovMinerAlgoBlock
 	lastMineHeight  uint64
 	muxMineBlock    sync.Mutex
+	muxSubmit       sync.Mutex
+
+	stratum *StratumServer
 
 	quitCh         chan struct{}
 	feb            *event.FeedEventBus
@@ -100,6 +103,10 @@ func (w *PovWorker) Init() error {
 		}
 	}
 
+	if cfg.PoV.Stratum != nil && cfg.PoV.Stratum.Enable {
+		w.stratum = NewStratumServer(w, cfg.PoV.Stratum)
+	}
+
 	return nil
 }
 
@@ -115,12 +122,22 @@ func (w *PovWorker) Start() error {
 		common.Go(w.cpuMiningLoop)
 	}
 
+	if w.stratum != nil {
+		if err := w.stratum.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (w *PovWorker) Stop() error {
 	w.febRpcMsgSubID.Unsubscribe()
 
+	if w.stratum != nil {
+		w.stratum.Stop()
+	}
+
 	if w.quitCh != nil {
 		close(w.quitCh)
 	}
@@ -128,6 +145,33 @@ func (w *PovWorker) Stop() error {
 	return nil
 }
 
+// SetMinerConfig changes the miner address and algo by the coinbase and algoName of PoV config,
+// the cpu mining loop uses them from the next block
+func (w *PovWorker) SetMinerConfig(cfg *config.PoVConfig) error {
+	minerAddr := types.ZeroAddress
+	if cfg.Coinbase != "" {
+		var err error
+		minerAddr, err = types.HexToAddress(cfg.Coinbase)
+		if err != nil {
+			return fmt.Errorf("invalid coinbase address %s", cfg.Coinbase)
+		}
+	}
+	algoType := types.ALGO_UNKNOWN
+	if cfg.AlgoName != "" {
+		algoType = types.NewPoVHashAlgoFromStr(cfg.AlgoName)
+		if algoType == types.ALGO_UNKNOWN {
+			return fmt.Errorf("invalid algo name %s", cfg.AlgoName)
+		}
+	}
+
+	w.minerAddr = minerAddr
+	w.minerAccount = nil
+	w.algoType = algoType
+	w.logger.Infof("miner config changed, coinbase %s, algo %s", minerAddr, algoType)
+
+	return nil
+}
+
 func (w *PovWorker) GetConfig() *config.Config {
 	return w.miner.GetConfig()
 }
@@ -199,26 +243,11 @@ func (w *PovWorker) GetWork(in interface{}, out interface{}) {
 	inArgs := in.(map[interface{}]interface{})
 	outArgs := out.(map[interface{}]interface{})
 
-	if w.miner.GetSyncState() != topic.SyncDone {
-		outArgs["err"] = fmt.Errorf("miner pausing for sync state %s", w.miner.GetSyncState())
-		return
-	}
-
 	minerAddr := inArgs["minerAddr"].(types.Address)
 	algoName := inArgs["algoName"].(string)
 	algoType := types.NewPoVHashAlgoFromStr(algoName)
-	if !common.PovIsAlgoSupported(algoType) {
-		outArgs["err"] = errors.New("unknown algorithm name")
-		return
-	}
-
-	err := w.checkMinerPledge(minerAddr)
-	if err != nil {
-		outArgs["err"] = err
-		return
-	}
 
-	mineBlock, err := w.generateBlock(minerAddr, algoType)
+	mineBlock, err := w.getWorkBlock(minerAddr, algoType)
 	if err != nil {
 		outArgs["err"] = err
 		return
@@ -234,21 +263,48 @@ func (w *PovWorker) SubmitWork(in interface{}, out interface{}) {
 
 	result := inArgs["mineResult"].(*types.PovMineResult)
 
+	err := w.submitMineResult(result)
+	if err != nil {
+		outArgs["err"] = err
+		return
+	}
+
+	outArgs["err"] = nil
+}
+
+func (w *PovWorker) getWorkBlock(minerAddr types.Address, algoType types.PovAlgoType) (*types.PovMineBlock, error) {
+	if w.miner.GetSyncState() != topic.SyncDone {
+		return nil, fmt.Errorf("miner pausing for sync state %s", w.miner.GetSyncState())
+	}
+
+	if !common.PovIsAlgoSupported(algoType) {
+		return nil, errors.New("unknown algorithm name")
+	}
+
+	err := w.checkMinerPledge(minerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.generateBlock(minerAddr, algoType)
+}
+
+func (w *PovWorker) submitMineResult(result *types.PovMineResult) error {
+	w.muxSubmit.Lock()
+	defer w.muxSubmit.Unlock()
+
 	mineBlock := w.findBlockInPool(result.WorkHash)
 	if mineBlock == nil {
-		outArgs["err"] = errors.New("failed to find block by WorkHash")
-		return
+		return errors.New("failed to find block by WorkHash")
 	}
 
 	err := w.checkAndFillBlockByResult(mineBlock, result)
 	if err != nil {
-		outArgs["err"] = err
-		return
+		return err
 	}
 
 	w.submitBlock(mineBlock)
-
-	outArgs["err"] = nil
+	return nil
 }
 
 func (w *PovWorker) StartMining(in interface{}, out interface{}) {
@@ -330,6 +386,10 @@ func (w *PovWorker) GetMiningInfo(in interface{}, out interface{}) {
 	outArgs["minerAlgo"] = w.algoType
 	outArgs["cpuMining"] = w.cpuMining
 
+	if w.stratum != nil {
+		outArgs["stratumWorkers"] = w.stratum.GetWorkerStats()
+	}
+
 	outArgs["err"] = nil
 }
 
@@ -0,0 +1,860 @@
+package miner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/merkle"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	stratumExtraNonce1Size = 4
+	stratumExtraNonce2Size = 4
+	stratumMaxJobsPerConn  = 8
+	stratumMaxLineSize     = 8192
+	stratumReadTimeout     = 10 * time.Minute
+	stratumWriteTimeout    = 10 * time.Second
+	stratumJobInterval     = 30 * time.Second
+	stratumMaxFutureTime   = 2 * time.Hour
+)
+
+var (
+	errStratumOther          = &stratumError{Code: 20, Message: "Other/Unknown"}
+	errStratumJobNotFound    = &stratumError{Code: 21, Message: "Job not found"}
+	errStratumDuplicateShare = &stratumError{Code: 22, Message: "Duplicate share"}
+	errStratumLowDiffShare   = &stratumError{Code: 23, Message: "Low difficulty share"}
+	errStratumUnauthorized   = &stratumError{Code: 24, Message: "Unauthorized worker"}
+	errStratumNotSubscribed  = &stratumError{Code: 25, Message: "Not subscribed"}
+)
+
+type stratumError struct {
+	Code    int
+	Message string
+}
+
+func (e *stratumError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// MarshalJSON encodes the error like other stratum v1 pools, [code, message, traceback]
+func (e *stratumError) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Code, e.Message, nil})
+}
+
+func newStratumError(format string, a ...interface{}) *stratumError {
+	return &stratumError{Code: errStratumOther.Code, Message: fmt.Sprintf(format, a...)}
+}
+
+type stratumRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type stratumResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  *stratumError   `json:"error"`
+}
+
+type stratumNotify struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// StratumServer serves stratum v1 miners with the block templates of PovWorker
+type StratumServer struct {
+	worker *PovWorker
+	cfg    *config.Stratum
+	logger *zap.SugaredLogger
+
+	listeners   []net.Listener
+	sessions    map[uint64]*stratumSession
+	workers     map[string]*types.PovStratumWorkerStat
+	lastSession uint64
+	mu          sync.RWMutex
+
+	extraNonce1 uint32
+	quitCh      chan struct{}
+	wg          sync.WaitGroup
+}
+
+func NewStratumServer(worker *PovWorker, cfg *config.Stratum) *StratumServer {
+	return &StratumServer{
+		worker:   worker,
+		cfg:      cfg,
+		logger:   log.NewLogger("pov_stratum"),
+		sessions: make(map[uint64]*stratumSession),
+		workers:  make(map[string]*types.PovStratumWorkerStat),
+		quitCh:   make(chan struct{}),
+	}
+}
+
+func (s *StratumServer) Start() error {
+	for _, port := range s.cfg.Ports {
+		algoType := types.NewPoVHashAlgoFromStr(port.AlgoName)
+		if !common.PovIsAlgoSupported(algoType) {
+			s.closeListeners()
+			return fmt.Errorf("stratum port %s has unsupported algo %s", port.ListenAddress, port.AlgoName)
+		}
+
+		network, address, err := scheme(port.ListenAddress)
+		if err != nil {
+			s.closeListeners()
+			return err
+		}
+		ln, err := net.Listen(network, address)
+		if err != nil {
+			s.closeListeners()
+			return fmt.Errorf("failed to listen: %s (%s,%s)", err, network, address)
+		}
+		s.listeners = append(s.listeners, ln)
+
+		s.logger.Infof("stratum listen on %s, algo %s", ln.Addr(), algoType)
+
+		s.wg.Add(1)
+		go s.acceptLoop(ln, port, algoType)
+	}
+
+	s.wg.Add(1)
+	go s.jobLoop()
+
+	return nil
+}
+
+func (s *StratumServer) Stop() {
+	close(s.quitCh)
+	s.closeListeners()
+
+	s.mu.RLock()
+	for _, sess := range s.sessions {
+		_ = sess.conn.Close()
+	}
+	s.mu.RUnlock()
+
+	s.wg.Wait()
+}
+
+func (s *StratumServer) closeListeners() {
+	for _, ln := range s.listeners {
+		_ = ln.Close()
+	}
+}
+
+// Addrs returns the actual listen addresses, in the same order of config ports
+func (s *StratumServer) Addrs() []net.Addr {
+	var addrs []net.Addr
+	for _, ln := range s.listeners {
+		addrs = append(addrs, ln.Addr())
+	}
+	return addrs
+}
+
+// GetWorkerStats returns the share accounting of all workers which have been authorized
+func (s *StratumServer) GetWorkerStats() []*types.PovStratumWorkerStat {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := make([]*types.PovStratumWorkerStat, 0, len(s.workers))
+	for _, stat := range s.workers {
+		statCopy := *stat
+		stats = append(stats, &statCopy)
+	}
+	return stats
+}
+
+func (s *StratumServer) acceptLoop(ln net.Listener, port *config.StratumPort, algoType types.PovAlgoType) {
+	defer s.wg.Done()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			select {
+			case <-s.quitCh:
+				return
+			default:
+			}
+			s.logger.Errorf("stratum accept error: %s", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		s.mu.Lock()
+		if s.cfg.MaxConnections > 0 && len(s.sessions) >= s.cfg.MaxConnections {
+			s.mu.Unlock()
+			s.logger.Warnf("stratum reject %s for too many connections", conn.RemoteAddr())
+			_ = conn.Close()
+			continue
+		}
+		s.lastSession++
+		sess := newStratumSession(s, s.lastSession, conn, port, algoType)
+		s.sessions[sess.id] = sess
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			sess.serve()
+		}()
+	}
+}
+
+func (s *StratumServer) jobLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var lastHash types.Hash
+	lastNotify := time.Now()
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case now := <-ticker.C:
+			latestHeader := s.worker.miner.GetChain().LatestHeader()
+			if latestHeader == nil {
+				continue
+			}
+
+			clean := latestHeader.GetHash() != lastHash
+			if !clean && now.Before(lastNotify.Add(stratumJobInterval)) {
+				s.retargetSessions(now)
+				continue
+			}
+			lastHash = latestHeader.GetHash()
+			lastNotify = now
+
+			for _, sess := range s.allSessions() {
+				sess.sendJob(clean)
+			}
+		}
+	}
+}
+
+func (s *StratumServer) retargetSessions(now time.Time) {
+	for _, sess := range s.allSessions() {
+		if sess.retarget(now) {
+			sess.sendJob(false)
+		}
+	}
+}
+
+func (s *StratumServer) allSessions() []*stratumSession {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sessions := make([]*stratumSession, 0, len(s.sessions))
+	for _, sess := range s.sessions {
+		sessions = append(sessions, sess)
+	}
+	return sessions
+}
+
+func (s *StratumServer) removeSession(sess *stratumSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, sess.id)
+	if sess.workerName != "" {
+		if stat := s.workers[sess.workerName]; stat != nil && stat.Connections > 0 {
+			stat.Connections--
+		}
+	}
+}
+
+func (s *StratumServer) nextExtraNonce1() []byte {
+	return util.BE_Uint32ToBytes(atomic.AddUint32(&s.extraNonce1, 1))
+}
+
+func (s *StratumServer) updateWorkerStat(name string, fn func(stat *types.PovStratumWorkerStat)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stat := s.workers[name]; stat != nil {
+		fn(stat)
+	}
+}
+
+func (s *StratumServer) registerWorker(sess *stratumSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat := s.workers[sess.workerName]
+	if stat == nil {
+		stat = &types.PovStratumWorkerStat{
+			Worker:    sess.workerName,
+			MinerAddr: sess.minerAddr,
+			AlgoType:  sess.algoType,
+		}
+		s.workers[sess.workerName] = stat
+	}
+	stat.Connections++
+	stat.Difficulty = sess.difficulty
+}
+
+type stratumJob struct {
+	id        string
+	workHash  types.Hash
+	header    *types.PovHeader
+	coinbase1 []byte
+	coinbase2 []byte
+	branch    []*types.Hash
+	minTime   uint32
+
+	difficulty float64
+	shares     map[string]struct{}
+}
+
+func newStratumJob(id string, mineBlock *types.PovMineBlock, difficulty float64) *stratumJob {
+	header := mineBlock.Header.Copy()
+	header.AuxHdr = nil
+
+	// coinbase1 ends with the length of extra, so miners only need to append extra nonce
+	coinbase1 := mineBlock.Header.CbTx.GetCoinBaseData1()
+	coinbase1 = append(coinbase1, util.LE_EncodeVarInt(stratumExtraNonce1Size+stratumExtraNonce2Size)...)
+
+	return &stratumJob{
+		id:         id,
+		workHash:   mineBlock.WorkHash,
+		header:     header,
+		coinbase1:  coinbase1,
+		coinbase2:  mineBlock.Header.CbTx.GetCoinBaseData2(),
+		branch:     mineBlock.CoinbaseBranch,
+		minTime:    mineBlock.MinTime,
+		difficulty: difficulty,
+		shares:     make(map[string]struct{}),
+	}
+}
+
+func (j *stratumJob) notifyParams(clean bool) []interface{} {
+	branch := make([]string, 0, len(j.branch))
+	for _, h := range j.branch {
+		branch = append(branch, hex.EncodeToString(h[:]))
+	}
+
+	// previous hash is sent with every 4 bytes swapped, the same as bitcoin stratum
+	prev := j.header.GetPrevious()
+	prevSwapped := make([]byte, types.HashSize)
+	for i := 0; i < types.HashSize; i += 4 {
+		prevSwapped[i] = prev[i+3]
+		prevSwapped[i+1] = prev[i+2]
+		prevSwapped[i+2] = prev[i+1]
+		prevSwapped[i+3] = prev[i]
+	}
+
+	return []interface{}{
+		j.id,
+		hex.EncodeToString(prevSwapped),
+		hex.EncodeToString(j.coinbase1),
+		hex.EncodeToString(j.coinbase2),
+		branch,
+		fmt.Sprintf("%08x", j.header.GetVersion()),
+		fmt.Sprintf("%08x", j.header.GetBits()),
+		fmt.Sprintf("%08x", uint32(time.Now().Unix())),
+		clean,
+	}
+}
+
+// buildHeader fills the header with the solution and returns it with the coinbase hash
+func (j *stratumJob) buildHeader(extra []byte, timestamp uint32, nonce uint32) (*types.PovHeader, types.Hash) {
+	buf := new(bytes.Buffer)
+	buf.Write(j.coinbase1)
+	buf.Write(extra)
+	buf.Write(j.coinbase2)
+	cbHash := types.Sha256DHashData(buf.Bytes())
+
+	mklRoot := &cbHash
+	for _, h := range j.branch {
+		mklRoot = merkle.HashMerkleBranches(mklRoot, h)
+	}
+
+	header := j.header.Copy()
+	header.BasHdr.MerkleRoot = *mklRoot
+	header.BasHdr.Timestamp = timestamp
+	header.BasHdr.Nonce = nonce
+	return header, cbHash
+}
+
+type stratumSession struct {
+	id       uint64
+	server   *StratumServer
+	conn     net.Conn
+	port     *config.StratumPort
+	algoType types.PovAlgoType
+	logger   *zap.SugaredLogger
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+
+	extraNonce1 []byte
+	subscribed  bool
+	authorized  bool
+	minerAddr   types.Address
+	workerName  string
+
+	difficulty       float64
+	jobs             map[string]*stratumJob
+	jobIDs           []string
+	lastJob          *stratumJob
+	lastJobSeq       uint64
+	lastRetarget     time.Time
+	sharesOfRetarget int
+}
+
+func newStratumSession(s *StratumServer, id uint64, conn net.Conn, port *config.StratumPort, algoType types.PovAlgoType) *stratumSession {
+	return &stratumSession{
+		id:           id,
+		server:       s,
+		conn:         conn,
+		port:         port,
+		algoType:     algoType,
+		logger:       s.logger,
+		extraNonce1:  s.nextExtraNonce1(),
+		difficulty:   clampStratumDifficulty(port.Difficulty, port),
+		jobs:         make(map[string]*stratumJob),
+		lastRetarget: time.Now(),
+	}
+}
+
+func (sess *stratumSession) serve() {
+	defer func() {
+		_ = sess.conn.Close()
+		sess.server.removeSession(sess)
+		sess.logger.Debugf("stratum session %d closed, %s", sess.id, sess.conn.RemoteAddr())
+	}()
+
+	sess.logger.Debugf("stratum session %d opened, %s", sess.id, sess.conn.RemoteAddr())
+
+	reader := bufio.NewReaderSize(sess.conn, stratumMaxLineSize)
+	for {
+		_ = sess.conn.SetReadDeadline(time.Now().Add(stratumReadTimeout))
+		line, err := reader.ReadSlice('\n')
+		if err != nil {
+			if err == bufio.ErrBufferFull {
+				sess.logger.Warnf("stratum session %d sent too long line", sess.id)
+			}
+			return
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		req := new(stratumRequest)
+		if err := json.Unmarshal(line, req); err != nil {
+			sess.logger.Warnf("stratum session %d sent invalid request, %s", sess.id, err)
+			return
+		}
+
+		if err := sess.handleRequest(req); err != nil {
+			sess.logger.Debugf("stratum session %d write error, %s", sess.id, err)
+			return
+		}
+	}
+}
+
+func (sess *stratumSession) handleRequest(req *stratumRequest) error {
+	switch req.Method {
+	case "mining.subscribe":
+		return sess.handleSubscribe(req)
+	case "mining.extranonce.subscribe":
+		return sess.reply(req, true, nil)
+	case "mining.authorize":
+		return sess.handleAuthorize(req)
+	case "mining.suggest_difficulty":
+		return sess.handleSuggestDifficulty(req)
+	case "mining.submit":
+		result, sErr := sess.handleSubmit(req)
+		return sess.reply(req, result, sErr)
+	default:
+		return sess.reply(req, nil, newStratumError("method %s not found", req.Method))
+	}
+}
+
+func (sess *stratumSession) handleSubscribe(req *stratumRequest) error {
+	sess.mu.Lock()
+	sess.subscribed = true
+	sessionID := fmt.Sprintf("%016x", sess.id)
+	sess.mu.Unlock()
+
+	result := []interface{}{
+		[][]string{{"mining.set_difficulty", sessionID}, {"mining.notify", sessionID}},
+		hex.EncodeToString(sess.extraNonce1),
+		stratumExtraNonce2Size,
+	}
+	return sess.reply(req, result, nil)
+}
+
+func (sess *stratumSession) handleAuthorize(req *stratumRequest) error {
+	sess.mu.Lock()
+	subscribed := sess.subscribed
+	sess.mu.Unlock()
+	if !subscribed {
+		return sess.reply(req, false, errStratumNotSubscribed)
+	}
+
+	userName, err := stratumParamString(req.Params, 0)
+	if err != nil {
+		return sess.reply(req, false, newStratumError(err.Error()))
+	}
+
+	// user name is miner address with an optional worker suffix, like qlc_xxx.rig1
+	addrStr := userName
+	if idx := strings.Index(userName, "."); idx >= 0 {
+		addrStr = userName[:idx]
+	}
+	minerAddr, err := types.HexToAddress(addrStr)
+	if err != nil {
+		return sess.reply(req, false, errStratumUnauthorized)
+	}
+	if err := sess.server.worker.checkMinerPledge(minerAddr); err != nil {
+		sess.logger.Infof("stratum session %d authorize %s failed, %s", sess.id, userName, err)
+		return sess.reply(req, false, errStratumUnauthorized)
+	}
+
+	sess.mu.Lock()
+	if sess.authorized {
+		sess.mu.Unlock()
+		return sess.reply(req, true, nil)
+	}
+	sess.authorized = true
+	sess.minerAddr = minerAddr
+	sess.workerName = userName
+	sess.mu.Unlock()
+
+	sess.server.registerWorker(sess)
+	sess.logger.Infof("stratum session %d authorized worker %s, algo %s", sess.id, userName, sess.algoType)
+
+	if err := sess.reply(req, true, nil); err != nil {
+		return err
+	}
+	sess.sendJob(true)
+	return nil
+}
+
+func (sess *stratumSession) handleSuggestDifficulty(req *stratumRequest) error {
+	var diff float64
+	if len(req.Params) < 1 || json.Unmarshal(req.Params[0], &diff) != nil || diff <= 0 {
+		return sess.reply(req, false, newStratumError("invalid difficulty"))
+	}
+
+	sess.mu.Lock()
+	sess.difficulty = clampStratumDifficulty(diff, sess.port)
+	authorized := sess.authorized
+	sess.mu.Unlock()
+
+	if err := sess.reply(req, true, nil); err != nil {
+		return err
+	}
+	if authorized {
+		sess.sendJob(false)
+	}
+	return nil
+}
+
+func (sess *stratumSession) handleSubmit(req *stratumRequest) (bool, *stratumError) {
+	sess.mu.Lock()
+	authorized := sess.authorized
+	workerName := sess.workerName
+	sess.mu.Unlock()
+	if !authorized {
+		return false, errStratumUnauthorized
+	}
+
+	var params [5]string
+	for i := range params {
+		p, err := stratumParamString(req.Params, i)
+		if err != nil {
+			return false, newStratumError(err.Error())
+		}
+		params[i] = p
+	}
+	jobID := params[1]
+
+	extraNonce2, err := hex.DecodeString(params[2])
+	if err != nil || len(extraNonce2) != stratumExtraNonce2Size {
+		return false, newStratumError("invalid extranonce2")
+	}
+	timestamp, err := strconv.ParseUint(params[3], 16, 32)
+	if err != nil {
+		return false, newStratumError("invalid ntime")
+	}
+	nonce, err := strconv.ParseUint(params[4], 16, 32)
+	if err != nil {
+		return false, newStratumError("invalid nonce")
+	}
+
+	sess.mu.Lock()
+	job := sess.jobs[jobID]
+	shareKey := params[2] + params[3] + params[4]
+	duplicated := false
+	if job != nil {
+		if _, ok := job.shares[shareKey]; ok {
+			duplicated = true
+		} else {
+			job.shares[shareKey] = struct{}{}
+		}
+	}
+	sess.mu.Unlock()
+
+	latestHeader := sess.server.worker.miner.GetChain().LatestHeader()
+	if job == nil || latestHeader == nil || job.header.GetPrevious() != latestHeader.GetHash() {
+		sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+			stat.StaleShares++
+		})
+		return false, errStratumJobNotFound
+	}
+	if duplicated {
+		sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+			stat.RejectedShares++
+		})
+		return false, errStratumDuplicateShare
+	}
+	if uint32(timestamp) < job.minTime || int64(timestamp) > time.Now().Add(stratumMaxFutureTime).Unix() {
+		sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+			stat.RejectedShares++
+		})
+		return false, newStratumError("ntime out of range")
+	}
+
+	extra := make([]byte, 0, stratumExtraNonce1Size+stratumExtraNonce2Size)
+	extra = append(extra, sess.extraNonce1...)
+	extra = append(extra, extraNonce2...)
+	header, cbHash := job.buildHeader(extra, uint32(timestamp), uint32(nonce))
+
+	powHash := header.ComputePowHash()
+	powInt := powHash.ToBigInt()
+	if powInt.Cmp(stratumShareTarget(sess.algoType, job.difficulty)) > 0 {
+		sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+			stat.RejectedShares++
+		})
+		return false, errStratumLowDiffShare
+	}
+
+	blockFound := false
+	if powInt.Cmp(header.GetAlgoTargetInt()) <= 0 {
+		result := types.NewPovMineResult()
+		result.WorkHash = job.workHash
+		result.BlockHash = header.ComputeHash()
+		result.MerkleRoot = header.BasHdr.MerkleRoot
+		result.Timestamp = header.BasHdr.Timestamp
+		result.Nonce = header.BasHdr.Nonce
+		result.CoinbaseExtra = extra
+		result.CoinbaseHash = cbHash
+
+		if err := sess.server.worker.submitMineResult(result); err != nil {
+			sess.logger.Warnf("stratum worker %s submit block %s failed, %s", workerName, result.BlockHash, err)
+		} else {
+			blockFound = true
+			sess.logger.Infof("stratum worker %s found block %d/%s", workerName, header.GetHeight(), result.BlockHash)
+		}
+	}
+
+	sess.mu.Lock()
+	sess.sharesOfRetarget++
+	sess.mu.Unlock()
+
+	sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+		stat.AcceptedShares++
+		stat.AcceptedDiff += job.difficulty
+		stat.LastShareTime = time.Now().Unix()
+		if blockFound {
+			stat.BlocksFound++
+		}
+	})
+
+	return true, nil
+}
+
+// retarget adjusts share difficulty by the share rate of last period, returns true if it changed
+func (sess *stratumSession) retarget(now time.Time) bool {
+	cfg := sess.server.cfg
+	if cfg.TargetShareTime <= 0 || cfg.RetargetTime <= 0 {
+		return false
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if !sess.authorized {
+		return false
+	}
+
+	elapsed := now.Sub(sess.lastRetarget)
+	if elapsed < time.Duration(cfg.RetargetTime)*time.Second {
+		return false
+	}
+
+	var newDiff float64
+	if sess.sharesOfRetarget == 0 {
+		newDiff = sess.difficulty / 2
+	} else {
+		shareTime := elapsed.Seconds() / float64(sess.sharesOfRetarget)
+		ratio := float64(cfg.TargetShareTime) / shareTime
+		if ratio > 4 {
+			ratio = 4
+		} else if ratio < 0.25 {
+			ratio = 0.25
+		}
+		newDiff = sess.difficulty * ratio
+	}
+	newDiff = clampStratumDifficulty(newDiff, sess.port)
+
+	sess.lastRetarget = now
+	sess.sharesOfRetarget = 0
+
+	// ignore small changes
+	if newDiff > sess.difficulty*0.9 && newDiff < sess.difficulty*1.1 {
+		return false
+	}
+	sess.difficulty = newDiff
+	return true
+}
+
+// sendJob sends the latest work to the miner, with set_difficulty if share difficulty changed
+func (sess *stratumSession) sendJob(clean bool) {
+	sess.mu.Lock()
+	authorized := sess.authorized
+	minerAddr := sess.minerAddr
+	sess.mu.Unlock()
+	if !authorized {
+		return
+	}
+
+	mineBlock, err := sess.server.worker.getWorkBlock(minerAddr, sess.algoType)
+	if err != nil {
+		sess.logger.Debugf("stratum session %d failed to get work, %s", sess.id, err)
+		return
+	}
+
+	sess.mu.Lock()
+	difficulty := sess.difficulty
+	diffChanged := sess.lastJob == nil || sess.lastJob.difficulty != difficulty
+	if !clean && !diffChanged && sess.lastJob.workHash == mineBlock.WorkHash {
+		sess.mu.Unlock()
+		return
+	}
+
+	sess.lastJobSeq++
+	job := newStratumJob(strconv.FormatUint(sess.lastJobSeq, 16), mineBlock, difficulty)
+	if clean {
+		sess.jobs = make(map[string]*stratumJob)
+		sess.jobIDs = nil
+	}
+	sess.jobs[job.id] = job
+	sess.jobIDs = append(sess.jobIDs, job.id)
+	if len(sess.jobIDs) > stratumMaxJobsPerConn {
+		delete(sess.jobs, sess.jobIDs[0])
+		sess.jobIDs = sess.jobIDs[1:]
+	}
+	sess.lastJob = job
+	workerName := sess.workerName
+	sess.mu.Unlock()
+
+	if diffChanged {
+		sess.server.updateWorkerStat(workerName, func(stat *types.PovStratumWorkerStat) {
+			stat.Difficulty = difficulty
+		})
+		if err := sess.notify("mining.set_difficulty", []interface{}{difficulty}); err != nil {
+			return
+		}
+	}
+	_ = sess.notify("mining.notify", job.notifyParams(clean))
+}
+
+func (sess *stratumSession) reply(req *stratumRequest, result interface{}, sErr *stratumError) error {
+	return sess.write(&stratumResponse{ID: req.ID, Result: result, Error: sErr})
+}
+
+func (sess *stratumSession) notify(method string, params []interface{}) error {
+	return sess.write(&stratumNotify{Method: method, Params: params})
+}
+
+func (sess *stratumSession) write(msg interface{}) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	sess.writeMu.Lock()
+	defer sess.writeMu.Unlock()
+
+	_ = sess.conn.SetWriteDeadline(time.Now().Add(stratumWriteTimeout))
+	_, err = sess.conn.Write(data)
+	return err
+}
+
+func stratumParamString(params []json.RawMessage, index int) (string, error) {
+	if index >= len(params) {
+		return "", fmt.Errorf("missing param %d", index)
+	}
+	var str string
+	if err := json.Unmarshal(params[index], &str); err != nil {
+		return "", fmt.Errorf("invalid param %d", index)
+	}
+	return str, nil
+}
+
+func clampStratumDifficulty(diff float64, port *config.StratumPort) float64 {
+	if port.MinDifficulty > 0 && diff < port.MinDifficulty {
+		diff = port.MinDifficulty
+	}
+	if port.MaxDifficulty > 0 && diff > port.MaxDifficulty {
+		diff = port.MaxDifficulty
+	}
+	if diff <= 0 {
+		diff = 1
+	}
+	return diff
+}
+
+var (
+	stratumDiff1Target       = types.CompactToBig(0x1d00ffff)
+	stratumScryptDiff1Target = types.CompactToBig(0x1f00ffff)
+)
+
+// stratumShareTarget converts share difficulty to target, scrypt uses a 65536 times easier diff1 like other pools
+func stratumShareTarget(algoType types.PovAlgoType, diff float64) *big.Int {
+	diff1 := stratumDiff1Target
+	if algoType == types.ALGO_SCRYPT {
+		diff1 = stratumScryptDiff1Target
+	}
+
+	target, _ := new(big.Float).Quo(new(big.Float).SetInt(diff1), big.NewFloat(diff)).Int(nil)
+	return target
+}
+
+func scheme(endpoint string) (string, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", "", errors.New("invalid endpoint " + endpoint)
+	}
+	return u.Scheme, u.Host, nil
+}
@@ -0,0 +1,200 @@
+package miner
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/merkle"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+type mockStratumClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	nextID int
+}
+
+type mockStratumMsg struct {
+	ID     *int              `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Result json.RawMessage   `json:"result"`
+	Error  json.RawMessage   `json:"error"`
+}
+
+func (c *mockStratumClient) call(method string, params ...interface{}) {
+	c.nextID++
+	data, _ := json.Marshal(map[string]interface{}{"id": c.nextID, "method": method, "params": params})
+	if _, err := c.conn.Write(append(data, '\n')); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *mockStratumClient) read() *mockStratumMsg {
+	_ = c.conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	line, err := c.reader.ReadBytes('\n')
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	msg := new(mockStratumMsg)
+	if err := json.Unmarshal(line, msg); err != nil {
+		c.t.Fatal(err)
+	}
+	return msg
+}
+
+// readUntil skips notifications until the response or notification wanted
+func (c *mockStratumClient) readUntil(fn func(msg *mockStratumMsg) bool) *mockStratumMsg {
+	for {
+		msg := c.read()
+		if fn(msg) {
+			return msg
+		}
+	}
+}
+
+func (c *mockStratumClient) readResult(id int) *mockStratumMsg {
+	return c.readUntil(func(msg *mockStratumMsg) bool {
+		return msg.ID != nil && *msg.ID == id
+	})
+}
+
+func TestStratum_Mining(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	_ = md.cc.Start()
+	defer func() {
+		_ = md.cc.Stop()
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	allPovBlks, err := mockMinerGeneratePovBlocksToLedger(md.l, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md.ch.mockPovBlocks["LatestHeader"] = allPovBlks[0]
+	md.ch.mockPovBlocks["LatestBlock"] = allPovBlks[0]
+
+	md.m.GetConfig().PoV.Stratum = &config.Stratum{
+		Enable: true,
+		Ports: []*config.StratumPort{
+			{ListenAddress: "tcp://127.0.0.1:0", AlgoName: "SHA256D", Difficulty: 1e-20},
+		},
+	}
+	_ = md.m.Init()
+	if err := md.m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = md.m.Stop()
+	}()
+
+	md.eb.Publish(topic.EventPovSyncState, topic.SyncDone)
+	time.Sleep(10 * time.Millisecond)
+
+	conn, err := net.Dial("tcp", md.m.povWorker.stratum.Addrs()[0].String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := &mockStratumClient{t: t, conn: conn, reader: bufio.NewReader(conn)}
+
+	c.call("mining.authorize", "foo", "x")
+	if msg := c.readResult(c.nextID); string(msg.Result) != "false" {
+		t.Fatal("authorize should fail before subscribe", string(msg.Result))
+	}
+
+	c.call("mining.subscribe", "test/1.0")
+	var subRsp []json.RawMessage
+	if err := json.Unmarshal(c.readResult(c.nextID).Result, &subRsp); err != nil || len(subRsp) != 3 {
+		t.Fatal("invalid subscribe result", err)
+	}
+
+	minerAcc := mock.Account()
+	c.call("mining.authorize", minerAcc.Address().String()+".rig1", "x")
+	if msg := c.readResult(c.nextID); string(msg.Result) != "true" {
+		t.Fatal("authorize failed", string(msg.Error))
+	}
+
+	c.readUntil(func(msg *mockStratumMsg) bool { return msg.Method == "mining.set_difficulty" })
+	notify := c.readUntil(func(msg *mockStratumMsg) bool { return msg.Method == "mining.notify" })
+	var jobID, ntime string
+	_ = json.Unmarshal(notify.Params[0], &jobID)
+	_ = json.Unmarshal(notify.Params[7], &ntime)
+
+	c.call("mining.submit", "rig1", jobID, "00000001", ntime, "00000001")
+	if msg := c.readResult(c.nextID); string(msg.Result) != "true" {
+		t.Fatal("share should be accepted", string(msg.Error))
+	}
+
+	c.call("mining.submit", "rig1", jobID, "00000001", ntime, "00000001")
+	if msg := c.readResult(c.nextID); string(msg.Result) != "false" {
+		t.Fatal("duplicate share should be rejected")
+	}
+
+	c.call("mining.submit", "rig1", "ffff", "00000001", ntime, "00000002")
+	if msg := c.readResult(c.nextID); string(msg.Result) != "false" {
+		t.Fatal("share of unknown job should be rejected")
+	}
+
+	outArgs := make(map[interface{}]interface{})
+	md.m.povWorker.OnEventRpcSyncCall(&topic.EventRPCSyncCallMsg{Name: "Miner.GetMiningInfo", In: make(map[interface{}]interface{}), Out: outArgs})
+	stats := outArgs["stratumWorkers"].([]*types.PovStratumWorkerStat)
+	if len(stats) != 1 {
+		t.Fatal("invalid worker stats", len(stats))
+	}
+	stat := stats[0]
+	if stat.MinerAddr != minerAcc.Address() || stat.AcceptedShares != 1 || stat.RejectedShares != 1 || stat.StaleShares != 1 {
+		t.Fatal("invalid worker stat", fmt.Sprintf("%+v", stat))
+	}
+}
+
+func TestStratum_Job(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	allPovBlks, err := mockMinerGeneratePovBlocksToLedger(md.l, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md.ch.mockPovBlocks["LatestHeader"] = allPovBlks[0]
+	md.ch.mockPovBlocks["LatestBlock"] = allPovBlks[0]
+	_ = md.m.Init()
+
+	mineBlock, err := md.m.povWorker.generateBlock(mock.Address(), types.ALGO_SHA256D)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := newStratumJob("1", mineBlock, 1)
+	extra := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	header, cbHash := job.buildHeader(extra, 100, 200)
+
+	mineBlock.Header.CbTx.TxIns[0].Extra = extra
+	if cbHash != mineBlock.Header.CbTx.ComputeHash() {
+		t.Fatal("coinbase hash mismatch")
+	}
+	mineBlock.AllTxHashes[0] = &cbHash
+	if header.BasHdr.MerkleRoot != merkle.CalcMerkleTreeRootHash(mineBlock.AllTxHashes) {
+		t.Fatal("merkle root mismatch")
+	}
+	if header.BasHdr.Timestamp != 100 || header.BasHdr.Nonce != 200 {
+		t.Fatal("invalid header")
+	}
+
+	if stratumShareTarget(types.ALGO_SCRYPT, 1).Cmp(stratumShareTarget(types.ALGO_SHA256D, 1)) <= 0 {
+		t.Fatal("scrypt diff1 target should be bigger")
+	}
+	if stratumShareTarget(types.ALGO_SHA256D, 2).Cmp(stratumShareTarget(types.ALGO_SHA256D, 1)) >= 0 {
+		t.Fatal("target should decrease with difficulty")
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	relation "github.com/qlcchain/go-qlc/ledger/relation"
+
 	storage "github.com/qlcchain/go-qlc/common/storage"
 
 	types "github.com/qlcchain/go-qlc/common/types"
@@ -206,6 +208,20 @@ func (_m *Store) AddOrUpdateAccountMetaCache(value *types.AccountMeta, batch ...
 	return r0
 }
 
+// AddOrUpdateMultiSigProposal provides a mock function with given fields: proposal
+func (_m *Store) AddOrUpdateMultiSigProposal(proposal *types.MultiSigProposal) error {
+	ret := _m.Called(proposal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.MultiSigProposal) error); ok {
+		r0 = rf(proposal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddOrUpdatePeerInfo provides a mock function with given fields: value
 func (_m *Store) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	ret := _m.Called(value)
@@ -220,6 +236,20 @@ func (_m *Store) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	return r0
 }
 
+// AddOrUpdateRepOnlineStats provides a mock function with given fields: stats
+func (_m *Store) AddOrUpdateRepOnlineStats(stats *types.RepOnlineStats) error {
+	ret := _m.Called(stats)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.RepOnlineStats) error); ok {
+		r0 = rf(stats)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddOrUpdateVmLogs provides a mock function with given fields: value, c
 func (_m *Store) AddOrUpdateVmLogs(value *types.VmLogs, c storage.Cache) error {
 	ret := _m.Called(value, c)
@@ -1245,6 +1275,20 @@ func (_m *Store) DeleteGapPublishBlock(key types.Hash, blkHash types.Hash) error
 	return r0
 }
 
+// DeleteMultiSigProposal provides a mock function with given fields: hash
+func (_m *Store) DeleteMultiSigProposal(hash types.Hash) error {
+	ret := _m.Called(hash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Hash) error); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePending provides a mock function with given fields: key, c
 func (_m *Store) DeletePending(key *types.PendingKey, c storage.Cache) error {
 	ret := _m.Called(key, c)
@@ -2305,6 +2349,43 @@ func (_m *Store) GetLatestPovMinerStat(batch ...storage.Batch) (*types.PovMinerD
 	return r0, r1
 }
 
+// GetMultiSigProposal provides a mock function with given fields: hash
+func (_m *Store) GetMultiSigProposal(hash types.Hash) (*types.MultiSigProposal, error) {
+	ret := _m.Called(hash)
+
+	var r0 *types.MultiSigProposal
+	if rf, ok := ret.Get(0).(func(types.Hash) *types.MultiSigProposal); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MultiSigProposal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMultiSigProposals provides a mock function with given fields: fn
+func (_m *Store) GetMultiSigProposals(fn func(*types.MultiSigProposal) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*types.MultiSigProposal) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetObject provides a mock function with given fields: k, c
 func (_m *Store) GetObject(k []byte, c ...storage.Cache) (interface{}, []byte, error) {
 	_va := make([]interface{}, len(c))
@@ -2866,6 +2947,43 @@ func (_m *Store) GetRelation(dest interface{}, query string) error {
 	return r0
 }
 
+// GetRepOnlineStats provides a mock function with given fields: period
+func (_m *Store) GetRepOnlineStats(period uint64) (*types.RepOnlineStats, error) {
+	ret := _m.Called(period)
+
+	var r0 *types.RepOnlineStats
+	if rf, ok := ret.Get(0).(func(uint64) *types.RepOnlineStats); ok {
+		r0 = rf(period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RepOnlineStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRepOnlineStatsByRange provides a mock function with given fields: start, end, fn
+func (_m *Store) GetRepOnlineStatsByRange(start uint64, end uint64, fn func(*types.RepOnlineStats) error) error {
+	ret := _m.Called(start, end, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, func(*types.RepOnlineStats) error) error); ok {
+		r0 = rf(start, end, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetRepresentation provides a mock function with given fields: key, c
 func (_m *Store) GetRepresentation(key types.Address, c ...storage.Cache) (*types.Benefit, error) {
 	_va := make([]interface{}, len(c))
@@ -3021,6 +3139,29 @@ func (_m *Store) GetStateBlocksConfirmed(fn func(*types.StateBlock) error) error
 	return r0
 }
 
+// GetTokenBlockHashBySeq provides a mock function with given fields: address, token, seq
+func (_m *Store) GetTokenBlockHashBySeq(address types.Address, token types.Hash, seq uint64) (types.Hash, error) {
+	ret := _m.Called(address, token, seq)
+
+	var r0 types.Hash
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash, uint64) types.Hash); ok {
+		r0 = rf(address, token, seq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Hash)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Hash, uint64) error); ok {
+		r1 = rf(address, token, seq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTokenById provides a mock function with given fields: tokenId
 func (_m *Store) GetTokenById(tokenId types.Hash) (*types.TokenInfo, error) {
 	ret := _m.Called(tokenId)
@@ -3838,6 +3979,36 @@ func (_m *Store) SaveStorageByConvert(key []byte, val []byte, c storage.Cache) e
 	return r0
 }
 
+// SearchBlocks provides a mock function with given fields: q
+func (_m *Store) SearchBlocks(q *relation.BlockQuery) ([]types.Hash, string, error) {
+	ret := _m.Called(q)
+
+	var r0 []types.Hash
+	if rf, ok := ret.Get(0).(func(*relation.BlockQuery) []types.Hash); ok {
+		r0 = rf(q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Hash)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(*relation.BlockQuery) string); ok {
+		r1 = rf(q)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*relation.BlockQuery) error); ok {
+		r2 = rf(q)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SearchVmLogs provides a mock function with given fields: fn
 func (_m *Store) SearchVmLogs(fn func(types.Hash, *types.VmLogs) error) error {
 	ret := _m.Called(fn)
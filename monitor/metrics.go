@@ -16,6 +16,7 @@ import (
 var (
 	SystemRegistry      = metrics.NewPrefixedChildRegistry(metrics.DefaultRegistry, "/system/")
 	PerformanceRegistry = metrics.NewPrefixedChildRegistry(metrics.DefaultRegistry, "/performance/")
+	ChainRegistry       = metrics.NewPrefixedChildRegistry(metrics.DefaultRegistry, "/chain/")
 )
 
 func init() {
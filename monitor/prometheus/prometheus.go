@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package prometheus
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+const namespace = "qlc"
+
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999}
+
+// Serve exposes the metrics of the registry at /metrics in prometheus text format, until the context is done.
+// collectors are called to update metrics before each scrape
+func Serve(ctx context.Context, r metrics.Registry, address string, collectors ...func()) error {
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(r, collectors...))
+	srv := &http.Server{Handler: mux, ReadTimeout: 10 * time.Second, WriteTimeout: 30 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	return nil
+}
+
+// Handler writes the metrics of the registry in prometheus text format
+func Handler(r metrics.Registry, collectors ...func()) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for _, collect := range collectors {
+			collect()
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		bw := bufio.NewWriter(w)
+		Write(bw, r)
+		_ = bw.Flush()
+	})
+}
+
+// Write writes the metrics of the registry in prometheus text format, sorted by name
+func Write(w io.Writer, r metrics.Registry) {
+	all := make(map[string]interface{})
+	r.Each(func(name string, i interface{}) {
+		all[metricName(name)] = i
+	})
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch metric := all[name].(type) {
+		case metrics.Counter:
+			writeValue(w, name+"_total", "counter", float64(metric.Count()))
+		case metrics.Gauge:
+			writeValue(w, name, "gauge", float64(metric.Value()))
+		case metrics.GaugeFloat64:
+			writeValue(w, name, "gauge", metric.Value())
+		case metrics.Histogram:
+			ms := metric.Snapshot()
+			writeSummary(w, name, ms.Percentiles(quantiles), float64(ms.Sum()), ms.Count())
+		case metrics.Meter:
+			ms := metric.Snapshot()
+			writeValue(w, name+"_total", "counter", float64(ms.Count()))
+			writeValue(w, name+"_rate1m", "gauge", ms.Rate1())
+			writeValue(w, name+"_rate5m", "gauge", ms.Rate5())
+			writeValue(w, name+"_rate15m", "gauge", ms.Rate15())
+		case metrics.Timer:
+			// timers are recorded in nanoseconds and exported in seconds
+			ms := metric.Snapshot()
+			ps := ms.Percentiles(quantiles)
+			for i := range ps {
+				ps[i] = ps[i] / float64(time.Second)
+			}
+			writeSummary(w, name+"_seconds", ps, float64(ms.Sum())/float64(time.Second), ms.Count())
+		}
+	}
+}
+
+func writeValue(w io.Writer, name, typ string, value float64) {
+	fmt.Fprintf(w, "# TYPE %s %s\n%s %g\n", name, typ, name, value)
+}
+
+func writeSummary(w io.Writer, name string, ps []float64, sum float64, count int64) {
+	fmt.Fprintf(w, "# TYPE %s summary\n", name)
+	for i, q := range quantiles {
+		fmt.Fprintf(w, "%s{quantile=\"%g\"} %g\n", name, q, ps[i])
+	}
+	fmt.Fprintf(w, "%s_sum %g\n%s_count %d\n", name, sum, name, count)
+}
+
+// metricName converts go-metrics name like /system/cpu.user to qlc_system_cpu_user
+func metricName(name string) string {
+	var sb strings.Builder
+	sb.WriteString(namespace)
+	underscore := true
+	for _, c := range name {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			if underscore {
+				sb.WriteByte('_')
+				underscore = false
+			}
+			sb.WriteRune(c)
+		} else {
+			underscore = true
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+func TestWrite(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.NewRegisteredCounter("/chain/dpos/election.confirmed", r).Inc(3)
+	metrics.NewRegisteredGauge("/chain/pov/height", r).Update(100)
+	metrics.NewRegisteredGaugeFloat64("/chain/p2p/bandwidth/rateIn", r).Update(1.5)
+	metrics.NewRegisteredTimer("/chain/dpos/confirmation", r).Update(2 * time.Second)
+
+	buf := new(bytes.Buffer)
+	Write(buf, r)
+	out := buf.String()
+	for _, line := range []string{
+		"# TYPE qlc_chain_dpos_election_confirmed_total counter\nqlc_chain_dpos_election_confirmed_total 3\n",
+		"# TYPE qlc_chain_pov_height gauge\nqlc_chain_pov_height 100\n",
+		"qlc_chain_p2p_bandwidth_rateIn 1.5\n",
+		"# TYPE qlc_chain_dpos_confirmation_seconds summary\n",
+		"qlc_chain_dpos_confirmation_seconds{quantile=\"0.5\"} 2\n",
+		"qlc_chain_dpos_confirmation_seconds_sum 2\nqlc_chain_dpos_confirmation_seconds_count 1\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("%q not found in\n%s", line, out)
+		}
+	}
+	if strings.Index(out, "qlc_chain_dpos_confirmation") > strings.Index(out, "qlc_chain_pov_height") {
+		t.Fatal("metrics are not sorted")
+	}
+}
+
+func TestServe(t *testing.T) {
+	r := metrics.NewRegistry()
+	height := metrics.NewRegisteredGauge("/chain/pov/height", r)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := Serve(ctx, r, "127.0.0.1:19747", func() { height.Update(1) }); err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := http.Get("http://127.0.0.1:19747/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rsp.Body.Close()
+	body, _ := ioutil.ReadAll(rsp.Body)
+	if !strings.Contains(string(body), "qlc_chain_pov_height 1") {
+		t.Fatal(string(body))
+	}
+	if err := Serve(ctx, r, "127.0.0.1:19747"); err == nil {
+		t.Fatal("address is in use")
+	}
+}
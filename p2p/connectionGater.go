@@ -12,19 +12,32 @@ type WhiteList struct {
 	addr ma.Multiaddr
 }
 
+// ConnectionGater rejects banned peers, and only dials peers of the white list if it is enabled
 type ConnectionGater struct {
-	whiteList []WhiteList
+	whiteList       []WhiteList
+	whiteListEnable bool
+	reputation      *peerReputation
 }
 
-func NewConnectionGater() *ConnectionGater {
-	return &ConnectionGater{}
+func NewConnectionGater(whiteListEnable bool, reputation *peerReputation) *ConnectionGater {
+	return &ConnectionGater{whiteListEnable: whiteListEnable, reputation: reputation}
 }
 
-func (cg *ConnectionGater) InterceptPeerDial(peer.ID) bool {
-	return true
+func (cg *ConnectionGater) isBanned(p peer.ID) bool {
+	return cg.reputation != nil && cg.reputation.isBanned(p.Pretty())
+}
+
+func (cg *ConnectionGater) InterceptPeerDial(p peer.ID) bool {
+	return !cg.isBanned(p)
 }
 
 func (cg *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool {
+	if cg.isBanned(p) {
+		return false
+	}
+	if !cg.whiteListEnable {
+		return true
+	}
 	var allow bool
 	for _, v := range cg.whiteList {
 		if p == v.id {
@@ -46,8 +59,8 @@ func (cg *ConnectionGater) InterceptAccept(network.ConnMultiaddrs) bool {
 	return true
 }
 
-func (cg *ConnectionGater) InterceptSecured(network.Direction, peer.ID, network.ConnMultiaddrs) bool {
-	return true
+func (cg *ConnectionGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return !cg.isBanned(p)
 }
 
 func (cg *ConnectionGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
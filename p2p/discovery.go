@@ -15,7 +15,7 @@ import (
 
 func (node *QlcNode) dhtFoundPeers() ([]peer.AddrInfo, error) {
 	//discovery peers
-	peers, err := discovery.FindPeers(node.ctx, node.dis, QlcProtocolFOUND, corediscovery.Limit(node.cfg.P2P.Discovery.Limit))
+	peers, err := discovery.FindPeers(node.ctx, node.dis, QlcProtocolFOUND, corediscovery.Limit(node.getDiscoveryLimit()))
 	if err != nil {
 		return nil, err
 	}
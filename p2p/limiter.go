@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package p2p
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+var messageTypeNames = [...]string{
+	PublishReq:      "publishReq",
+	ConfirmReq:      "confirmReq",
+	ConfirmAck:      "confirmAck",
+	FrontierRequest: "frontierReq",
+	FrontierRsp:     "frontierRsp",
+	BulkPullRequest: "bulkPullReq",
+	BulkPullRsp:     "bulkPullRsp",
+	BulkPushBlock:   "bulkPushBlock",
+	MessageResponse: "messageResponse",
+	PovStatus:       "povStatus",
+	PovPublishReq:   "povPublishReq",
+	PovBulkPullReq:  "povBulkPullReq",
+	PovBulkPullRsp:  "povBulkPullRsp",
+}
+
+func (t MessageType) String() string {
+	if int(t) >= len(messageTypeNames) {
+		return "unknown"
+	}
+	return messageTypeNames[t]
+}
+
+func parseMessageType(name string) (MessageType, error) {
+	for i, n := range messageTypeNames {
+		if n == name {
+			return MessageType(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown message type %s", name)
+}
+
+// limiter is a token bucket refilled by rate tokens per second up to burst tokens
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newLimiter returns nil if rate is 0, which allows all messages
+func newLimiter(rate float64, burst int) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+	b := float64(burst)
+	if b < 1 {
+		b = rate
+		if b < 1 {
+			b = 1
+		}
+	}
+	return &limiter{rate: rate, burst: b, tokens: b, last: time.Now(), now: time.Now}
+}
+
+// reserve takes a token and returns 0 if there is one, otherwise it returns the time until the next token
+func (l *limiter) reserve() time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+	}
+	l.tokens--
+	return 0
+}
+
+type messageLimit struct {
+	rate  float64
+	burst int
+}
+
+// parseMessageLimits parses limits in the format of type:rate:burst
+func parseMessageLimits(limits []string) (map[MessageType]messageLimit, error) {
+	ls := make(map[MessageType]messageLimit)
+	for _, s := range limits {
+		fields := strings.Split(s, ":")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid message limit %s, should be type:rate:burst", s)
+		}
+		t, err := parseMessageType(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		rate, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid rate of message limit %s", s)
+		}
+		burst, err := strconv.Atoi(fields[2])
+		if err != nil || burst < 0 {
+			return nil, fmt.Errorf("invalid burst of message limit %s", s)
+		}
+		ls[t] = messageLimit{rate: rate, burst: burst}
+	}
+	return ls, nil
+}
+
+// peerLimiterIdleTimeout is the time the limiters of a peer are kept after its last message
+const peerLimiterIdleTimeout = 10 * time.Minute
+
+type peerLimiter struct {
+	limiters map[MessageType]*limiter
+	dropped  map[MessageType]uint64
+	lastSeen time.Time
+}
+
+// messageLimiter limits the messages received from each peer and from all peers by message type,
+// it counts the messages dropped for exceeding the limits, a nil messageLimiter allows all messages.
+// Only the messages received on direct streams are limited per peer, the sender of a gossip message
+// is just the peer relaying it, so gossip is only limited by the limits of all peers.
+type messageLimiter struct {
+	lock       sync.Mutex
+	enable     bool
+	maxWait    time.Duration
+	peerLimits map[MessageType]messageLimit
+	global     map[MessageType]*limiter
+	peers      map[string]*peerLimiter
+	dropped    map[MessageType]uint64
+	lastEvict  time.Time
+	now        func() time.Time
+}
+
+func newMessageLimiter(cfg *config.P2PLimitConfig) (*messageLimiter, error) {
+	ml := &messageLimiter{
+		peers:     make(map[string]*peerLimiter),
+		dropped:   make(map[MessageType]uint64),
+		lastEvict: time.Now(),
+		now:       time.Now,
+	}
+	if err := ml.setConfig(cfg); err != nil {
+		return nil, err
+	}
+	return ml, nil
+}
+
+// setConfig replaces the limits and resets the token buckets, the limiter is disabled if cfg is nil
+func (ml *messageLimiter) setConfig(cfg *config.P2PLimitConfig) error {
+	if cfg == nil {
+		cfg = &config.P2PLimitConfig{}
+	}
+	peerLimits, err := parseMessageLimits(cfg.PeerLimits)
+	if err != nil {
+		return err
+	}
+	globalLimits, err := parseMessageLimits(cfg.GlobalLimits)
+	if err != nil {
+		return err
+	}
+	global := make(map[MessageType]*limiter)
+	for t, l := range globalLimits {
+		global[t] = newLimiter(l.rate, l.burst)
+	}
+
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	ml.enable = cfg.Enable
+	ml.maxWait = time.Duration(cfg.MaxWait) * time.Millisecond
+	ml.peerLimits = peerLimits
+	ml.global = global
+	for _, p := range ml.peers {
+		p.limiters = make(map[MessageType]*limiter)
+	}
+	return nil
+}
+
+// limiters returns the limiters of the peer and of all peers for the message type, they are nil if not limited,
+// the limiter of the peer is always nil if peerID is empty
+func (ml *messageLimiter) limiters(peerID string, t MessageType) (*limiter, *limiter, time.Duration, bool) {
+	if ml == nil {
+		return nil, nil, 0, false
+	}
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	if !ml.enable {
+		return nil, nil, 0, false
+	}
+	if peerID == "" {
+		return nil, ml.global[t], ml.maxWait, true
+	}
+	now := ml.now()
+	ml.evictIdle(now)
+	p, ok := ml.peers[peerID]
+	if !ok {
+		p = &peerLimiter{limiters: make(map[MessageType]*limiter), dropped: make(map[MessageType]uint64)}
+		ml.peers[peerID] = p
+	}
+	p.lastSeen = now
+	l, ok := p.limiters[t]
+	if !ok {
+		if pl, ok := ml.peerLimits[t]; ok {
+			l = newLimiter(pl.rate, pl.burst)
+		}
+		p.limiters[t] = l
+	}
+	return l, ml.global[t], ml.maxWait, true
+}
+
+// evictIdle removes the limiters of the peers without messages for peerLimiterIdleTimeout, it runs at most once
+// per timeout, the caller must hold the lock
+func (ml *messageLimiter) evictIdle(now time.Time) {
+	if now.Sub(ml.lastEvict) < peerLimiterIdleTimeout {
+		return
+	}
+	ml.lastEvict = now
+	for id, p := range ml.peers {
+		if now.Sub(p.lastSeen) >= peerLimiterIdleTimeout {
+			delete(ml.peers, id)
+		}
+	}
+}
+
+// allowGossip returns false if the gossip message exceeds the limits of all peers, the message should be dropped
+func (ml *messageLimiter) allowGossip(t MessageType) bool {
+	return ml.wait("", t, false)
+}
+
+// waitAllow waits for the message under the limits up to max wait time, which stops reading the stream
+// of the peer as backpressure, it returns false if the message should be dropped
+func (ml *messageLimiter) waitAllow(peerID string, t MessageType) bool {
+	return ml.wait(peerID, t, true)
+}
+
+func (ml *messageLimiter) wait(peerID string, t MessageType, wait bool) bool {
+	pl, gl, maxWait, enable := ml.limiters(peerID, t)
+	if !enable || (pl == nil && gl == nil) {
+		return true
+	}
+	if !wait {
+		maxWait = 0
+	}
+	var waited time.Duration
+	for _, l := range []*limiter{pl, gl} {
+		for {
+			d := l.reserve()
+			if d == 0 {
+				break
+			}
+			if waited+d > maxWait {
+				ml.drop(peerID, t)
+				return false
+			}
+			time.Sleep(d)
+			waited += d
+		}
+	}
+	return true
+}
+
+func (ml *messageLimiter) drop(peerID string, t MessageType) {
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	ml.dropped[t]++
+	if p, ok := ml.peers[peerID]; ok {
+		p.dropped[t]++
+	}
+}
+
+// removePeer removes the limiters and drop counts of the disconnected peer
+func (ml *messageLimiter) removePeer(peerID string) {
+	if ml == nil {
+		return
+	}
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	delete(ml.peers, peerID)
+}
+
+// peerDropped returns the messages dropped of the peer by message type name
+func (ml *messageLimiter) peerDropped(peerID string) map[string]uint64 {
+	if ml == nil {
+		return nil
+	}
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	if p, ok := ml.peers[peerID]; ok {
+		return droppedByName(p.dropped)
+	}
+	return nil
+}
+
+// totalDropped returns the messages dropped of all peers by message type name
+func (ml *messageLimiter) totalDropped() map[string]uint64 {
+	if ml == nil {
+		return nil
+	}
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+	return droppedByName(ml.dropped)
+}
+
+func droppedByName(dropped map[MessageType]uint64) map[string]uint64 {
+	if len(dropped) == 0 {
+		return nil
+	}
+	m := make(map[string]uint64, len(dropped))
+	for t, n := range dropped {
+		m[t.String()] = n
+	}
+	return m
+}
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func TestParseMessageLimits(t *testing.T) {
+	cfg, _ := config.DefaultConfig(config.QlcTestDataDir())
+	if _, err := newMessageLimiter(cfg.P2PLimit); err != nil {
+		t.Fatal(err)
+	}
+	ls, err := parseMessageLimits([]string{"publishReq:10.5:20", "povBulkPullReq:1:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ls[PublishReq].rate != 10.5 || ls[PublishReq].burst != 20 || ls[PovBulkPullReq].rate != 1 {
+		t.Fatal("invalid limits", ls)
+	}
+	for _, s := range []string{"publishReq:10", "unknown:1:1", "confirmReq:a:1", "confirmReq:1:-1"} {
+		if _, err := parseMessageLimits([]string{s}); err == nil {
+			t.Fatal("limit should be invalid", s)
+		}
+	}
+}
+
+func TestMessageLimiter(t *testing.T) {
+	peer1 := "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+	peer2 := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	ml, err := newMessageLimiter(&config.P2PLimitConfig{
+		Enable:       true,
+		PeerLimits:   []string{"publishReq:1:2"},
+		GlobalLimits: []string{"publishReq:1:3"},
+		MaxWait:      0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// burst of peer1 is 2, the third message is dropped
+	for i := 0; i < 2; i++ {
+		if !ml.waitAllow(peer1, PublishReq) {
+			t.Fatal("message should be allowed", i)
+		}
+	}
+	if ml.waitAllow(peer1, PublishReq) {
+		t.Fatal("message should be dropped")
+	}
+	// the global burst is 3, so only one message of peer2 is allowed
+	if !ml.waitAllow(peer2, PublishReq) {
+		t.Fatal("message should be allowed")
+	}
+	if ml.waitAllow(peer2, PublishReq) {
+		t.Fatal("message should be dropped")
+	}
+	// message type without limits
+	if !ml.waitAllow(peer1, ConfirmAck) {
+		t.Fatal("message should be allowed")
+	}
+	if d := ml.peerDropped(peer1); d["publishReq"] != 1 {
+		t.Fatal("invalid dropped of peer", d)
+	}
+	if d := ml.totalDropped(); d["publishReq"] != 2 {
+		t.Fatal("invalid total dropped", d)
+	}
+	// gossip is only limited by the global limits, and is not counted to the relaying peer
+	if ml.allowGossip(PublishReq) {
+		t.Fatal("gossip should be dropped")
+	}
+	if !ml.allowGossip(ConfirmAck) {
+		t.Fatal("gossip should be allowed")
+	}
+	if d := ml.peerDropped(peer1); d["publishReq"] != 1 {
+		t.Fatal("invalid dropped of peer", d)
+	}
+
+	// the limiters of idle peers are evicted
+	now := time.Now()
+	ml.now = func() time.Time { return now.Add(2 * peerLimiterIdleTimeout) }
+	ml.waitAllow(peer1, ConfirmAck)
+	if d := ml.peerDropped(peer2); d != nil {
+		t.Fatal("idle peer should be evicted", d)
+	}
+	if _, ok := ml.peers[peer1]; !ok {
+		t.Fatal("active peer should be kept")
+	}
+	ml.now = time.Now
+
+	// waits for the next token
+	if err := ml.setConfig(&config.P2PLimitConfig{Enable: true, PeerLimits: []string{"confirmReq:20:1"}, MaxWait: 500}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if !ml.waitAllow(peer1, ConfirmReq) {
+			t.Fatal("message should be allowed after waiting", i)
+		}
+	}
+	if time.Since(start) < 90*time.Millisecond {
+		t.Fatal("message should be waited", time.Since(start))
+	}
+
+	ml.removePeer(peer1)
+	if d := ml.peerDropped(peer1); d != nil {
+		t.Fatal("peer should be removed", d)
+	}
+
+	// disabled
+	if err := ml.setConfig(nil); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if !ml.waitAllow(peer2, ConfirmReq) {
+			t.Fatal("message should be allowed")
+		}
+	}
+	var nilLimiter *messageLimiter
+	if !nilLimiter.waitAllow(peer1, PublishReq) || nilLimiter.totalDropped() != nil {
+		t.Fatal("nil limiter should allow all messages")
+	}
+}
@@ -17,6 +17,8 @@ const (
 	QlcMessageTypeEndIdx         = 5
 	QlcMessageDataLengthEndIdx   = 9
 	QlcMessageDataCheckSumEndIdx = 13
+	// MaxMessageDataLength is the max data length of a message, the peer sending larger one is penalised
+	MaxMessageDataLength = 32 * 1024 * 1024
 )
 
 // Error types
@@ -25,6 +27,7 @@ var (
 	ErrInvalidMessageDataLength   = errors.New("invalid message data length")
 	ErrInvalidMagicNumber         = errors.New("invalid magic number")
 	ErrInvalidDataCheckSum        = errors.New("invalid data checksum")
+	ErrMessageTooLarge            = errors.New("message data is too large")
 )
 
 type QlcMessage struct {
@@ -99,6 +102,9 @@ func ParseQlcMessage(data []byte) (*QlcMessage, error) {
 	if err := message.VerifyHeader(); err != nil {
 		return nil, err
 	}
+	if message.DataLength() > MaxMessageDataLength {
+		return nil, ErrMessageTooLarge
+	}
 	message.messageType = message.MessageType()
 	return message, nil
 }
@@ -248,27 +248,30 @@ func (ms *MessageService) onPublishReq(message *Message) {
 	p, err := protos.PublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.netService.node.penalizeSender(message, MisbehaviorInvalidMessage)
 		return
 	}
-	ms.netService.msgEvent.Publish(topic.EventPublish, &topic.EventPublishMsg{Block: p.Blk, From: message.MessageFrom()})
+	ms.netService.msgEvent.Publish(topic.EventPublish, &topic.EventPublishMsg{Block: p.Blk, From: message.MessageFrom(), Direct: message.IsDirect()})
 }
 
 func (ms *MessageService) onConfirmReq(message *Message) {
 	r, err := protos.ConfirmReqBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Error(err)
+		ms.netService.node.penalizeSender(message, MisbehaviorInvalidMessage)
 		return
 	}
-	ms.netService.msgEvent.Publish(topic.EventConfirmReq, &topic.EventConfirmReqMsg{Blocks: r.Blk, From: message.MessageFrom()})
+	ms.netService.msgEvent.Publish(topic.EventConfirmReq, &topic.EventConfirmReqMsg{Blocks: r.Blk, From: message.MessageFrom(), Direct: message.IsDirect()})
 }
 
 func (ms *MessageService) onConfirmAck(message *Message) {
 	ack, err := protos.ConfirmAckBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.netService.node.penalizeSender(message, MisbehaviorInvalidMessage)
 		return
 	}
-	ms.netService.msgEvent.Publish(topic.EventConfirmAck, &EventConfirmAckMsg{ack, message.MessageFrom()})
+	ms.netService.msgEvent.Publish(topic.EventConfirmAck, &EventConfirmAckMsg{Block: ack, From: message.MessageFrom(), Direct: message.IsDirect()})
 }
 
 func (ms *MessageService) onPovStatus(message *Message) {
@@ -285,6 +288,7 @@ func (ms *MessageService) onPovPublishReq(message *Message) {
 	p, err := protos.PovPublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.netService.node.penalizeSender(message, MisbehaviorInvalidMessage)
 		return
 	}
 
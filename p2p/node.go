@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
@@ -89,11 +90,22 @@ type QlcNode struct {
 	reporter         p2pmetrics.Reporter
 	ping             *ping.Pinger
 	connectionGater  *ConnectionGater
+	reputation       *peerReputation
+	limiter          *messageLimiter
+	// boot nodes and discovery limit can be changed while running
+	cfgLock        sync.RWMutex
+	bootNodes      []string
+	discoveryLimit int
 }
 
 // NewNode return new QlcNode according to the config.
 func NewNode(config *config.Config) (*QlcNode, error) {
+	limiter, err := newMessageLimiter(config.P2PLimit)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithCancel(context.Background())
+	reputation := newPeerReputation()
 	node := &QlcNode{
 		cfg:             config,
 		ctx:             ctx,
@@ -101,7 +113,11 @@ func NewNode(config *config.Config) (*QlcNode, error) {
 		streamManager:   NewStreamManager(),
 		logger:          log.NewLogger("p2p"),
 		isMiner:         config.PoV.PovEnabled,
-		connectionGater: NewConnectionGater(),
+		connectionGater: NewConnectionGater(config.WhiteList.Enable, reputation),
+		reputation:      reputation,
+		limiter:         limiter,
+		bootNodes:       config.P2P.BootNodes,
+		discoveryLimit:  config.P2P.Discovery.Limit,
 	}
 	privateKey, err := config.DecodePrivateKey()
 	if err != nil {
@@ -117,6 +133,37 @@ func NewNode(config *config.Config) (*QlcNode, error) {
 	return node, nil
 }
 
+// SetBootNodes replaces the http urls to get boot node addresses from, it takes effect in the next round
+func (node *QlcNode) SetBootNodes(urls []string) {
+	node.cfgLock.Lock()
+	defer node.cfgLock.Unlock()
+	node.bootNodes = urls
+}
+
+func (node *QlcNode) getBootNodes() []string {
+	node.cfgLock.RLock()
+	defer node.cfgLock.RUnlock()
+	return node.bootNodes
+}
+
+// SetDiscoveryLimit changes the maximum number of peers found by a discovery round
+func (node *QlcNode) SetDiscoveryLimit(limit int) {
+	node.cfgLock.Lock()
+	defer node.cfgLock.Unlock()
+	node.discoveryLimit = limit
+}
+
+// SetMessageLimit replaces the limits of messages received from peers, the limits are removed if cfg is nil
+func (node *QlcNode) SetMessageLimit(cfg *config.P2PLimitConfig) error {
+	return node.limiter.setConfig(cfg)
+}
+
+func (node *QlcNode) getDiscoveryLimit() int {
+	node.cfgLock.RLock()
+	defer node.cfgLock.RUnlock()
+	return node.discoveryLimit
+}
+
 func (node *QlcNode) setRepresentativeNode(isRepresentative bool) {
 	node.isRepresentative = isRepresentative
 }
@@ -155,38 +202,25 @@ func (node *QlcNode) updateWhiteList(id string, url string) {
 
 func (node *QlcNode) buildHost() error {
 	var err error
-	go node.getBootNode(node.cfg.P2P.BootNodes)
+	go node.getBootNode()
 	node.logger.Info("Start Qlc Host...")
+	if err := node.reputation.load(node.netService.msgService.ledger); err != nil {
+		node.logger.Errorf("load banned peers: %s", err)
+	}
 	sourceMultiAddr, _ := ma.NewMultiaddr(node.cfg.P2P.Listen)
-	if node.cfg.WhiteList.Enable {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			libp2p.ConnectionGater(node.connectionGater),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
-	} else {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
+	node.host, err = libp2p.New(
+		node.ctx,
+		libp2p.ListenAddrs(sourceMultiAddr),
+		libp2p.Identity(node.privateKey),
+		//libp2p.NATPortMap(),
+		libp2p.BandwidthReporter(node.reporter),
+		libp2p.Ping(false),
+		libp2p.ConnectionGater(node.connectionGater),
+		// libp2p.NoSecurity,
+		// libp2p.DefaultMuxers,
+	)
+	if err != nil {
+		return err
 	}
 	node.host.SetStreamHandler(QlcProtocolID, node.handleStream)
 	node.kadDht, err = dht.New(node.ctx, node.host, dht.Mode(dht.ModeServer))
@@ -207,6 +241,9 @@ func (node *QlcNode) buildHost() error {
 	if err != nil {
 		return errors.New("failed to set up pubsub")
 	}
+	if err := node.pubSub.RegisterTopicValidator(MsgTopic, node.validateMessage); err != nil {
+		return err
+	}
 	tp, err := node.pubSub.Join(MsgTopic)
 	if err != nil {
 		return err
@@ -327,6 +364,7 @@ func (node *QlcNode) startPingService() {
 							LastUpdateTime: time.Now().Format(time.RFC3339),
 						}
 
+						node.reputation.fill(pi)
 						node.streamManager.AddOrUpdateOnlineInfo(pi)
 						_ = node.netService.msgService.ledger.AddOrUpdatePeerInfo(pi)
 					} else {
@@ -350,6 +388,7 @@ func (node *QlcNode) startPingService() {
 									Rtt:            stream.rtt.Seconds(),
 									LastUpdateTime: time.Now().Format(time.RFC3339),
 								}
+								node.reputation.fill(pi)
 								node.streamManager.AddOrUpdateOnlineInfo(pi)
 								_ = node.netService.msgService.ledger.AddOrUpdatePeerInfo(pi)
 							}
@@ -383,7 +422,10 @@ func (node *QlcNode) publishPeersInfoToChainContext() {
 						Version:        stream.globalVersion,
 						Rtt:            stream.rtt.Seconds(),
 						LastUpdateTime: stream.lastUpdateTime,
+						Dropped:        node.limiter.peerDropped(stream.pid.Pretty()),
 					}
+					bw := node.reporter.GetBandwidthForPeer(stream.pid)
+					ps.TotalIn, ps.TotalOut, ps.RateIn, ps.RateOut = bw.TotalIn, bw.TotalOut, bw.RateIn, bw.RateOut
 					p = append(p, ps)
 				}
 				return true
@@ -404,6 +446,7 @@ func (node *QlcNode) publishPeersInfoToChainContext() {
 				TotalOut: stats.TotalOut,
 				RateIn:   stats.RateIn,
 				RateOut:  stats.RateOut,
+				Dropped:  node.limiter.totalDropped(),
 			}
 			node.netService.msgEvent.Publish(topic.EventGetBandwidthStats, bwState)
 		}
@@ -509,7 +552,7 @@ func (node *QlcNode) updatePeersInfo(peers []peer.AddrInfo) {
 }
 
 func (node *QlcNode) handleStream(s network.Stream) {
-	node.logger.Infof("Got a new stream from %s!", s.Conn().RemotePeer().Pretty())
+	node.logger.With(log.Peer(s.Conn().RemotePeer().Pretty())).Infof("Got a new stream from %s!", s.Conn().RemotePeer().Pretty())
 	var addrs []ma.Multiaddr
 	var infos []peer.AddrInfo
 	addrs = append(addrs, s.Conn().RemoteMultiaddr())
@@ -522,6 +565,65 @@ func (node *QlcNode) handleStream(s network.Stream) {
 	node.streamManager.Add(s)
 }
 
+// penalize lowers the score of the peer by the misbehavior, and disconnects the peer if it is banned
+func (node *QlcNode) penalize(peerID string, m Misbehavior) {
+	if peerID == "" || peerID == node.ID.Pretty() {
+		return
+	}
+	banned, err := node.reputation.penalize(peerID, m)
+	if err != nil {
+		node.logger.Errorf("save ban of peer %s: %s", peerID, err)
+	}
+	if banned {
+		node.logger.With(log.Peer(peerID)).Warnf("ban peer %s for %s, score drops to %d", peerID, m, BanScoreThreshold)
+		node.disconnect(peerID)
+	} else {
+		node.logger.With(log.Peer(peerID)).Debugf("penalize peer %s for %s, score %d", peerID, m, node.reputation.score(peerID))
+	}
+}
+
+// penalizeSender penalizes the sender of the message only if it is received on a direct stream, the gossip
+// is relayed by peers which are not the author of it
+func (node *QlcNode) penalizeSender(message *Message, m Misbehavior) {
+	if message.IsDirect() {
+		node.penalize(message.MessageFrom(), m)
+	}
+}
+
+// BanPeer bans the peer for the duration and disconnects it
+func (node *QlcNode) BanPeer(peerID string, duration time.Duration, reason string) error {
+	if _, err := peer.Decode(peerID); err != nil {
+		return err
+	}
+	if peerID == node.ID.Pretty() {
+		return errors.New("can not ban self")
+	}
+	if err := node.reputation.ban(peerID, duration, reason); err != nil {
+		return err
+	}
+	node.logger.With(log.Peer(peerID)).Warnf("ban peer %s for %s: %s", peerID, duration, reason)
+	node.disconnect(peerID)
+	return nil
+}
+
+// UnbanPeer removes the ban of the peer and resets its score
+func (node *QlcNode) UnbanPeer(peerID string) error {
+	if _, err := peer.Decode(peerID); err != nil {
+		return err
+	}
+	node.logger.With(log.Peer(peerID)).Infof("unban peer %s", peerID)
+	return node.reputation.unban(peerID)
+}
+
+// disconnect closes the connections with the peer, the stream is closed by its read loop then
+func (node *QlcNode) disconnect(peerID string) {
+	if pid, err := peer.Decode(peerID); err == nil && node.host != nil {
+		if err := node.host.Network().ClosePeer(pid); err != nil {
+			node.logger.Error(err)
+		}
+	}
+}
+
 // ID return node ID.
 func (node *QlcNode) GetID() string {
 	return node.ID.Pretty()
@@ -605,50 +707,90 @@ func (node *QlcNode) handleSubscription(ctx context.Context, sub pubsub.Subscrip
 	}
 }
 
+// validateMessage rejects the malformed gossip before it is delivered or forwarded, so invalid messages can not
+// spread through honest peers. The relaying peer is not penalized here, it is not the author of the message.
+func (node *QlcNode) validateMessage(ctx context.Context, from peer.ID, msg *libp2pps.Message) libp2pps.ValidationResult {
+	message, err := parseGossipMessage(msg.GetData())
+	if err != nil {
+		node.logger.Debugf("reject gossip relayed by %s: %s", from.Pretty(), err)
+		return libp2pps.ValidationReject
+	}
+	if message.Version() < p2pVersion {
+		return libp2pps.ValidationIgnore
+	}
+	return libp2pps.ValidationAccept
+}
+
+// parseGossipMessage parses the header and the data of a message received from pubsub
+func parseGossipMessage(data []byte) (*QlcMessage, error) {
+	message, err := ParseQlcMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	messageBuffer := data[QlcMessageHeaderLength:]
+	if len(messageBuffer) < int(message.DataLength()) {
+		return nil, ErrInvalidMessageDataLength
+	}
+	if err := message.ParseMessageData(messageBuffer); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
 func (node *QlcNode) processMessage(ctx context.Context, pubSubMsg pubsub.Message) error {
-	var message *QlcMessage
-	var err error
 	data := pubSubMsg.GetData()
 	peerID := pubSubMsg.GetSender().Pretty()
 	if peerID == node.ID.Pretty() {
 		return nil
 	}
 	node.logger.Debugf("node [%s] receive topic from [%s]", node.ID.Pretty(), peerID)
-	message, err = ParseQlcMessage(data)
+	// the message has been checked by validateMessage
+	message, err := parseGossipMessage(data)
 	if err != nil {
 		return err
 	}
 	node.logger.Debug("message Type is :", message.messageType)
-	messageBuffer := data[QlcMessageHeaderLength:]
-	if len(messageBuffer) < int(message.DataLength()) {
-		return errors.New("data length error")
-	}
-	if err := message.ParseMessageData(messageBuffer); err != nil {
-		return err
-	}
 	if message.Version() < p2pVersion {
 		node.logger.Debugf("message Version [%d] is less then p2pVersion [%d]", message.Version(), p2pVersion)
 		return nil
 	}
+	// the handler of pubsub can not be blocked, so the message exceeding the limits is dropped without waiting,
+	// the limits of each peer are not applied to gossip, the peer relaying it is not the author of it
+	if !node.limiter.allowGossip(message.MessageType()) {
+		node.logger.Debugf("drop %s message relayed by %s for exceeding the limits", message.MessageType(), peerID)
+		return nil
+	}
 	m := NewMessage(message.MessageType(), peerID, message.MessageData(), message.content)
 	node.netService.PutMessage(m)
 	return nil
 }
 
+// penalizeParseError penalizes the peer by the error of parsing its message
+func (node *QlcNode) penalizeParseError(peerID string, err error) {
+	switch err {
+	case ErrInvalidDataCheckSum:
+		node.penalize(peerID, MisbehaviorBadCheckSum)
+	case ErrMessageTooLarge:
+		node.penalize(peerID, MisbehaviorOversizedMessage)
+	default:
+		node.penalize(peerID, MisbehaviorInvalidMessage)
+	}
+}
+
 type pubSubHandler func(ctx context.Context, msg pubsub.Message) error
 
 func (node *QlcNode) GetBandwidthStats(stats *p2pmetrics.Stats) {
 	*stats = node.reporter.GetBandwidthTotals()
 }
 
-func (node *QlcNode) getBootNode(urls []string) {
+func (node *QlcNode) getBootNode() {
 	ticker := time.NewTicker(getBootNodeInterval)
 	for {
 		select {
 		case <-node.ctx.Done():
 			return
 		case <-ticker.C:
-			for _, v := range urls {
+			for _, v := range node.getBootNodes() {
 				boot, err := accessHttpServer(v)
 				if err != nil {
 					continue
@@ -342,6 +342,7 @@ func TestQlcNode_buildHost(t *testing.T) {
 		reporter         p2pmetrics.Reporter
 		ping             *ping.Pinger
 		connectionGater  *ConnectionGater
+		reputation       *peerReputation
 	}
 	tests := []struct {
 		name    string
@@ -349,8 +350,8 @@ func TestQlcNode_buildHost(t *testing.T) {
 		wantErr bool
 	}{
 		// TODO: Add test cases.
-		{"okWhitelistEnable", fields{na.ID, na.privateKey, na.cfg, na.ctx, na.cancel, na.localDiscovery, na.host, na.peerStore, na.boostrapAddrs, na.streamManager, na.dis, na.kadDht, na.netService, na.logger, na.MessageTopic, na.pubSub, na.MessageSub, na.isMiner, na.isRepresentative, na.reporter, na.ping, na.connectionGater}, false},
-		{"okWhitelistDisable", fields{na.ID, na.privateKey, na.cfg, na.ctx, na.cancel, na.localDiscovery, na.host, na.peerStore, na.boostrapAddrs, na.streamManager, na.dis, na.kadDht, na.netService, na.logger, na.MessageTopic, na.pubSub, na.MessageSub, na.isMiner, na.isRepresentative, na.reporter, na.ping, na.connectionGater}, false},
+		{"okWhitelistEnable", fields{na.ID, na.privateKey, na.cfg, na.ctx, na.cancel, na.localDiscovery, na.host, na.peerStore, na.boostrapAddrs, na.streamManager, na.dis, na.kadDht, na.netService, na.logger, na.MessageTopic, na.pubSub, na.MessageSub, na.isMiner, na.isRepresentative, na.reporter, na.ping, na.connectionGater, na.reputation}, false},
+		{"okWhitelistDisable", fields{na.ID, na.privateKey, na.cfg, na.ctx, na.cancel, na.localDiscovery, na.host, na.peerStore, na.boostrapAddrs, na.streamManager, na.dis, na.kadDht, na.netService, na.logger, na.MessageTopic, na.pubSub, na.MessageSub, na.isMiner, na.isRepresentative, na.reporter, na.ping, na.connectionGater, na.reputation}, false},
 	}
 	step := 0
 	for _, tt := range tests {
@@ -378,6 +379,7 @@ func TestQlcNode_buildHost(t *testing.T) {
 				reporter:         tt.fields.reporter,
 				ping:             tt.fields.ping,
 				connectionGater:  tt.fields.connectionGater,
+				reputation:       tt.fields.reputation,
 			}
 			switch step {
 			case 1:
@@ -1808,8 +1810,9 @@ func TestQlcNode_getBootNode(t *testing.T) {
 				reporter:         tt.fields.reporter,
 				ping:             tt.fields.ping,
 				connectionGater:  tt.fields.connectionGater,
+				bootNodes:        tt.args.urls,
 			}
-			node.getBootNode(tt.args.urls)
+			node.getBootNode()
 		})
 	}
 }
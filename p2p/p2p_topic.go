@@ -33,8 +33,9 @@ type EventPovBulkPullRspMsg struct {
 }
 
 type EventConfirmAckMsg struct {
-	Block *protos.ConfirmAckBlock
-	From  string
+	Block  *protos.ConfirmAckBlock
+	From   string
+	Direct bool
 }
 
 type EventBroadcastMsg struct {
@@ -45,3 +46,9 @@ type EventBroadcastMsg struct {
 type EventFrontiersReqMsg struct {
 	PeerID string
 }
+
+// EventPeerMisbehaveMsg reports the misbehavior of a peer found out of the p2p layer, like invalid blocks
+type EventPeerMisbehaveMsg struct {
+	PeerID      string
+	Misbehavior Misbehavior
+}
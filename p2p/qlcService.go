@@ -20,6 +20,10 @@ type QlcService struct {
 	msgEvent   event.EventBus
 	msgService *MessageService
 	cc         *chainctx.ChainContext
+
+	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
+	febRpcMsgSubID event.FeedSubscription
+	quitCh         chan struct{}
 }
 
 // NewQlcService create netService
@@ -31,10 +35,12 @@ func NewQlcService(cfgFile string) (*QlcService, error) {
 		return nil, err
 	}
 	ns := &QlcService{
-		node:       node,
-		dispatcher: NewDispatcher(),
-		msgEvent:   cc.EventBus(),
-		cc:         cc,
+		node:        node,
+		dispatcher:  NewDispatcher(),
+		msgEvent:    cc.EventBus(),
+		cc:          cc,
+		febRpcMsgCh: make(chan *topic.EventRPCSyncCallMsg, 100),
+		quitCh:      make(chan struct{}),
 	}
 	node.SetQlcService(ns)
 	l := ledger.NewLedger(cfgFile)
@@ -124,18 +130,63 @@ func (ns *QlcService) setEvent() error {
 			if len(msg.NodeId) != 0 {
 				ns.node.updateWhiteList(msg.NodeId, msg.NodeUrl)
 			}
+		case *EventPeerMisbehaveMsg:
+			ns.node.penalize(msg.PeerID, msg.Misbehavior)
 		}
 	}), ns.msgEvent)
 
 	if err := ns.subscriber.Subscribe(topic.EventBroadcast, topic.EventSendMsgToSingle, topic.EventFrontiersReq,
-		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate); err != nil {
+		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate,
+		topic.EventPeerMisbehave); err != nil {
 		ns.node.logger.Error(err)
 		return err
 	}
 
+	ns.febRpcMsgSubID = ns.cc.FeedEventBus().Subscribe(topic.EventRpcSyncCall, ns.febRpcMsgCh)
+	if ns.febRpcMsgSubID == nil {
+		ns.node.logger.Error("failed to subscribe EventRpcSyncCall")
+	} else {
+		go ns.rpcCallLoop()
+	}
+
 	return nil
 }
 
+func (ns *QlcService) rpcCallLoop() {
+	for {
+		select {
+		case <-ns.quitCh:
+			return
+		case msg := <-ns.febRpcMsgCh:
+			ns.onEventRPCSyncCall(msg)
+		}
+	}
+}
+
+// onEventRPCSyncCall bans and unbans peers for the net rpc
+func (ns *QlcService) onEventRPCSyncCall(msg *topic.EventRPCSyncCallMsg) {
+	in, ok1 := msg.In.(map[string]interface{})
+	out, ok2 := msg.Out.(map[string]interface{})
+	if !ok1 || !ok2 {
+		return
+	}
+	switch msg.Name {
+	case "Net.BanPeer":
+		peerID, _ := in["peerId"].(string)
+		duration, _ := in["duration"].(time.Duration)
+		reason, _ := in["reason"].(string)
+		out["err"] = ns.node.BanPeer(peerID, duration, reason)
+	case "Net.UnbanPeer":
+		peerID, _ := in["peerId"].(string)
+		out["err"] = ns.node.UnbanPeer(peerID)
+	default:
+		return
+	}
+	if msg.ResponseChan != nil {
+		msg.ResponseChan <- msg.Out
+	}
+}
+
 // Stop stop p2p manager.
 func (ns *QlcService) Stop() error {
 	// ns.node.logger.VInfo("Stopping QlcService...")
@@ -145,6 +196,10 @@ func (ns *QlcService) Stop() error {
 	if err != nil {
 		return err
 	}
+	if ns.febRpcMsgSubID != nil {
+		ns.febRpcMsgSubID.Unsubscribe()
+		close(ns.quitCh)
+	}
 
 	if err := ns.node.Stop(); err != nil {
 		return err
@@ -188,5 +243,8 @@ func (ns *QlcService) Broadcast(name MessageType, value interface{}) {
 
 // SendMessageToPeer send message to a peer.
 func (ns *QlcService) SendMessageToPeer(messageName MessageType, value interface{}, peerID string) error {
+	if messageName == BulkPullRequest {
+		ns.msgService.syncService.onPullRequest(peerID)
+	}
 	return ns.node.SendMessageToPeer(messageName, value, peerID)
 }
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package p2p
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+const (
+	// a peer is banned when its score drops to BanScoreThreshold, the score recovers a point per minute
+	BanScoreThreshold    = -100
+	scoreRecoverInterval = time.Minute
+	DefaultBanDuration   = 24 * time.Hour
+)
+
+var ErrInvalidBanDuration = errors.New("invalid ban duration")
+
+// Misbehavior is invalid data sent by a peer, each one lowers the score of the peer by its penalty
+type Misbehavior byte
+
+const (
+	MisbehaviorInvalidBlock Misbehavior = iota
+	MisbehaviorInvalidMessage
+	MisbehaviorBadCheckSum
+	MisbehaviorUnsolicitedBulkPullRsp
+	MisbehaviorOversizedMessage
+)
+
+var misbehaviors = [...]struct {
+	name    string
+	penalty int
+}{
+	MisbehaviorInvalidBlock:           {"invalid block", 20},
+	MisbehaviorInvalidMessage:         {"invalid message", 10},
+	MisbehaviorBadCheckSum:            {"bad checksum", 25},
+	MisbehaviorUnsolicitedBulkPullRsp: {"unsolicited BulkPullRsp", 10},
+	MisbehaviorOversizedMessage:       {"oversized message", 50},
+}
+
+func (m Misbehavior) String() string {
+	if int(m) >= len(misbehaviors) {
+		return "unknown misbehavior"
+	}
+	return misbehaviors[m].name
+}
+
+func (m Misbehavior) Penalty() int {
+	if int(m) >= len(misbehaviors) {
+		return 0
+	}
+	return misbehaviors[m].penalty
+}
+
+type peerScore struct {
+	score      int
+	updateTime time.Time
+}
+
+// recover adds the points recovered since the last update, the score never exceeds 0
+func (s *peerScore) recover(now time.Time) {
+	if recovered := int(now.Sub(s.updateTime) / scoreRecoverInterval); recovered > 0 {
+		s.score += recovered
+		if s.score > 0 {
+			s.score = 0
+		}
+		s.updateTime = s.updateTime.Add(time.Duration(recovered) * scoreRecoverInterval)
+	}
+}
+
+// peerReputation scores peers by their misbehaviors and keeps the banned peers, bans are persisted in PeerInfo,
+// so they survive restarts. Scores recovered to 0 and expired bans are pruned.
+type peerReputation struct {
+	lock      sync.RWMutex
+	scores    map[string]*peerScore
+	bans      map[string]*types.PeerInfo
+	store     ledger.PeerInfoStore
+	pruneTime time.Time
+}
+
+func newPeerReputation() *peerReputation {
+	return &peerReputation{
+		scores: make(map[string]*peerScore),
+		bans:   make(map[string]*types.PeerInfo),
+	}
+}
+
+// load sets the store to persist bans and loads the bans not expired
+func (r *peerReputation) load(store ledger.PeerInfoStore) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.store = store
+	now := time.Now().Unix()
+	return store.GetPeersInfo(func(pi *types.PeerInfo) error {
+		if pi.BannedUntil > now {
+			r.bans[pi.PeerID] = pi
+		}
+		return nil
+	})
+}
+
+// penalize lowers the score of the peer, and bans the peer if the score drops to the threshold,
+// it returns true if the peer is banned by this misbehavior
+func (r *peerReputation) penalize(peerID string, m Misbehavior) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.isBannedLocked(peerID) {
+		return false, nil
+	}
+	now := time.Now()
+	if now.Sub(r.pruneTime) >= scoreRecoverInterval {
+		r.pruneLocked(now)
+	}
+	s, ok := r.scores[peerID]
+	if !ok {
+		s = &peerScore{updateTime: now}
+		r.scores[peerID] = s
+	}
+	// recover the score before the penalty
+	s.recover(now)
+	s.score -= m.Penalty()
+	if s.score > BanScoreThreshold {
+		return false, nil
+	}
+	return true, r.banLocked(peerID, DefaultBanDuration, m.String())
+}
+
+// pruneLocked drops the scores recovered to 0 and the expired bans
+func (r *peerReputation) pruneLocked(now time.Time) {
+	r.pruneTime = now
+	for id, s := range r.scores {
+		if s.recover(now); s.score == 0 {
+			delete(r.scores, id)
+		}
+	}
+	for id, pi := range r.bans {
+		if pi.BannedUntil <= now.Unix() {
+			delete(r.bans, id)
+		}
+	}
+}
+
+func (r *peerReputation) score(peerID string) int {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if s, ok := r.scores[peerID]; ok {
+		return s.score
+	}
+	return 0
+}
+
+func (r *peerReputation) ban(peerID string, duration time.Duration, reason string) error {
+	if duration <= 0 {
+		return ErrInvalidBanDuration
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.banLocked(peerID, duration, reason)
+}
+
+func (r *peerReputation) banLocked(peerID string, duration time.Duration, reason string) error {
+	pi := r.peerInfo(peerID)
+	pi.BannedUntil = time.Now().Add(duration).Unix()
+	pi.BanReason = reason
+	r.bans[peerID] = pi
+	delete(r.scores, peerID)
+	if r.store != nil {
+		return r.store.AddOrUpdatePeerInfo(pi)
+	}
+	return nil
+}
+
+// unban removes the ban and resets the score of the peer
+func (r *peerReputation) unban(peerID string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.bans, peerID)
+	delete(r.scores, peerID)
+	if r.store == nil {
+		return nil
+	}
+	pi, err := r.store.GetPeerInfo(peerID)
+	if err != nil || pi.BannedUntil == 0 {
+		return nil
+	}
+	pi.BannedUntil = 0
+	pi.BanReason = ""
+	return r.store.AddOrUpdatePeerInfo(pi)
+}
+
+func (r *peerReputation) isBanned(peerID string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.isBannedLocked(peerID)
+}
+
+func (r *peerReputation) isBannedLocked(peerID string) bool {
+	if pi, ok := r.bans[peerID]; ok {
+		return pi.BannedUntil > time.Now().Unix()
+	}
+	return false
+}
+
+// fill keeps the ban of the peer info updated by the node
+func (r *peerReputation) fill(pi *types.PeerInfo) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if b, ok := r.bans[pi.PeerID]; ok {
+		pi.BannedUntil = b.BannedUntil
+		pi.BanReason = b.BanReason
+	}
+}
+
+func (r *peerReputation) peerInfo(peerID string) *types.PeerInfo {
+	if r.store != nil {
+		if pi, err := r.store.GetPeerInfo(peerID); err == nil {
+			return pi
+		}
+	}
+	return &types.PeerInfo{PeerID: peerID, LastUpdateTime: time.Now().Format(time.RFC3339)}
+}
@@ -0,0 +1,159 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p-core/peer"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+func TestPeerReputation(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "reputation", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+	defer func() {
+		_ = l.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	peerID := "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+	r := newPeerReputation()
+	if err := r.load(l); err != nil {
+		t.Fatal(err)
+	}
+
+	// oversized message costs 50 points, the peer is banned by the second one
+	if banned, err := r.penalize(peerID, MisbehaviorOversizedMessage); banned || err != nil {
+		t.Fatal("peer should not be banned", err)
+	}
+	if r.score(peerID) != -MisbehaviorOversizedMessage.Penalty() {
+		t.Fatal("invalid score", r.score(peerID))
+	}
+	if banned, err := r.penalize(peerID, MisbehaviorOversizedMessage); !banned || err != nil {
+		t.Fatal("peer should be banned", err)
+	}
+	if !r.isBanned(peerID) {
+		t.Fatal("peer is not banned")
+	}
+
+	// the ban is persisted and kept when the peer info is updated
+	pi, err := l.GetPeerInfo(peerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pi.BannedUntil <= time.Now().Unix() || pi.BanReason != MisbehaviorOversizedMessage.String() {
+		t.Fatal("invalid ban", pi)
+	}
+	update := &types.PeerInfo{PeerID: peerID}
+	r.fill(update)
+	if update.BannedUntil != pi.BannedUntil {
+		t.Fatal("ban is not filled")
+	}
+	r2 := newPeerReputation()
+	if err := r2.load(l); err != nil {
+		t.Fatal(err)
+	}
+	if !r2.isBanned(peerID) {
+		t.Fatal("ban is not loaded")
+	}
+
+	// banned peers are rejected by the gater
+	cg := NewConnectionGater(false, r)
+	pid, _ := peer.Decode(peerID)
+	if cg.InterceptPeerDial(pid) || cg.InterceptAddrDial(pid, nil) || cg.InterceptSecured(0, pid, nil) {
+		t.Fatal("banned peer should be rejected")
+	}
+
+	if err := r.unban(peerID); err != nil {
+		t.Fatal(err)
+	}
+	if r.isBanned(peerID) || r.score(peerID) != 0 {
+		t.Fatal("peer is not unbanned")
+	}
+	if pi, err := l.GetPeerInfo(peerID); err != nil || pi.BannedUntil != 0 {
+		t.Fatal("unban is not persisted", err)
+	}
+	if !cg.InterceptPeerDial(pid) || !cg.InterceptAddrDial(pid, nil) {
+		t.Fatal("unbanned peer should be accepted")
+	}
+
+	if err := r.ban(peerID, 0, "test"); err != ErrInvalidBanDuration {
+		t.Fatal("zero duration should fail")
+	}
+}
+
+func TestPeerReputation_prune(t *testing.T) {
+	r := newPeerReputation()
+	peer1 := "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+	peer2 := "QmfMSZSGBaLobW6WKzqaVhXnbVg8kJEaRbWyEfsxi94dMw"
+	peer3 := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	if _, err := r.penalize(peer1, MisbehaviorInvalidMessage); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.penalize(peer2, MisbehaviorOversizedMessage); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ban(peer3, time.Hour, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	// peer1 has recovered, peer2 has not, the ban of peer3 is expired
+	now := time.Now()
+	r.scores[peer1].updateTime = now.Add(-time.Duration(MisbehaviorInvalidMessage.Penalty()) * scoreRecoverInterval)
+	r.scores[peer2].updateTime = now.Add(-scoreRecoverInterval)
+	r.bans[peer3].BannedUntil = now.Unix() - 1
+	r.pruneTime = now.Add(-scoreRecoverInterval)
+	if _, err := r.penalize(peer3, MisbehaviorInvalidMessage); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.scores[peer1]; ok {
+		t.Fatal("recovered score should be pruned")
+	}
+	if r.score(peer2) != 1-MisbehaviorOversizedMessage.Penalty() {
+		t.Fatal("invalid score", r.score(peer2))
+	}
+	if _, ok := r.bans[peer3]; ok || r.score(peer3) != -MisbehaviorInvalidMessage.Penalty() {
+		t.Fatal("expired ban should be pruned")
+	}
+}
+
+func TestQlcNode_penalizeSender(t *testing.T) {
+	node := &QlcNode{reputation: newPeerReputation(), logger: log.NewLogger("test_penalize")}
+	peerID := "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"
+
+	// the peer relaying gossip is not the author of it
+	m := NewMessage(PublishReq, peerID, nil, nil)
+	node.penalizeSender(m, MisbehaviorInvalidMessage)
+	if node.reputation.score(peerID) != 0 {
+		t.Fatal("relaying peer should not be penalized")
+	}
+
+	m.direct = true
+	node.penalizeSender(m, MisbehaviorInvalidMessage)
+	if node.reputation.score(peerID) != -MisbehaviorInvalidMessage.Penalty() {
+		t.Fatal("invalid score", node.reputation.score(peerID))
+	}
+}
+
+func Test_parseGossipMessage(t *testing.T) {
+	data := NewQlcMessage([]byte("block"), p2pVersion, PublishReq)
+	if m, err := parseGossipMessage(data); err != nil || m.MessageType() != PublishReq {
+		t.Fatal("parse message failed", err)
+	}
+	if _, err := parseGossipMessage(data[:len(data)-1]); err != ErrInvalidMessageDataLength {
+		t.Fatal("truncated message should fail", err)
+	}
+	data[len(data)-1]++
+	if _, err := parseGossipMessage(data); err != ErrInvalidDataCheckSum {
+		t.Fatal("bad checksum should fail", err)
+	}
+}
@@ -161,6 +161,10 @@ func (s *Stream) readLoop() {
 				}
 				message, err = ParseQlcMessage(messageBuffer)
 				if err != nil {
+					s.node.penalizeParseError(s.pid.Pretty(), err)
+					if err := s.close(); err != nil {
+						s.node.logger.Error(err)
+					}
 					return
 				}
 				messageBuffer = messageBuffer[QlcMessageHeaderLength:]
@@ -171,6 +175,10 @@ func (s *Stream) readLoop() {
 				break
 			}
 			if err := message.ParseMessageData(messageBuffer); err != nil {
+				s.node.penalizeParseError(s.pid.Pretty(), err)
+				if err := s.close(); err != nil {
+					s.node.logger.Error(err)
+				}
 				return
 			}
 			// remove data from buffer.
@@ -294,7 +302,13 @@ func (s *Stream) handleMessage(message *QlcMessage) {
 		s.node.logger.Debugf("message Version [%d] is less then p2pVersion [%d]", message.Version(), p2pVersion)
 		return
 	}
+	// reading of the stream is stopped while waiting, which slows down the peer sending too many messages
+	if !s.node.limiter.waitAllow(s.pid.Pretty(), message.MessageType()) {
+		s.node.logger.Debugf("drop %s message from %s for exceeding the limits", message.MessageType(), s.pid.Pretty())
+		return
+	}
 	m := NewMessage(message.MessageType(), s.pid.Pretty(), message.MessageData(), message.content)
+	m.direct = true
 	s.node.netService.PutSyncMessage(m)
 }
 
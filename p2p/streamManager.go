@@ -106,6 +106,7 @@ func (sm *StreamManager) RemoveStream(s *Stream) {
 		sm.node.logger.Debugf("Removing a stream:[%s]", s.pid.Pretty())
 		sm.allStreams.Delete(s.pid.Pretty())
 		sm.activePeersCount--
+		sm.node.limiter.removePeer(s.pid.Pretty())
 	}
 }
 
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 	"sync"
@@ -43,6 +44,8 @@ type ServiceSync struct {
 	lastSyncHash       types.Hash
 	quitChanForSync    chan bool
 	mu                 *sync.Mutex
+	// peer id -> time of the last BulkPullRequest sent to the peer
+	pullRequests *sync.Map
 }
 
 // NewService return new Service.
@@ -56,6 +59,7 @@ func NewSyncService(netService *QlcService, ledger ledger.Store) *ServiceSync {
 		pullRequestStartCh: make(chan bool, 1),
 		quitChanForSync:    make(chan bool, 1),
 		mu:                 &sync.Mutex{},
+		pullRequests:       new(sync.Map),
 	}
 	return ss
 }
@@ -599,9 +603,26 @@ func (ss *ServiceSync) onBulkPullRequest(message *Message) error {
 //	return nil
 //}
 
+// onPullRequest records the BulkPullRequest sent to the peer, BulkPullRsp is only accepted from the peer requested
+func (ss *ServiceSync) onPullRequest(peerID string) {
+	ss.pullRequests.Store(peerID, time.Now())
+}
+
+func (ss *ServiceSync) isPullRequested(peerID string) bool {
+	if v, ok := ss.pullRequests.Load(peerID); ok {
+		return time.Since(v.(time.Time)) < pullRspTimeOut
+	}
+	return false
+}
+
 func (ss *ServiceSync) onBulkPullRsp(message *Message) error {
+	if !ss.isPullRequested(message.MessageFrom()) {
+		ss.netService.node.penalize(message.MessageFrom(), MisbehaviorUnsolicitedBulkPullRsp)
+		return fmt.Errorf("unsolicited BulkPullRsp from %s", message.MessageFrom())
+	}
 	blkPacket, err := protos.BulkPullRspPacketFromProto(message.Data())
 	if err != nil {
+		ss.netService.node.penalize(message.MessageFrom(), MisbehaviorInvalidMessage)
 		return err
 	}
 	blocks := blkPacket.Blocks
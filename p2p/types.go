@@ -62,6 +62,7 @@ type Message struct {
 	from        string
 	data        []byte //removed the header
 	content     []byte //complete message data
+	direct      bool   //received on a stream from the peer, not relayed by pubsub
 }
 
 // NewBaseMessage new base message
@@ -79,6 +80,12 @@ func (msg *Message) MessageFrom() string {
 	return msg.from
 }
 
+// IsDirect returns whether the message is received on a stream from the sender, only the sender of such
+// message is accountable for its content, the sender of a gossip message is just the relaying peer
+func (msg *Message) IsDirect() bool {
+	return msg.direct
+}
+
 // Data get the message data
 func (msg *Message) Data() []byte {
 	return msg.data
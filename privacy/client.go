@@ -16,6 +16,7 @@ type Transport interface {
 }
 
 type Client struct {
+	ptmNode    string // the endpoint the client is created by
 	scheme     string // http+unix, http
 	rootPath   string
 	transport  Transport
@@ -36,7 +37,7 @@ func NewClient(ptmNode string) *Client {
 	}
 	rootPath := ptmNode[len(parts[0])+1:]
 
-	c := &Client{scheme: scheme, rootPath: rootPath}
+	c := &Client{ptmNode: ptmNode, scheme: scheme, rootPath: rootPath}
 	c.httpClient = &http.Client{}
 
 	if c.scheme == "http+unix" {
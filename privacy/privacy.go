@@ -97,6 +97,11 @@ func (c *Controller) Stop() error {
 	return nil
 }
 
+// SetPtmNode applies the new ptm node endpoint to the running controller
+func (c *Controller) SetPtmNode(ptmNode string) error {
+	return c.ptm.SetPtmNode(ptmNode)
+}
+
 func (c *Controller) mainLoop() {
 	for {
 		select {
@@ -24,7 +24,9 @@ const (
 type PTM struct {
 	cfg        *config.Config
 	logger     *zap.SugaredLogger
+	ptmNode    atomic.String
 	clientPool sync.Pool
+	clientLock sync.RWMutex
 	mainClient *Client
 	cache      gcache.Cache
 	status     atomic.Int32
@@ -44,8 +46,9 @@ var (
 
 func NewPTM(cfg *config.Config) *PTM {
 	m := &PTM{cfg: cfg}
+	m.ptmNode.Store(cfg.Privacy.PtmNode)
 	m.clientPool.New = func() interface{} {
-		return NewClient(m.cfg.Privacy.PtmNode)
+		return NewClient(m.ptmNode.Load())
 	}
 	m.status.Store(ptmNodeUnknown)
 	return m
@@ -55,14 +58,14 @@ func (m *PTM) Init() error {
 	m.cache = gcache.New(common.DPoSMaxBlocks).LRU().Build()
 	m.logger = log.NewLogger("privacy_ptm")
 
-	m.mainClient = NewClient(m.cfg.Privacy.PtmNode)
+	m.mainClient = NewClient(m.ptmNode.Load())
 	if m.mainClient == nil {
 		return errors.New("invalid ptm node")
 	}
 
 	nCPU := runtime.NumCPU()
 	for i := 0; i < nCPU; i++ {
-		c := NewClient(m.cfg.Privacy.PtmNode)
+		c := NewClient(m.ptmNode.Load())
 		if c == nil {
 			return errors.New("invalid ptm node")
 		}
@@ -75,7 +78,7 @@ func (m *PTM) Init() error {
 func (m *PTM) Start() error {
 	m.quitCh = make(chan struct{})
 
-	m.logger.Info("ptm node at ", m.cfg.Privacy.PtmNode)
+	m.logger.Info("ptm node at ", m.ptmNode.Load())
 
 	common.Go(m.mainLoop)
 
@@ -175,7 +178,7 @@ func (m *PTM) onUpCheckTicker() {
 	oldStatus := m.status.Load()
 	newStatus := int32(ptmNodeUnknown)
 
-	chkOk, chkErr := m.mainClient.Upcheck()
+	chkOk, chkErr := m.getMainClient().Upcheck()
 	if chkOk {
 		newStatus = int32(ptmNodeRunning)
 	} else {
@@ -187,27 +190,59 @@ func (m *PTM) onUpCheckTicker() {
 	}
 
 	if newStatus == int32(ptmNodeRunning) {
-		m.logger.Infof("ptm node is online, url %s", m.cfg.Privacy.PtmNode)
+		m.logger.Infof("ptm node is online, url %s", m.ptmNode.Load())
 	} else {
-		m.logger.Errorf("ptm node is offline, url [%s], err [%s]", m.cfg.Privacy.PtmNode, chkErr)
+		m.logger.Errorf("ptm node is offline, url [%s], err [%s]", m.ptmNode.Load(), chkErr)
 	}
 
 	m.status.Store(newStatus)
 }
 
+func (m *PTM) getMainClient() *Client {
+	m.clientLock.RLock()
+	defer m.clientLock.RUnlock()
+	return m.mainClient
+}
+
+// SetPtmNode switches to a new ptm node endpoint, the pooled clients of the old endpoint are dropped
+// and the node status is checked again by the main loop
+func (m *PTM) SetPtmNode(ptmNode string) error {
+	c := NewClient(ptmNode)
+	if c == nil {
+		return errors.New("invalid ptm node")
+	}
+	if m.fakeMode {
+		c.transport.SetFakeMode(true)
+	}
+
+	m.clientLock.Lock()
+	m.mainClient = c
+	m.ptmNode.Store(c.ptmNode)
+	m.clientLock.Unlock()
+
+	m.status.Store(ptmNodeUnknown)
+	m.logger.Info("ptm node changed to ", c.ptmNode)
+	return nil
+}
+
 func (m *PTM) acquireClient() *Client {
 	if m.fakeMode {
-		return m.mainClient
+		return m.getMainClient()
 	}
-	v := m.clientPool.Get()
-	if v != nil {
-		return v.(*Client)
+	for {
+		v := m.clientPool.Get()
+		if v == nil {
+			return nil
+		}
+		c := v.(*Client)
+		if c == nil || c.ptmNode == m.ptmNode.Load() {
+			return c
+		}
 	}
-	return nil
 }
 
 func (m *PTM) releaseClient(c *Client) {
-	if m.fakeMode {
+	if m.fakeMode || c.ptmNode != m.ptmNode.Load() {
 		return
 	}
 	m.clientPool.Put(c)
@@ -224,5 +259,5 @@ func (m *PTM) GetDebugInfo() map[string]interface{} {
 
 func (m *PTM) SetFakeMode(mode bool) {
 	m.fakeMode = mode
-	m.mainClient.transport.SetFakeMode(mode)
+	m.getMainClient().transport.SetFakeMode(mode)
 }
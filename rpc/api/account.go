@@ -8,6 +8,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/ed25519"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -62,6 +65,70 @@ func (a *AccountApi) NewSeed() (string, error) {
 	return hex.EncodeToString(seed[:]), nil
 }
 
+// NewMnemonic generates a BIP-39 mnemonic, 24 words by default
+func (a *AccountApi) NewMnemonic(words *int) (string, error) {
+	if words == nil {
+		return hd.NewMnemonic(24)
+	}
+	return hd.NewMnemonic(*words)
+}
+
+func (a *AccountApi) ValidateMnemonic(mnemonic string) bool {
+	return hd.ValidateMnemonic(mnemonic)
+}
+
+// CreateByMnemonic derives the account of the SLIP-10 path from the mnemonic,
+// the first account under hd.DefaultPath is derived if the path is not set
+func (a *AccountApi) CreateByMnemonic(mnemonic, passphrase string, path *string) (map[string]string, error) {
+	p := hd.AccountPath(hd.DefaultPath, 0)
+	if path != nil && *path != "" {
+		p = *path
+	}
+	seed, err := hd.MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := hd.DeriveAccount(seed, p)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]string)
+	r["pubKey"] = hex.EncodeToString(acc.Address().Bytes())
+	r["privKey"] = hex.EncodeToString(acc.PrivateKey())
+	r["address"] = acc.Address().String()
+	r["path"] = p
+	return r, nil
+}
+
+// EncryptKeystore encrypts the hex private key to an account keystore
+func (a *AccountApi) EncryptKeystore(privKey string, password string) (*keystore.Keystore, error) {
+	b, err := hex.DecodeString(privKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key size %d", len(b))
+	}
+	return keystore.EncryptAccount(types.NewAccount(b), password, keystore.StandardScrypt)
+}
+
+// DecryptKeystore decrypts the account keystore json
+func (a *AccountApi) DecryptKeystore(content string, password string) (map[string]string, error) {
+	ks, err := keystore.Parse([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	acc, err := ks.Account(password)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]string)
+	r["pubKey"] = hex.EncodeToString(acc.Address().Bytes())
+	r["privKey"] = hex.EncodeToString(acc.PrivateKey())
+	r["address"] = acc.Address().String()
+	return r, nil
+}
+
 type Accounts struct {
 	Seed       string `json:"seed"`
 	PrivateKey string `json:"privateKey"`
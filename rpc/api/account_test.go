@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/qlcchain/go-qlc/mock"
@@ -62,3 +64,53 @@ func TestAccountApi_Validate(t *testing.T) {
 		t.Fatal(b)
 	}
 }
+
+func TestAccountApi_Mnemonic(t *testing.T) {
+	api := NewAccountApi()
+	m, err := api.NewMnemonic(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !api.ValidateMnemonic(m) || api.ValidateMnemonic(m+" abandon") {
+		t.Fatal("invalid mnemonic validation")
+	}
+	r1, err := api.CreateByMnemonic(m, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "m/44'/1995'/0'"
+	r2, err := api.CreateByMnemonic(m, "", &path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r1["address"] != r2["address"] || r1["path"] != path {
+		t.Fatal("default path should be the first account", r1, r2)
+	}
+	words := 11
+	if _, err := api.NewMnemonic(&words); err == nil {
+		t.Fatal("11 words should be invalid")
+	}
+}
+
+func TestAccountApi_Keystore(t *testing.T) {
+	api := NewAccountApi()
+	account := mock.Account()
+	ks, err := api.EncryptKeystore(hex.EncodeToString(account.PrivateKey()), "pwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := json.Marshal(ks)
+	r, err := api.DecryptKeystore(string(content), "pwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r["address"] != account.Address().String() || r["privKey"] != hex.EncodeToString(account.PrivateKey()) {
+		t.Fatal("invalid account", r)
+	}
+	if _, err := api.DecryptKeystore(string(content), "pwd2"); err == nil {
+		t.Fatal("password should be invalid")
+	}
+	if _, err := api.EncryptKeystore("abcd", "pwd"); err == nil {
+		t.Fatal("private key should be invalid")
+	}
+}
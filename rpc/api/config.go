@@ -102,3 +102,25 @@ func (c *ConfigApi) Save(token string, mark string) (bool, error) {
 	c.eb.Publish(topic.EventRestartChain, types.NewTuple(c.cfgManager.ConfigFile, true))
 	return true, nil
 }
+
+// Apply commits the modified config to the running node without restarting it, the keys in the result
+// Applied field take effect immediately, and the keys in Restart field need config_commit or config_save
+// to restart the chain. If isSave is true, the config is also saved to the config file, otherwise the
+// change is refused if any key is not applied live by a service, it must be saved to survive the restart.
+func (c *ConfigApi) Apply(token string, mark string, isSave bool) (*context.ConfigChange, error) {
+	if token != c.token {
+		return nil, ErrIdentity
+	}
+	if mark != c.mark {
+		return nil, ErrOperation
+	}
+	change, err := c.context.ApplyConfig(isSave)
+	if err != nil {
+		if err == context.ErrConfigNotChanged {
+			return nil, ErrOperation
+		}
+		return nil, err
+	}
+	c.logger.Infof("config applied, %v, need restart, %v", change.Applied, change.Restart)
+	return change, nil
+}
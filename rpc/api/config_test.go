@@ -3,10 +3,12 @@ package api
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 
+	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/config"
 )
 
@@ -46,3 +48,54 @@ func TestConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestConfigApi_Apply(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "l", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := config.NewCfgManager(dir)
+	cm.Load()
+	cfg, err := cm.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := cfg.Manager.AdminToken
+	c := NewConfigApi(cm.ConfigFile)
+	defer func() {
+		_ = c.context.Destroy()
+	}()
+
+	var level string
+	c.context.WatchConfig(context.LogService, []string{"logLevel"}, func(cfg *config.Config) error {
+		level = cfg.LogLevel
+		return nil
+	})
+
+	mark := "abc"
+	if _, err := c.Apply(token, mark, false); err != ErrOperation {
+		t.Fatal(err)
+	}
+	if _, err := c.Update([]string{"logLevel=debug", "p2p.syncInterval=200"}, token, mark); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Apply("invalid", mark, false); err != ErrIdentity {
+		t.Fatal(err)
+	}
+	if _, err := c.Apply(token, mark, false); err == nil || !strings.Contains(err.Error(), "p2p.syncInterval") {
+		t.Fatal("unwatched key should not be applied without saving", err)
+	}
+	change, err := c.Apply(token, mark, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level != "debug" || len(change.Applied) != 1 || change.Applied[0] != "logLevel" {
+		t.Fatal(level, change.Applied)
+	}
+	if len(change.Restart) != 1 || change.Restart[0] != "p2p.syncInterval" {
+		t.Fatal(change.Restart)
+	}
+	if _, err := c.Apply(token, mark, false); err != ErrOperation {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/AsynkronIT/protoactor-go/actor"
 	rpc "github.com/qlcchain/jsonrpc2"
 	"go.uber.org/zap"
 
@@ -443,6 +444,66 @@ func (l *DebugApi) GetConsPerf() (map[string]interface{}, error) {
 	return outArgs, nil
 }
 
+// ElectionTrace returns the forks, votes and decision of the election of the block, it is pending if the block is voting
+func (l *DebugApi) ElectionTrace(hash types.Hash) (*dpos.ElectionTrace, error) {
+	outArgs := make(map[string]interface{})
+
+	sv, err := l.getConsensusService()
+	if err != nil {
+		return nil, err
+	}
+	sv.RpcCall(common.RpcDPoSElectionTrace, hash, outArgs)
+
+	er, ok := outArgs["err"]
+	if !ok {
+		return nil, errors.New("api not support")
+	}
+	if er != nil {
+		return nil, er.(error)
+	}
+	return outArgs["trace"].(*dpos.ElectionTrace), nil
+}
+
+// ElectionTraces notifies the traces of elections when they are decided
+func (l *DebugApi) ElectionTraces(ctx context.Context) (*rpc.Subscription, error) {
+	return createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
+		traces := make(chan *dpos.ElectionTrace, 1024)
+		subscriber := event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+			if t, ok := c.Message().(*dpos.ElectionTrace); ok {
+				select {
+				case traces <- t:
+				default:
+					l.logger.Warnf("election traces subscription %s is too slow, drop trace %s", subscription.ID, t.Hash)
+				}
+			}
+		}), l.eb)
+		if err := subscriber.Subscribe(topic.EventElectionTrace); err != nil {
+			l.logger.Error(err)
+			return
+		}
+
+		go func() {
+			defer func() {
+				if err := subscriber.UnsubscribeAll(); err != nil {
+					l.logger.Error(err)
+				}
+			}()
+			for {
+				select {
+				case t := <-traces:
+					if err := notifier.Notify(subscription.ID, t); err != nil {
+						l.logger.Errorf("notify error: %s", err)
+						return
+					}
+				case err := <-subscription.Err():
+					l.logger.Infof("subscription exception %s", err)
+					return
+				}
+			}
+		}()
+	})
+}
+
 type CacheStat struct {
 	Index int    `json:"index"`
 	Key   int    `json:"key"`
@@ -759,3 +820,27 @@ func (l *DebugApi) BadgerTableSize(keyPrefixs []storage.KeyPrefix) (int64, error
 	}
 	return size, nil
 }
+
+type APILogLevels struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+// SetLogLevel changes the level of the named logger at runtime, the global level is changed if module is empty,
+// and the logger uses the global level again if level is empty
+func (l *DebugApi) SetLogLevel(module string, level string) error {
+	if module == "" && level == "" {
+		return errors.New("invalid log level")
+	}
+	if err := log.SetModuleLevel(module, level); err != nil {
+		return err
+	}
+	l.logger.Infof("log level of [%s] is changed to [%s]", module, level)
+	return nil
+}
+
+// GetLogLevels returns the global log level and the level of each named logger
+func (l *DebugApi) GetLogLevels() *APILogLevels {
+	level, modules := log.Levels()
+	return &APILogLevels{Level: level, Modules: modules}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/mock"
 	"github.com/qlcchain/go-qlc/mock/mocks"
 )
@@ -396,3 +397,29 @@ func TestDebugApi_UncheckBlocks(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDebugApi_SetLogLevel(t *testing.T) {
+	debugApi := &DebugApi{logger: log.NewLogger("api_debug")}
+	module := "test_" + uuid.New().String()
+	logger := log.NewLogger(module)
+	if err := debugApi.SetLogLevel("", ""); err == nil {
+		t.Fatal("empty module and level should return error")
+	}
+	if err := debugApi.SetLogLevel(module, "verbose"); err == nil {
+		t.Fatal("invalid level should return error")
+	}
+	if err := debugApi.SetLogLevel(module, "debug"); err != nil {
+		t.Fatal(err)
+	}
+	r := debugApi.GetLogLevels()
+	if r.Modules[module] != "debug" {
+		t.Fatal(r.Modules)
+	}
+	logger.Debug("debug log enabled")
+	if err := debugApi.SetLogLevel(module, ""); err != nil {
+		t.Fatal(err)
+	}
+	if r := debugApi.GetLogLevels(); r.Modules[module] != r.Level {
+		t.Fatal(r)
+	}
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"go.uber.org/zap"
+
+	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
+)
+
+const eventLagged = "lagged"
+
+// APIEvent is notified by subscriptions created with a cursor, type is block, povBlock, rollback or lagged.
+// A lagged event means the subscription is closed, and should be resubscribed from the cursor
+type APIEvent struct {
+	Cursor uint64      `json:"cursor"`
+	Type   string      `json:"type"`
+	Hash   types.Hash  `json:"hash"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+type eventLogService interface {
+	EventLog() *eventlog.EventLog
+}
+
+func getEventLog(cc *chainctx.ChainContext) (*eventlog.EventLog, error) {
+	sv, err := cc.Service(chainctx.EventLogService)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := sv.(eventLogService); ok && s.EventLog() != nil {
+		return s.EventLog(), nil
+	}
+	return nil, errors.New("event log is not available")
+}
+
+// createCursorSubscription replays events of the kind after the cursor and then notifies live ones,
+// fn converts an event to the notified data, events with nil data are skipped.
+// Rollback events are always notified to block subscriptions
+func createCursorSubscription(ctx context.Context, cc *chainctx.ChainContext, cursor uint64, kind eventlog.Kind,
+	logger *zap.SugaredLogger, fn func(e *eventlog.Event) (interface{}, error)) (*rpc.Subscription, error) {
+	if _, supported := rpc.NotifierFromContext(ctx); !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	el, err := getEventLog(cc)
+	if err != nil {
+		return nil, err
+	}
+	es, err := el.Subscribe(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
+		go func() {
+			defer es.Unsubscribe()
+			last := cursor
+			for {
+				select {
+				case e, ok := <-es.Events():
+					if !ok {
+						logger.Infof("event subscription %s closed: %s", subscription.ID, es.Err())
+						if err := notifier.Notify(subscription.ID, &APIEvent{Cursor: last, Type: eventLagged}); err != nil {
+							logger.Errorf("notify error: %s", err)
+						}
+						return
+					}
+					last = e.Seq
+					var data interface{}
+					if e.Kind == eventlog.KindRollback {
+						if kind != eventlog.KindBlock {
+							continue
+						}
+					} else {
+						if e.Kind != kind {
+							continue
+						}
+						if data, err = fn(e); err != nil {
+							logger.Errorf("event %d: %s", e.Seq, err)
+							continue
+						}
+						if data == nil {
+							continue
+						}
+					}
+					if err := notifier.Notify(subscription.ID, &APIEvent{Cursor: e.Seq, Type: e.Kind.String(), Hash: e.Hash, Data: data}); err != nil {
+						logger.Errorf("notify error: %s", err)
+						return
+					}
+				case err := <-subscription.Err():
+					logger.Infof("subscription exception %s", err)
+					return
+				}
+			}
+		}()
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -19,7 +20,9 @@ import (
 	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
 	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/ledger/relation"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -87,6 +90,17 @@ type APIAccountsBalance struct {
 	Pending types.Balance  `json:"pending"`
 }
 
+type APIBalanceRecord struct {
+	Address   types.Address   `json:"address"`
+	Token     types.Hash      `json:"token"`
+	TokenName string          `json:"tokenName"`
+	Balance   types.Balance   `json:"balance"`
+	Hash      types.Hash      `json:"hash"`
+	Type      types.BlockType `json:"type"`
+	Timestamp int64           `json:"timestamp"`
+	PoVHeight uint64          `json:"povHeight"`
+}
+
 type APITokenMeta struct {
 	*types.TokenMeta
 	TokenName string        `json:"tokenName"`
@@ -255,6 +269,168 @@ func (l *LedgerAPI) AccountsBalance(addresses []types.Address) (map[types.Addres
 	return as, nil
 }
 
+// AccountBalanceAt returns the confirmed balance of the token of the account as of the timestamp and pov height,
+// zero timestamp or pov height means no limit. The token chain is binary searched by block seq, which takes
+// timestamps and pov heights of blocks as not decreasing along the chain
+func (l *LedgerAPI) AccountBalanceAt(address types.Address, token types.Hash, timestamp int64, povHeight uint64) (*APIBalanceRecord, error) {
+	info, err := l.ledger.GetTokenById(token)
+	if err != nil {
+		return nil, err
+	}
+	r := &APIBalanceRecord{
+		Address:   address,
+		Token:     token,
+		TokenName: info.TokenName,
+		Balance:   types.ZeroBalance,
+	}
+	before := func(block *types.StateBlock) bool {
+		return (timestamp <= 0 || block.Timestamp <= timestamp) && (povHeight == 0 || block.PoVHeight <= povHeight)
+	}
+	tm, indexed, err := l.tokenChainMeta(address, token)
+	if err != nil || tm == nil {
+		return r, err
+	}
+	if !indexed {
+		err = l.walkTokenChain(tm, func(block *types.StateBlock) bool {
+			if !before(block) {
+				return true
+			}
+			fillBalanceRecord(r, block)
+			return false
+		})
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	for lo, hi := int64(1), tm.BlockCount; lo <= hi; {
+		seq := lo + (hi-lo)/2
+		block, err := l.tokenBlockBySeq(address, token, uint64(seq))
+		if err != nil {
+			return nil, err
+		}
+		if before(block) {
+			fillBalanceRecord(r, block)
+			lo = seq + 1
+		} else {
+			hi = seq - 1
+		}
+	}
+	return r, nil
+}
+
+// AccountBalanceHistory returns balances of the token of the account after each confirmed block, latest first
+func (l *LedgerAPI) AccountBalanceHistory(address types.Address, token types.Hash, count int, offset *int) ([]*APIBalanceRecord, error) {
+	c, o, err := checkOffset(count, offset)
+	if err != nil {
+		return nil, err
+	}
+	info, err := l.ledger.GetTokenById(token)
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]*APIBalanceRecord, 0)
+	record := func(block *types.StateBlock) {
+		r := &APIBalanceRecord{
+			Address:   address,
+			Token:     token,
+			TokenName: info.TokenName,
+		}
+		fillBalanceRecord(r, block)
+		rs = append(rs, r)
+	}
+	tm, indexed, err := l.tokenChainMeta(address, token)
+	if err != nil || tm == nil {
+		return rs, err
+	}
+	if !indexed {
+		index := 0
+		err = l.walkTokenChain(tm, func(block *types.StateBlock) bool {
+			if index >= o {
+				record(block)
+			}
+			index++
+			return len(rs) < c
+		})
+		if err != nil {
+			return nil, err
+		}
+		return rs, nil
+	}
+	for seq := tm.BlockCount - int64(o); seq > 0 && len(rs) < c; seq-- {
+		block, err := l.tokenBlockBySeq(address, token, uint64(seq))
+		if err != nil {
+			return nil, err
+		}
+		record(block)
+	}
+	return rs, nil
+}
+
+// tokenChainMeta returns the confirmed token meta of the account, nil if the account has not the token,
+// and whether the blocks of the token chain can be looked up by seq. Ledgers migrated from old versions may
+// miss the seq index of a token chain, whose blocks have to be walked from the header then
+func (l *LedgerAPI) tokenChainMeta(address types.Address, token types.Hash) (*types.TokenMeta, bool, error) {
+	tm, err := l.ledger.GetTokenMetaConfirmed(address, token)
+	if err != nil {
+		if err == ledger.ErrAccountNotFound || err == ledger.ErrTokenNotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	for _, s := range []struct {
+		seq  int64
+		hash types.Hash
+	}{{1, tm.OpenBlock}, {tm.BlockCount, tm.Header}} {
+		hash, err := l.ledger.GetTokenBlockHashBySeq(address, token, uint64(s.seq))
+		if err != nil {
+			if err == ledger.ErrBlockNotFound {
+				return tm, false, nil
+			}
+			return nil, false, err
+		}
+		if hash != s.hash {
+			return tm, false, nil
+		}
+	}
+	return tm, true, nil
+}
+
+func (l *LedgerAPI) tokenBlockBySeq(address types.Address, token types.Hash, seq uint64) (*types.StateBlock, error) {
+	hash, err := l.ledger.GetTokenBlockHashBySeq(address, token, seq)
+	if err != nil {
+		return nil, fmt.Errorf("can not get block of seq %d: %s", seq, err)
+	}
+	block, err := l.ledger.GetStateBlockConfirmed(hash)
+	if err != nil {
+		return nil, fmt.Errorf("can not get block %s: %s", hash.String(), err)
+	}
+	return block, nil
+}
+
+// walkTokenChain calls fn with confirmed blocks of the token chain from the header to the open block, until fn returns false
+func (l *LedgerAPI) walkTokenChain(tm *types.TokenMeta, fn func(block *types.StateBlock) bool) error {
+	for hash := tm.Header; !hash.IsZero(); {
+		block, err := l.ledger.GetStateBlockConfirmed(hash)
+		if err != nil {
+			return fmt.Errorf("can not get block %s: %s", hash.String(), err)
+		}
+		if !fn(block) || block.IsOpen() {
+			return nil
+		}
+		hash = block.GetPrevious()
+	}
+	return nil
+}
+
+func fillBalanceRecord(r *APIBalanceRecord, block *types.StateBlock) {
+	r.Balance = block.GetBalance()
+	r.Hash = block.GetHash()
+	r.Type = block.GetType()
+	r.Timestamp = block.GetTimestamp()
+	r.PoVHeight = block.PoVHeight
+}
+
 func (l *LedgerAPI) AccountsFrontiers(addresses []types.Address) (map[types.Address]map[string]types.Hash, error) {
 	r := make(map[types.Address]map[string]types.Hash)
 	for _, addr := range addresses {
@@ -503,6 +679,79 @@ func (l *LedgerAPI) Blocks(count int, offset *int) ([]*APIBlock, error) {
 	return bs, nil
 }
 
+type APIBlockQuery struct {
+	Types          []types.BlockType `json:"types"`
+	Token          types.Hash        `json:"token"`
+	Address        types.Address     `json:"address"`
+	Link           types.Hash        `json:"link"`
+	Representative types.Address     `json:"representative"`
+	MinAmount      *types.Balance    `json:"minAmount"`
+	MaxAmount      *types.Balance    `json:"maxAmount"`
+	StartTime      int64             `json:"startTime"`
+	EndTime        int64             `json:"endTime"`
+	Method         string            `json:"method"`
+	Cursor         string            `json:"cursor"`
+	Count          int               `json:"count"`
+}
+
+type APIBlocksPage struct {
+	Blocks []*APIBlock `json:"blocks"`
+	Cursor string      `json:"cursor"`
+}
+
+// SearchBlocks returns blocks matched the query, latest first, cursor of the result is used to query the next page,
+// an amount range requires the token of the amounts
+func (l *LedgerAPI) SearchBlocks(query *APIBlockQuery) (*APIBlocksPage, error) {
+	if query == nil {
+		return nil, ErrParameterNil
+	}
+	if query.Count < 1 {
+		return nil, errors.New("err count")
+	}
+	hashes, cursor, err := l.ledger.SearchBlocks(&relation.BlockQuery{
+		Types:          query.Types,
+		Token:          query.Token,
+		Address:        query.Address,
+		Link:           query.Link,
+		Representative: query.Representative,
+		MinAmount:      toRelationAmount(query.MinAmount),
+		MaxAmount:      toRelationAmount(query.MaxAmount),
+		StartTime:      query.StartTime,
+		EndTime:        query.EndTime,
+		Method:         query.Method,
+		Cursor:         query.Cursor,
+		Limit:          query.Count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bs := make([]*APIBlock, 0)
+	latestPov, err := l.ledger.GetLatestPovHeader()
+	for _, h := range hashes {
+		block, err := l.ledger.GetStateBlockConfirmed(h)
+		if err != nil {
+			return nil, fmt.Errorf("can not get block %s", h.String())
+		}
+		b, err := GenerateAPIBlock(l.ledger, block, latestPov)
+		if err != nil {
+			return nil, err
+		}
+		bs = append(bs, b)
+	}
+	return &APIBlocksPage{Blocks: bs, Cursor: cursor}, nil
+}
+
+func toRelationAmount(b *types.Balance) int64 {
+	if b == nil || b.Int == nil {
+		return 0
+	}
+	if !b.IsInt64() {
+		return math.MaxInt64
+	}
+	return b.Int64()
+}
+
 // Chain returns a consecutive list of block hashes in the account chain starting at block up to count
 func (l *LedgerAPI) Chain(hash types.Hash, n int) ([]types.Hash, error) {
 	if n < -1 {
@@ -670,6 +919,22 @@ func (l *LedgerAPI) GenerateChangeBlock(account types.Address, representative ty
 	return block, nil
 }
 
+// GenerateUnsignedSendBlock returns the send block without work and signature, so they can be
+// generated offline and submitted by ledger_process, the private key never touches the node
+func (l *LedgerAPI) GenerateUnsignedSendBlock(para *APISendBlockPara) (*types.StateBlock, error) {
+	return l.GenerateSendBlock(para, nil)
+}
+
+// GenerateUnsignedReceiveBlock returns the receive block of the send block without work and signature
+func (l *LedgerAPI) GenerateUnsignedReceiveBlock(sendHash types.Hash) (*types.StateBlock, error) {
+	return l.GenerateReceiveBlockByHash(sendHash, nil)
+}
+
+// GenerateUnsignedChangeBlock returns the change block without work and signature
+func (l *LedgerAPI) GenerateUnsignedChangeBlock(account types.Address, representative types.Address) (*types.StateBlock, error) {
+	return l.GenerateChangeBlock(account, representative, nil)
+}
+
 func (l *LedgerAPI) Pendings() ([]*APIPending, error) {
 	aps := make([]*APIPending, 0)
 	err := l.ledger.GetPendings(func(pendingKey *types.PendingKey, pendingInfo *types.PendingInfo) error {
@@ -926,7 +1191,14 @@ func (l *LedgerAPI) GetAccountOnlineBlock(account types.Address) ([]*types.State
 	}
 }
 
-func (l *LedgerAPI) NewBlock(ctx context.Context) (*rpc.Subscription, error) {
+// NewBlock notifies confirmed blocks, if fromCursor is set, blocks after the cursor are replayed
+// and notified as APIEvent, including rollback events
+func (l *LedgerAPI) NewBlock(ctx context.Context, fromCursor *uint64) (*rpc.Subscription, error) {
+	if fromCursor != nil {
+		return createCursorSubscription(ctx, l.cc, *fromCursor, eventlog.KindBlock, l.logger, func(e *eventlog.Event) (interface{}, error) {
+			return l.generateEventBlock(e.Hash)
+		})
+	}
 	sub, err := createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 		go func() {
 			ch := make(chan struct{})
@@ -969,7 +1241,15 @@ func (l *LedgerAPI) NewBlock(ctx context.Context) (*rpc.Subscription, error) {
 	return sub, nil
 }
 
-func (l *LedgerAPI) NewAccountBlock(ctx context.Context, address types.Address) (*rpc.Subscription, error) {
+func (l *LedgerAPI) NewAccountBlock(ctx context.Context, address types.Address, fromCursor *uint64) (*rpc.Subscription, error) {
+	if fromCursor != nil {
+		return createCursorSubscription(ctx, l.cc, *fromCursor, eventlog.KindBlock, l.logger, func(e *eventlog.Event) (interface{}, error) {
+			if e.Address != address {
+				return nil, nil
+			}
+			return l.generateEventBlock(e.Hash)
+		})
+	}
 	sub, err := createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 		go func() {
 			ch := make(chan struct{})
@@ -1012,7 +1292,17 @@ func (l *LedgerAPI) NewAccountBlock(ctx context.Context, address types.Address)
 	return sub, nil
 }
 
-func (l *LedgerAPI) BalanceChange(ctx context.Context, address types.Address) (*rpc.Subscription, error) {
+// BalanceChange notifies account meta of the address, replayed events carry the balances of the replayed block
+// with only its token and without pending amount
+func (l *LedgerAPI) BalanceChange(ctx context.Context, address types.Address, fromCursor *uint64) (*rpc.Subscription, error) {
+	if fromCursor != nil {
+		return createCursorSubscription(ctx, l.cc, *fromCursor, eventlog.KindBlock, l.logger, func(e *eventlog.Event) (interface{}, error) {
+			if e.Address != address {
+				return nil, nil
+			}
+			return l.generateEventAccount(e.Hash)
+		})
+	}
 	return createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 		go func() {
 			ch := make(chan struct{})
@@ -1052,7 +1342,20 @@ func (l *LedgerAPI) BalanceChange(ctx context.Context, address types.Address) (*
 	})
 }
 
-func (l *LedgerAPI) NewPending(ctx context.Context, address types.Address) (*rpc.Subscription, error) {
+func (l *LedgerAPI) NewPending(ctx context.Context, address types.Address, fromCursor *uint64) (*rpc.Subscription, error) {
+	if fromCursor != nil {
+		return createCursorSubscription(ctx, l.cc, *fromCursor, eventlog.KindBlock, l.logger, func(e *eventlog.Event) (interface{}, error) {
+			block, err := l.ledger.GetStateBlockConfirmed(e.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("get block info: %s", err)
+			}
+			ap, err := l.generatePending(address, block)
+			if ap == nil {
+				return nil, err
+			}
+			return ap, nil
+		})
+	}
 	return createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 		go func() {
 			ch := make(chan struct{})
@@ -1068,39 +1371,17 @@ func (l *LedgerAPI) NewPending(ctx context.Context, address types.Address) (*rpc
 					}
 
 					for _, block := range blocks {
-						if block.IsSendBlock() {
-							if block.Type == types.Send && block.GetLink() != types.Hash(address) {
-								continue
-							}
-							pk := &types.PendingKey{
-								Address: address,
-								Hash:    block.GetHash(),
-							}
-							if pi, _ := l.ledger.GetPending(pk); pi != nil {
-								token, err := l.ledger.GetTokenById(pi.Type)
-								if err != nil {
-									l.logger.Errorf("get token info: %s", err)
-									return
-								}
-
-								blk, err := l.ledger.GetStateBlockConfirmed(pk.Hash)
-								if err != nil {
-									l.logger.Errorf("get block info: %s", err)
-									return
-								}
-
-								ap := APIPending{
-									PendingKey:  pk,
-									PendingInfo: pi,
-									TokenName:   token.TokenName,
-									Timestamp:   blk.Timestamp,
-									BlockType:   blk.GetType(),
-								}
-								if err := notifier.Notify(subscription.ID, ap); err != nil {
-									l.logger.Errorf("notify error: %s", err)
-									return
-								}
-							}
+						ap, err := l.generatePending(address, block)
+						if err != nil {
+							l.logger.Error(err)
+							return
+						}
+						if ap == nil {
+							continue
+						}
+						if err := notifier.Notify(subscription.ID, ap); err != nil {
+							l.logger.Errorf("notify error: %s", err)
+							return
 						}
 					}
 				case err := <-subscription.Err():
@@ -1112,6 +1393,93 @@ func (l *LedgerAPI) NewPending(ctx context.Context, address types.Address) (*rpc
 	})
 }
 
+// generatePending returns the pending of the address created by the block, nil if there is none
+func (l *LedgerAPI) generatePending(address types.Address, block *types.StateBlock) (*APIPending, error) {
+	if !block.IsSendBlock() {
+		return nil, nil
+	}
+	if block.Type == types.Send && block.GetLink() != types.Hash(address) {
+		return nil, nil
+	}
+	pk := &types.PendingKey{
+		Address: address,
+		Hash:    block.GetHash(),
+	}
+	pi, _ := l.ledger.GetPending(pk)
+	if pi == nil {
+		return nil, nil
+	}
+	token, err := l.ledger.GetTokenById(pi.Type)
+	if err != nil {
+		return nil, fmt.Errorf("get token info: %s", err)
+	}
+	blk, err := l.ledger.GetStateBlockConfirmed(pk.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("get block info: %s", err)
+	}
+	return &APIPending{
+		PendingKey:  pk,
+		PendingInfo: pi,
+		TokenName:   token.TokenName,
+		Timestamp:   blk.Timestamp,
+		BlockType:   blk.GetType(),
+	}, nil
+}
+
+func (l *LedgerAPI) generateEventBlock(hash types.Hash) (*APIBlock, error) {
+	block, err := l.ledger.GetStateBlockConfirmed(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get block info: %s", err)
+	}
+	latestPov, _ := l.ledger.GetLatestPovHeader()
+	return GenerateAPIBlock(l.ledger, block, latestPov)
+}
+
+// generateEventAccount generates the account of the block by the token meta after the block
+func (l *LedgerAPI) generateEventAccount(hash types.Hash) (*APIAccount, error) {
+	block, err := l.ledger.GetStateBlockConfirmed(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get block info: %s", err)
+	}
+	tm, err := l.ledger.GetTokenMetaByBlockHash(hash)
+	if err != nil {
+		tm = &types.TokenMeta{
+			Type:           block.Token,
+			Header:         hash,
+			Representative: block.Representative,
+			Balance:        block.Balance,
+			Modified:       block.Timestamp,
+			BelongTo:       block.Address,
+		}
+	}
+	info, err := l.ledger.GetTokenById(block.Token)
+	if err != nil {
+		return nil, err
+	}
+	aa := &APIAccount{
+		Address: block.Address,
+		Tokens:  []*APITokenMeta{{TokenMeta: tm, TokenName: info.TokenName, Pending: types.ZeroBalance}},
+	}
+	if block.Token == config.ChainToken() {
+		aa.CoinBalance = &block.Balance
+		aa.Representative = &block.Representative
+		aa.CoinVote = &block.Vote
+		aa.CoinNetwork = &block.Network
+		aa.CoinOracle = &block.Oracle
+		aa.CoinStorage = &block.Storage
+	}
+	return aa, nil
+}
+
+// EventCursor returns the cursor of the latest event, which can be used as fromCursor of subscriptions
+func (l *LedgerAPI) EventCursor() (uint64, error) {
+	el, err := getEventLog(l.cc)
+	if err != nil {
+		return 0, err
+	}
+	return el.Latest(), nil
+}
+
 func (l *LedgerAPI) GenesisAddress() types.Address {
 	return config.GenesisAddress()
 }
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/relation"
+	"github.com/qlcchain/go-qlc/vm/abi"
+	"github.com/qlcchain/go-qlc/vm/contract"
+)
+
+const exportPageSize = 100
+
+// APIHistoryRecord is a block of account history, with amount decoded by token decimals and contract data
+// decoded by the abi of chain contract
+type APIHistoryRecord struct {
+	Hash      types.Hash      `json:"hash"`
+	Type      types.BlockType `json:"type"`
+	Timestamp int64           `json:"timestamp"`
+	Token     types.Hash      `json:"token"`
+	TokenName string          `json:"tokenName"`
+	Decimals  uint8           `json:"decimals"`
+	// in, out or empty if the balance is not changed
+	Direction string        `json:"direction"`
+	Amount    types.Balance `json:"amount"`
+	Value     string        `json:"value"`
+	// receiver of send, sender of receive and contract of contract send
+	Counterparty types.Address          `json:"counterparty"`
+	Method       string                 `json:"method,omitempty"`
+	Params       map[string]interface{} `json:"params,omitempty"`
+}
+
+// APIHistoryExport is notified by the export subscription, the last one is marked done with count of records
+type APIHistoryExport struct {
+	Record *APIHistoryRecord `json:"record,omitempty"`
+	Done   bool              `json:"done"`
+	Count  int               `json:"count"`
+	Error  string            `json:"error,omitempty"`
+}
+
+// ExportAccountHistory notifies decoded blocks of the account between startTime and endTime (unix seconds,
+// 0 is unlimited), latest first, the subscription can be unsubscribed after the notification marked done
+func (l *LedgerAPI) ExportAccountHistory(ctx context.Context, address types.Address, startTime int64, endTime int64) (*rpc.Subscription, error) {
+	if address.IsZero() {
+		return nil, ErrParameterNil
+	}
+	if startTime < 0 || endTime < 0 || (endTime > 0 && endTime < startTime) {
+		return nil, errors.New("invalid time range")
+	}
+	return createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
+		go func() {
+			count, err := l.exportAccountHistory(address, startTime, endTime, func(r *APIHistoryRecord) error {
+				select {
+				case err := <-subscription.Err():
+					return fmt.Errorf("subscription closed, %v", err)
+				default:
+				}
+				return notifier.Notify(subscription.ID, &APIHistoryExport{Record: r})
+			})
+			done := &APIHistoryExport{Done: true, Count: count}
+			if err != nil {
+				l.logger.Errorf("export history of %s: %s", address, err)
+				done.Error = err.Error()
+			}
+			if err := notifier.Notify(subscription.ID, done); err != nil {
+				l.logger.Errorf("notify error: %s", err)
+			}
+		}()
+	})
+}
+
+func (l *LedgerAPI) exportAccountHistory(address types.Address, startTime, endTime int64, fn func(r *APIHistoryRecord) error) (int, error) {
+	d := newHistoryDecoder(l.ledger)
+	query := &relation.BlockQuery{Address: address, StartTime: startTime, EndTime: endTime, Limit: exportPageSize}
+	count := 0
+	for {
+		hashes, cursor, err := l.ledger.SearchBlocks(query)
+		if err != nil {
+			return count, err
+		}
+		for _, h := range hashes {
+			block, err := l.ledger.GetStateBlockConfirmed(h)
+			if err != nil {
+				return count, fmt.Errorf("can not get block %s", h.String())
+			}
+			r, err := d.record(block)
+			if err != nil {
+				return count, err
+			}
+			if err := fn(r); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if cursor == "" {
+			return count, nil
+		}
+		query.Cursor = cursor
+	}
+}
+
+// historyDecoder caches token infos and contract abis used by blocks of the history
+type historyDecoder struct {
+	l      ledger.Store
+	tokens map[types.Hash]*types.TokenInfo
+	abis   map[types.Address]*abi.ABIContract
+}
+
+func newHistoryDecoder(l ledger.Store) *historyDecoder {
+	return &historyDecoder{
+		l:      l,
+		tokens: make(map[types.Hash]*types.TokenInfo),
+		abis:   make(map[types.Address]*abi.ABIContract),
+	}
+}
+
+func (d *historyDecoder) record(block *types.StateBlock) (*APIHistoryRecord, error) {
+	amount, err := d.l.CalculateAmount(block)
+	if err != nil {
+		return nil, fmt.Errorf("calculate amount of %s: %s", block.GetHash(), err)
+	}
+	r := &APIHistoryRecord{
+		Hash:      block.GetHash(),
+		Type:      block.GetType(),
+		Timestamp: block.GetTimestamp(),
+		Token:     block.GetToken(),
+		Amount:    amount,
+	}
+	if token, err := d.token(block.GetToken()); err == nil {
+		r.TokenName = token.TokenName
+		r.Decimals = token.Decimals
+	}
+	r.Value = common.RawToDecimal(amount, r.Decimals)
+
+	switch block.GetType() {
+	case types.Send:
+		r.Direction = "out"
+		r.Counterparty = types.Address(block.GetLink())
+	case types.ContractSend:
+		r.Direction = "out"
+		r.Counterparty = types.Address(block.GetLink())
+		r.Method, r.Params = d.decodeData(r.Counterparty, block.GetData())
+	case types.Open, types.Receive, types.ContractReward:
+		r.Direction = "in"
+		if send, err := d.l.GetStateBlockConfirmed(block.GetLink()); err == nil {
+			r.Counterparty = send.GetAddress()
+			if block.GetType() == types.ContractReward {
+				r.Method, _ = d.decodeData(types.Address(send.GetLink()), send.GetData())
+			}
+		}
+	}
+	if amount.Int == nil || amount.Sign() == 0 {
+		r.Direction = ""
+	}
+	return r, nil
+}
+
+func (d *historyDecoder) token(id types.Hash) (*types.TokenInfo, error) {
+	if t, ok := d.tokens[id]; ok {
+		return t, nil
+	}
+	t, err := d.l.GetTokenById(id)
+	if err != nil {
+		return nil, err
+	}
+	d.tokens[id] = t
+	return t, nil
+}
+
+// decodeData returns the method name and params of chain contract data, params are empty if they can not be unpacked
+func (d *historyDecoder) decodeData(addr types.Address, data []byte) (string, map[string]interface{}) {
+	if len(data) < 4 || !contract.IsChainContract(addr) {
+		return "", nil
+	}
+	name, _, err := contract.GetChainContractName(addr, data[:4])
+	if err != nil || name == "" {
+		return "", nil
+	}
+
+	ca, ok := d.abis[addr]
+	if !ok {
+		s, err := contract.GetAbiByContractAddress(addr)
+		if err != nil {
+			return name, nil
+		}
+		c, err := abi.JSONToABIContract(strings.NewReader(s))
+		if err != nil {
+			return name, nil
+		}
+		ca = &c
+		d.abis[addr] = ca
+	}
+	method, ok := ca.Methods[name]
+	if !ok {
+		return name, nil
+	}
+	values, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil || len(values) != len(method.Inputs) {
+		return name, nil
+	}
+	params := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		if b, ok := v.([]byte); ok {
+			v = hex.EncodeToString(b)
+		}
+		params[method.Inputs[i].Name] = v
+	}
+	return name, params
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func TestLedgerAPI_ExportAccountHistory(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	time.Sleep(1 * time.Second)
+
+	if _, err := ledgerApi.ExportAccountHistory(rpc.SubscriptionContextRandom(), types.ZeroAddress, 0, 0); err == nil {
+		t.Fatal("zero address should fail")
+	}
+	if _, err := ledgerApi.ExportAccountHistory(rpc.SubscriptionContextRandom(), account1.Address(), 10, 5); err == nil {
+		t.Fatal("invalid time range should fail")
+	}
+	if r, err := ledgerApi.ExportAccountHistory(rpc.SubscriptionContextRandom(), account1.Address(), 0, 0); err != nil || r == nil {
+		t.Fatal(err)
+	}
+
+	var records []*APIHistoryRecord
+	count, err := ledgerApi.exportAccountHistory(account1.Address(), 0, 0, func(r *APIHistoryRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 || len(records) != 4 {
+		t.Fatal("invalid records", count, len(records))
+	}
+	for _, r := range records {
+		if r.Value == "" || r.TokenName == "" {
+			t.Fatal("invalid record", r)
+		}
+		switch r.Type {
+		case types.Send:
+			if r.Direction != "out" || r.Counterparty.IsZero() {
+				t.Fatal("invalid send record", r)
+			}
+		case types.Open, types.Receive:
+			if r.Direction != "in" {
+				t.Fatal("invalid receive record", r)
+			}
+		}
+		t.Log(r.Type, r.Direction, r.TokenName, r.Value, r.Counterparty)
+	}
+
+	count, err = ledgerApi.exportAccountHistory(account1.Address(), 0, 0, func(r *APIHistoryRecord) error {
+		return errors.New("closed")
+	})
+	if err == nil || count != 0 {
+		t.Fatal("export should be stopped", count, err)
+	}
+}
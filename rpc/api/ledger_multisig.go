@@ -0,0 +1,223 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+const (
+	// multiSigProposalExpiry is the time a proposal is kept for the owners to sign it
+	multiSigProposalExpiry = 72 * time.Hour
+	// maxMultiSigProposals is the max count of unexpired proposals kept by the node
+	maxMultiSigProposals = 1024
+	// maxMultiSigAccountProposals is the max count of unexpired proposals of a multi-signature account
+	maxMultiSigAccountProposals = 16
+)
+
+var (
+	ErrMultiSigProposalsFull = errors.New("too many multisig proposals")
+	ErrInvalidWork           = errors.New("invalid work of the block")
+)
+
+// APIMultiSigProposal is a block of a multi-signature account and its signing progress
+type APIMultiSigProposal struct {
+	Hash      types.Hash        `json:"hash"`
+	Block     *types.StateBlock `json:"block"`
+	Threshold uint8             `json:"threshold"`
+	Signed    int               `json:"signed"`
+	Completed bool              `json:"completed"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+func toAPIMultiSigProposal(p *types.MultiSigProposal) *APIMultiSigProposal {
+	hash := p.Block.GetHash()
+	signed := p.Block.MultiSig.Signed(hash)
+	return &APIMultiSigProposal{
+		Hash:      hash,
+		Block:     p.Block,
+		Threshold: p.Block.MultiSig.Threshold,
+		Signed:    signed,
+		Completed: signed >= int(p.Block.MultiSig.Threshold),
+		Timestamp: p.Timestamp,
+	}
+}
+
+// MultiSigAddress returns the address of the multi-signature account of the owners and threshold
+func (l *LedgerAPI) MultiSigAddress(threshold uint8, owners []types.Address) (types.Address, error) {
+	return types.MultiSigAddress(threshold, owners)
+}
+
+// ProposeMultiSig saves the unsigned block of the multi-signature account, so the owners can sign it
+// by ledger_signMultiSig, the block is generated by ledger_generateUnsigned* with the account address,
+// and its work is generated by the proposer.
+// A block already proposed is rejected, so the signatures collected are kept, and the proposal expires
+// if it is not processed in time.
+func (l *LedgerAPI) ProposeMultiSig(block *types.StateBlock, threshold uint8, owners []types.Address) (*APIMultiSigProposal, error) {
+	if block == nil {
+		return nil, ErrParameterNil
+	}
+	m, err := types.NewMultiSig(threshold, owners)
+	if err != nil {
+		return nil, err
+	}
+	if m.Address() != block.Address {
+		return nil, fmt.Errorf("block address %s is not the multisig address %s", block.Address, m.Address())
+	}
+	if !block.IsValid() {
+		return nil, ErrInvalidWork
+	}
+	block.Signature = types.ZeroSignature
+	block.MultiSig = m
+	if _, err := l.getMultiSigProposal(block.GetHash()); err == nil {
+		return nil, ledger.ErrMultiSigProposalExists
+	} else if err != ledger.ErrMultiSigProposalNotFound {
+		return nil, err
+	}
+	if err := l.checkMultiSigProposals(block.Address); err != nil {
+		return nil, err
+	}
+	p := &types.MultiSigProposal{
+		Block:     block,
+		Timestamp: common.TimeNow().Unix(),
+	}
+	if err := l.ledger.AddOrUpdateMultiSigProposal(p); err != nil {
+		return nil, err
+	}
+	return toAPIMultiSigProposal(p), nil
+}
+
+// checkMultiSigProposals removes the expired proposals, and checks a new proposal of the address is under the limits
+func (l *LedgerAPI) checkMultiSigProposals(address types.Address) error {
+	expired := make([]types.Hash, 0)
+	total, count := 0, 0
+	err := l.ledger.GetMultiSigProposals(func(p *types.MultiSigProposal) error {
+		if isMultiSigProposalExpired(p) {
+			expired = append(expired, p.Block.GetHash())
+			return nil
+		}
+		total++
+		if p.Block.Address == address {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, h := range expired {
+		if err := l.ledger.DeleteMultiSigProposal(h); err != nil {
+			l.logger.Errorf("delete expired multisig proposal %s: %s", h, err)
+		}
+	}
+	if total >= maxMultiSigProposals || count >= maxMultiSigAccountProposals {
+		return ErrMultiSigProposalsFull
+	}
+	return nil
+}
+
+// getMultiSigProposal returns the proposal, an expired proposal is removed and not found
+func (l *LedgerAPI) getMultiSigProposal(hash types.Hash) (*types.MultiSigProposal, error) {
+	p, err := l.ledger.GetMultiSigProposal(hash)
+	if err != nil {
+		return nil, err
+	}
+	if isMultiSigProposalExpired(p) {
+		if err := l.ledger.DeleteMultiSigProposal(hash); err != nil {
+			l.logger.Errorf("delete expired multisig proposal %s: %s", hash, err)
+		}
+		return nil, ledger.ErrMultiSigProposalNotFound
+	}
+	return p, nil
+}
+
+func isMultiSigProposalExpired(p *types.MultiSigProposal) bool {
+	return common.TimeNow().Sub(time.Unix(p.Timestamp, 0)) > multiSigProposalExpiry
+}
+
+// SignMultiSig adds the signature of the owner to the proposal, the signature is made offline by the owner,
+// so the private key never touches the node
+func (l *LedgerAPI) SignMultiSig(hash types.Hash, owner types.Address, signature types.Signature) (*APIMultiSigProposal, error) {
+	p, err := l.getMultiSigProposal(hash)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Block.MultiSig.AddSignature(owner, hash, signature); err != nil {
+		return nil, err
+	}
+	if err := l.ledger.AddOrUpdateMultiSigProposal(p); err != nil {
+		return nil, err
+	}
+	return toAPIMultiSigProposal(p), nil
+}
+
+func (l *LedgerAPI) MultiSigProposal(hash types.Hash) (*APIMultiSigProposal, error) {
+	p, err := l.getMultiSigProposal(hash)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIMultiSigProposal(p), nil
+}
+
+// MultiSigProposals returns the proposals which the owner can sign, or all proposals if owner is nil
+func (l *LedgerAPI) MultiSigProposals(owner *types.Address) ([]*APIMultiSigProposal, error) {
+	r := make([]*APIMultiSigProposal, 0)
+	err := l.ledger.GetMultiSigProposals(func(p *types.MultiSigProposal) error {
+		if isMultiSigProposalExpired(p) {
+			return nil
+		}
+		if owner == nil || p.Block.MultiSig.IsOwner(*owner) {
+			r = append(r, toAPIMultiSigProposal(p))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ProcessMultiSig processes the block of the proposal once enough owners signed it, the proposal is removed
+func (l *LedgerAPI) ProcessMultiSig(hash types.Hash) (types.Hash, error) {
+	p, err := l.getMultiSigProposal(hash)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	if err := p.Block.MultiSig.Verify(p.Block.Address, hash); err != nil {
+		return types.ZeroHash, err
+	}
+	h, err := l.Process(p.Block)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	if err := l.ledger.DeleteMultiSigProposal(hash); err != nil {
+		l.logger.Errorf("delete multisig proposal %s: %s", hash, err)
+	}
+	return h, nil
+}
+
+// CancelMultiSig removes the proposal, it must be signed by an owner of the account, the signature is of
+// the hash returned by ledger_multiSigCancelHash rather than the block hash
+func (l *LedgerAPI) CancelMultiSig(hash types.Hash, owner types.Address, signature types.Signature) error {
+	p, err := l.getMultiSigProposal(hash)
+	if err != nil {
+		return err
+	}
+	if !p.Block.MultiSig.IsOwner(owner) {
+		return types.ErrMultiSigNotOwner
+	}
+	cancelHash := types.MultiSigCancelHash(hash)
+	if !owner.Verify(cancelHash[:], signature[:]) {
+		return fmt.Errorf("invalid cancel signature of owner %s", owner)
+	}
+	return l.ledger.DeleteMultiSigProposal(hash)
+}
+
+// MultiSigCancelHash returns the hash an owner signs to cancel the proposal of the block hash
+func (l *LedgerAPI) MultiSigCancelHash(hash types.Hash) types.Hash {
+	return types.MultiSigCancelHash(hash)
+}
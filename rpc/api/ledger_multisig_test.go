@@ -0,0 +1,202 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestLedgerAPI_MultiSig(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	ac1 := initAccount(l, t)
+	owners := []*types.Account{mock.Account(), mock.Account(), mock.Account()}
+	ownerAddrs := []types.Address{owners[0].Address(), owners[1].Address(), owners[2].Address()}
+	msAddr, err := ledgerApi.MultiSigAddress(2, ownerAddrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// fund the multisig account
+	amount := types.Balance{Int: big.NewInt(int64(100000))}
+	sendBlk, err := ledgerApi.GenerateUnsignedSendBlock(&APISendBlockPara{
+		From:      ac1.Address(),
+		TokenName: "QLC",
+		To:        msAddr,
+		Amount:    amount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	setTestWork(sendBlk)
+	sendBlk.Signature = ac1.Sign(sendBlk.GetHash())
+	if _, err := ledgerApi.Process(sendBlk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddPending(&types.PendingKey{Address: msAddr, Hash: sendBlk.GetHash()}, &types.PendingInfo{
+		Source: ac1.Address(),
+		Type:   config.ChainToken(),
+		Amount: amount,
+	}, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+
+	openBlk, err := ledgerApi.GenerateUnsignedReceiveBlock(sendBlk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.ProposeMultiSig(openBlk, 2, ownerAddrs); err != ErrInvalidWork {
+		t.Fatal("expect invalid work, got", err)
+	}
+	setTestWork(openBlk)
+	if _, err := ledgerApi.ProposeMultiSig(openBlk, 3, ownerAddrs); err == nil {
+		t.Fatal("threshold does not match the address")
+	}
+	p, err := ledgerApi.ProposeMultiSig(openBlk, 2, ownerAddrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := p.Hash
+	if p.Signed != 0 || p.Completed {
+		t.Fatal("invalid proposal", p)
+	}
+	if ps, err := ledgerApi.MultiSigProposals(&ownerAddrs[1]); err != nil || len(ps) != 1 {
+		t.Fatal(err, ps)
+	}
+	ac1Addr := ac1.Address()
+	if ps, err := ledgerApi.MultiSigProposals(&ac1Addr); err != nil || len(ps) != 0 {
+		t.Fatal(err, ps)
+	}
+
+	// the block is signed by only one owner
+	if _, err := ledgerApi.SignMultiSig(hash, ownerAddrs[0], owners[0].Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.ProcessMultiSig(hash); err != types.ErrMultiSigSignatures {
+		t.Fatal(err)
+	}
+	// proposing the block again does not drop the signatures collected
+	if _, err := ledgerApi.ProposeMultiSig(openBlk, 2, ownerAddrs); err != ledger.ErrMultiSigProposalExists {
+		t.Fatal(err)
+	}
+	if p, err := ledgerApi.MultiSigProposal(hash); err != nil || p.Signed != 1 {
+		t.Fatal("signatures should be kept", err, p)
+	}
+	// the proposal can only be canceled by an owner signing the cancel hash
+	if err := ledgerApi.CancelMultiSig(hash, ownerAddrs[0], owners[0].Sign(hash)); err == nil {
+		t.Fatal("signature of block hash should not cancel the proposal")
+	}
+	cancelHash := ledgerApi.MultiSigCancelHash(hash)
+	if err := ledgerApi.CancelMultiSig(hash, ac1.Address(), ac1.Sign(cancelHash)); err != types.ErrMultiSigNotOwner {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.SignMultiSig(hash, ac1.Address(), ac1.Sign(hash)); err != types.ErrMultiSigNotOwner {
+		t.Fatal(err)
+	}
+	p, err = ledgerApi.SignMultiSig(hash, ownerAddrs[2], owners[2].Sign(hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Signed != 2 || !p.Completed {
+		t.Fatal("invalid proposal", p)
+	}
+
+	// blocks of multisig accounts are rejected before the fork
+	if _, err := ledgerApi.ProcessMultiSig(hash); err == nil {
+		t.Fatal("multisig block should be rejected before the fork")
+	}
+	forkHeight := common.PovMultiSigForkHeight
+	common.PovMultiSigForkHeight = p.Block.PoVHeight
+	defer func() {
+		common.PovMultiSigForkHeight = forkHeight
+	}()
+
+	if h, err := ledgerApi.ProcessMultiSig(hash); err != nil || h != hash {
+		t.Fatal(err, h)
+	}
+	if _, err := ledgerApi.MultiSigProposal(hash); err != ledger.ErrMultiSigProposalNotFound {
+		t.Fatal(err)
+	}
+	blk, err := l.GetStateBlock(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk.MultiSig == nil || blk.MultiSig.Verify(msAddr, hash) != nil {
+		t.Fatal("multisig should be saved with the block")
+	}
+
+	// a block of multisig account signed by a single key is rejected
+	changeBlk, err := ledgerApi.GenerateUnsignedChangeBlock(ac1.Address(), ac1.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	setTestWork(changeBlk)
+	m, _ := types.NewMultiSig(1, []types.Address{ownerAddrs[0]})
+	changeBlk.MultiSig = m
+	_ = m.AddSignature(ownerAddrs[0], changeBlk.GetHash(), owners[0].Sign(changeBlk.GetHash()))
+	changeBlk.Signature = ac1.Sign(changeBlk.GetHash())
+	if _, err := ledgerApi.Process(changeBlk); err == nil {
+		t.Fatal("multisig does not match the address")
+	}
+
+	// expired proposals are removed
+	changeBlk.MultiSig = nil
+	changeBlk.Signature = types.ZeroSignature
+	if err := l.AddOrUpdateMultiSigProposal(&types.MultiSigProposal{
+		Block:     changeBlk,
+		Timestamp: time.Now().Add(-multiSigProposalExpiry - time.Hour).Unix(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.MultiSigProposal(changeBlk.GetHash()); err != ledger.ErrMultiSigProposalNotFound {
+		t.Fatal(err)
+	}
+}
+
+func TestLedgerAPI_CancelMultiSig(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	owners := []*types.Account{mock.Account(), mock.Account()}
+	ownerAddrs := []types.Address{owners[0].Address(), owners[1].Address()}
+	m, err := types.NewMultiSig(1, ownerAddrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < maxMultiSigAccountProposals; i++ {
+		blk := mock.StateBlockWithoutWork()
+		blk.Address = m.Address()
+		blk.MultiSig = m
+		if err := l.AddOrUpdateMultiSigProposal(&types.MultiSigProposal{Block: blk, Timestamp: time.Now().Unix()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = m.Address()
+	setTestWork(blk)
+	if _, err := ledgerApi.ProposeMultiSig(blk, 1, ownerAddrs); err != ErrMultiSigProposalsFull {
+		t.Fatal(err)
+	}
+
+	ps, err := ledgerApi.MultiSigProposals(nil)
+	if err != nil || len(ps) != maxMultiSigAccountProposals {
+		t.Fatal(err, len(ps))
+	}
+	hash := ps[0].Hash
+	if err := ledgerApi.CancelMultiSig(hash, ownerAddrs[1], owners[1].Sign(types.MultiSigCancelHash(hash))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.MultiSigProposal(hash); err != ledger.ErrMultiSigProposalNotFound {
+		t.Fatal(err)
+	}
+	if _, err := ledgerApi.ProposeMultiSig(blk, 1, ownerAddrs); err != nil {
+		t.Fatal(err)
+	}
+}
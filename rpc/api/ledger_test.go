@@ -17,10 +17,12 @@ import (
 
 	qlcchainctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
 	"github.com/qlcchain/go-qlc/ledger/process"
 	"github.com/qlcchain/go-qlc/mock"
 	"github.com/qlcchain/go-qlc/mock/mocks"
@@ -177,32 +179,32 @@ func TestLedgerApi_Subscription(t *testing.T) {
 		}
 	}()
 
-	r, err := ledgerApi.NewBlock(rpc.SubscriptionContextRandom())
+	r, err := ledgerApi.NewBlock(rpc.SubscriptionContextRandom(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(r.ID)
-	r, err = ledgerApi.NewBlock(rpc.SubscriptionContextRandom())
+	r, err = ledgerApi.NewBlock(rpc.SubscriptionContextRandom(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(r.ID)
-	r, err = ledgerApi.BalanceChange(rpc.SubscriptionContextRandom(), ac1.Address())
+	r, err = ledgerApi.BalanceChange(rpc.SubscriptionContextRandom(), ac1.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(r.ID)
-	r, err = ledgerApi.BalanceChange(rpc.SubscriptionContextRandom(), ac1.Address())
+	r, err = ledgerApi.BalanceChange(rpc.SubscriptionContextRandom(), ac1.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(r.ID)
-	r, err = ledgerApi.NewAccountBlock(rpc.SubscriptionContextRandom(), ac1.Address())
+	r, err = ledgerApi.NewAccountBlock(rpc.SubscriptionContextRandom(), ac1.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(r.ID)
-	r, err = ledgerApi.NewPending(rpc.SubscriptionContextRandom(), ac2.Address())
+	r, err = ledgerApi.NewPending(rpc.SubscriptionContextRandom(), ac2.Address(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -275,6 +277,62 @@ func TestLedgerAPI_AccountHistoryTopn(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_AccountBalanceAt(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	addr := account1.Address()
+	am, err := l.GetAccountMetaConfirmed(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := am.Tokens[0]
+
+	rs, err := ledgerApi.AccountBalanceHistory(addr, tm.Type, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != int(tm.BlockCount) || rs[0].Hash != tm.Header || rs[0].Balance.Compare(tm.Balance) != types.BalanceCompEqual {
+		t.Fatal("invalid balance history", rs)
+	}
+	offset := 1
+	rs2, err := ledgerApi.AccountBalanceHistory(addr, tm.Type, 1, &offset)
+	if err != nil || len(rs2) != 1 || rs2[0].Hash != rs[1].Hash {
+		t.Fatal("invalid balance history page", err)
+	}
+
+	r, err := ledgerApi.AccountBalanceAt(addr, tm.Type, 0, 0)
+	if err != nil || r.Hash != tm.Header {
+		t.Fatal("invalid latest balance", err)
+	}
+	open := rs[len(rs)-1]
+	r, err = ledgerApi.AccountBalanceAt(addr, tm.Type, open.Timestamp, 0)
+	if err != nil || r.Hash != open.Hash || r.Balance.Compare(open.Balance) != types.BalanceCompEqual {
+		t.Fatal("invalid balance at open block", err)
+	}
+	r, err = ledgerApi.AccountBalanceAt(addr, tm.Type, open.Timestamp-1, 0)
+	if err != nil || !r.Hash.IsZero() || !r.Balance.IsZero() {
+		t.Fatal("balance should be zero before open block", err)
+	}
+	r, err = ledgerApi.AccountBalanceAt(mock.Address(), tm.Type, 0, 0)
+	if err != nil || !r.Balance.IsZero() {
+		t.Fatal("balance of unknown account should be zero", err)
+	}
+
+	// without the seq index the token chain is walked from the header
+	k, _ := storage.GetKeyOfParts(storage.KeyPrefixAccountTokenSeq, addr, tm.Type, uint64(1))
+	if err := l.DBStore().Delete(k); err != nil {
+		t.Fatal(err)
+	}
+	rs3, err := ledgerApi.AccountBalanceHistory(addr, tm.Type, 100, nil)
+	if err != nil || len(rs3) != len(rs) || rs3[len(rs3)-1].Hash != open.Hash {
+		t.Fatal("invalid balance history without index", err)
+	}
+	r, err = ledgerApi.AccountBalanceAt(addr, tm.Type, open.Timestamp, 0)
+	if err != nil || r.Hash != open.Hash {
+		t.Fatal("invalid balance at open block without index", err)
+	}
+}
+
 func TestLedgerAPI_AccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -599,6 +657,64 @@ func TestLedgerAPI_Blocks(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_SearchBlocks(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	time.Sleep(1 * time.Second)
+
+	if _, err := ledgerApi.SearchBlocks(nil); err == nil {
+		t.Fatal("nil query should fail")
+	}
+	r, err := ledgerApi.SearchBlocks(&APIBlockQuery{Address: account1.Address(), Count: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Blocks) != 4 || r.Cursor != "" {
+		t.Fatal("invalid blocks", len(r.Blocks), r.Cursor)
+	}
+
+	var blocks []*APIBlock
+	query := &APIBlockQuery{Address: account1.Address(), Count: 3}
+	for {
+		r, err := ledgerApi.SearchBlocks(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, r.Blocks...)
+		if r.Cursor == "" {
+			break
+		}
+		query.Cursor = r.Cursor
+	}
+	if len(blocks) != 4 {
+		t.Fatal("invalid blocks of pages", len(blocks))
+	}
+
+	r, err = ledgerApi.SearchBlocks(&APIBlockQuery{Address: account1.Address(), Types: []types.BlockType{types.Open}, Count: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range r.Blocks {
+		if b.Type != types.Open {
+			t.Fatal("invalid block type", b.Type)
+		}
+	}
+
+	amount := types.NewBalance(1)
+	if _, err := ledgerApi.SearchBlocks(&APIBlockQuery{MinAmount: &amount, Count: 100}); err == nil {
+		t.Fatal("amount range without token should be invalid")
+	}
+	r, err = ledgerApi.SearchBlocks(&APIBlockQuery{Token: config.ChainToken(), MinAmount: &amount, Count: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range r.Blocks {
+		if b.Token != config.ChainToken() || b.Amount.Compare(amount) == types.BalanceCompSmaller {
+			t.Fatal("invalid block of amount range", b.Token, b.Amount)
+		}
+	}
+}
+
 func TestLedgerAPI_Chain(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -751,6 +867,76 @@ func TestLedgerAPI_Process(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_GenerateUnsignedBlock(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	ac1 := initAccount(l, t)
+	ac2 := mock.Account()
+	amount := types.Balance{Int: big.NewInt(int64(100000))}
+
+	sendBlk, err := ledgerApi.GenerateUnsignedSendBlock(&APISendBlockPara{
+		From:      ac1.Address(),
+		TokenName: "QLC",
+		To:        ac2.Address(),
+		Amount:    amount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sendBlk.Signature.IsZero() || sendBlk.IsValid() {
+		t.Fatal("block should have neither work nor signature")
+	}
+	setTestWork(sendBlk)
+	if _, err := ledgerApi.Process(sendBlk); err == nil {
+		t.Fatal("unsigned block should not be processed")
+	}
+	sendBlk.Signature = ac1.Sign(sendBlk.GetHash())
+	if _, err := ledgerApi.Process(sendBlk); err != nil {
+		t.Fatal(err)
+	}
+
+	pendingKey := &types.PendingKey{
+		Address: ac2.Address(),
+		Hash:    sendBlk.GetHash(),
+	}
+	pendingInfo := &types.PendingInfo{
+		Source: ac1.Address(),
+		Type:   config.ChainToken(),
+		Amount: amount,
+	}
+	if err := l.AddPending(pendingKey, pendingInfo, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	recvBlk, err := ledgerApi.GenerateUnsignedReceiveBlock(sendBlk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recvBlk.Address != ac2.Address() || !recvBlk.Signature.IsZero() || recvBlk.IsValid() {
+		t.Fatal("invalid receive block", recvBlk)
+	}
+	setTestWork(recvBlk)
+	recvBlk.Signature = ac2.Sign(recvBlk.GetHash())
+	if _, err := ledgerApi.Process(recvBlk); err != nil {
+		t.Fatal(err)
+	}
+
+	changeBlk, err := ledgerApi.GenerateUnsignedChangeBlock(ac1.Address(), ac2.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changeBlk.Signature.IsZero() || changeBlk.IsValid() {
+		t.Fatal("block should have neither work nor signature")
+	}
+}
+
+// setTestWork generates the work of the block as the offline signer does
+func setTestWork(block *types.StateBlock) {
+	var w types.Work
+	worker, _ := types.NewWorker(w, block.Root())
+	block.Work = worker.NewWork()
+}
+
 func TestLedgerAPI_Representatives(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -877,7 +1063,7 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	blk1 := mock.StateBlock()
 
 	blkRpcCtx := rpc.SubscriptionContextRandom()
-	blkSub, err := ledgerApi.NewBlock(blkRpcCtx)
+	blkSub, err := ledgerApi.NewBlock(blkRpcCtx, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -886,7 +1072,7 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	}
 
 	pendRpcCtx := rpc.SubscriptionContextRandom()
-	pendSub, err := ledgerApi.NewPending(pendRpcCtx, blk1.GetAddress())
+	pendSub, err := ledgerApi.NewPending(pendRpcCtx, blk1.GetAddress(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -895,7 +1081,7 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	}
 
 	accRpcCtx := rpc.SubscriptionContextRandom()
-	accSub, err := ledgerApi.NewAccountBlock(accRpcCtx, blk1.GetAddress())
+	accSub, err := ledgerApi.NewAccountBlock(accRpcCtx, blk1.GetAddress(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -904,7 +1090,7 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	}
 
 	blRpcCtx := rpc.SubscriptionContextRandom()
-	blSub, err := ledgerApi.BalanceChange(blRpcCtx, blk1.GetAddress())
+	blSub, err := ledgerApi.BalanceChange(blRpcCtx, blk1.GetAddress(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -915,3 +1101,90 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	ledgerApi.blockSubscription.setBlocks(blk1)
 	time.Sleep(10 * time.Millisecond)
 }
+
+type testEventLogService struct {
+	common.ServiceLifecycle
+	el *eventlog.EventLog
+}
+
+func (s *testEventLogService) Init() error  { return nil }
+func (s *testEventLogService) Start() error { return nil }
+func (s *testEventLogService) Stop() error  { return nil }
+func (s *testEventLogService) Status() int32 {
+	return s.State()
+}
+func (s *testEventLogService) EventLog() *eventlog.EventLog {
+	return s.el
+}
+
+func TestLedgerAPI_generateEventAccount(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	var blocks []*types.StateBlock
+	if err := json.Unmarshal([]byte(mock.MockBlocks), &blocks); err != nil {
+		t.Fatal(err)
+	}
+	for _, blk := range blocks {
+		aa, err := ledgerApi.generateEventAccount(blk.GetHash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if aa.Address != blk.Address || len(aa.Tokens) != 1 || aa.Tokens[0].Header != blk.GetHash() ||
+			!aa.Tokens[0].Balance.Equal(blk.Balance) {
+			t.Fatal("account should be generated by the block", aa, blk)
+		}
+		if blk.Token == config.ChainToken() && (aa.CoinBalance == nil || !aa.CoinVote.Equal(blk.Vote)) {
+			t.Fatal("invalid coin balances", aa)
+		}
+	}
+	if _, err := ledgerApi.generateEventAccount(mock.Hash()); err == nil {
+		t.Fatal("block should not exist")
+	}
+}
+
+func TestLedgerAPI_PubSubFromCursor(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	cursor := uint64(0)
+	if _, err := ledgerApi.NewBlock(rpc.SubscriptionContextRandom(), &cursor); err == nil {
+		t.Fatal("event log service is not registered")
+	}
+
+	el, err := eventlog.NewEventLog(l.DBStore(), ledgerApi.eb, eventlog.DefaultRetention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ledgerApi.cc.Register(qlcchainctx.EventLogService, &testEventLogService{el: el}); err != nil {
+		t.Fatal(err)
+	}
+
+	blk := mock.StateBlockWithoutWork()
+	_ = el.Append(&eventlog.Event{Kind: eventlog.KindBlock, Hash: blk.GetHash(), Address: blk.GetAddress()})
+	_ = el.Append(&eventlog.Event{Kind: eventlog.KindRollback, Hash: blk.GetHash()})
+	if c, err := ledgerApi.EventCursor(); err != nil || c != 2 {
+		t.Fatal("invalid event cursor", c, err)
+	}
+
+	if r, err := ledgerApi.NewBlock(rpc.SubscriptionContextRandom(), &cursor); err != nil || r == nil {
+		t.Fatal(err)
+	}
+	if r, err := ledgerApi.NewAccountBlock(rpc.SubscriptionContextRandom(), blk.GetAddress(), &cursor); err != nil || r == nil {
+		t.Fatal(err)
+	}
+	if r, err := ledgerApi.BalanceChange(rpc.SubscriptionContextRandom(), blk.GetAddress(), &cursor); err != nil || r == nil {
+		t.Fatal(err)
+	}
+	if r, err := ledgerApi.NewPending(rpc.SubscriptionContextRandom(), blk.GetAddress(), &cursor); err != nil || r == nil {
+		t.Fatal(err)
+	}
+	invalid := uint64(10)
+	if _, err := ledgerApi.NewBlock(rpc.SubscriptionContextRandom(), &invalid); err != eventlog.ErrInvalidCursor {
+		t.Fatal("expect invalid cursor, got", err)
+	}
+	if _, err := ledgerApi.NewBlock(context.Background(), &cursor); err != rpc.ErrNotificationsUnsupported {
+		t.Fatal("expect notifications unsupported, got", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
 )
 
 type NetApi struct {
@@ -20,6 +23,7 @@ type NetApi struct {
 	eb     event.EventBus
 	logger *zap.SugaredLogger
 	cc     *chainctx.ChainContext
+	feb    *event.FeedEventBus
 }
 
 type OnlineRepTotal struct {
@@ -34,7 +38,7 @@ type OnlineRepInfo struct {
 }
 
 func NewNetApi(l ledger.Store, eb event.EventBus, cc *chainctx.ChainContext) *NetApi {
-	return &NetApi{ledger: l, eb: eb, logger: log.NewLogger("api_net"), cc: cc}
+	return &NetApi{ledger: l, eb: eb, logger: log.NewLogger("api_net"), cc: cc, feb: cc.FeedEventBus()}
 }
 
 func (q *NetApi) OnlineRepresentatives() []types.Address {
@@ -152,3 +156,72 @@ func (q *NetApi) GetPeerId() string {
 	cfg, _ := q.cc.Config()
 	return cfg.P2P.ID.PeerID
 }
+
+// BannedPeers returns peers banned for misbehaving or by operators, with the time they are banned until
+func (q *NetApi) BannedPeers() ([]*types.PeerInfo, error) {
+	pis := make([]*types.PeerInfo, 0)
+	now := time.Now().Unix()
+	err := q.ledger.GetPeersInfo(func(pi *types.PeerInfo) error {
+		if pi.BannedUntil > now {
+			pis = append(pis, pi)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pis, nil
+}
+
+// BanPeer bans the peer for duration seconds and disconnects it, the default duration is used if it is 0.
+// It is only served with rpc auth enabled, the default public role is denied.
+func (q *NetApi) BanPeer(peerID string, duration int64, reason string) error {
+	if err := q.checkAuth(); err != nil {
+		return err
+	}
+	if duration < 0 {
+		return errors.New("invalid duration")
+	}
+	d := time.Duration(duration) * time.Second
+	if duration == 0 {
+		d = p2p.DefaultBanDuration
+	}
+	if reason == "" {
+		reason = "banned by rpc"
+	}
+	return q.rpcSyncCall("Net.BanPeer", map[string]interface{}{"peerId": peerID, "duration": d, "reason": reason})
+}
+
+// UnbanPeer removes the ban of the peer and resets its score
+func (q *NetApi) UnbanPeer(peerID string) error {
+	if err := q.checkAuth(); err != nil {
+		return err
+	}
+	return q.rpcSyncCall("Net.UnbanPeer", map[string]interface{}{"peerId": peerID})
+}
+
+// checkAuth refuses the calls changing peers if they can not be authorized by the rpc auth
+func (q *NetApi) checkAuth() error {
+	cfg, err := q.cc.Config()
+	if err != nil {
+		return err
+	}
+	if cfg.RPC == nil || cfg.RPC.Auth == nil || !cfg.RPC.Auth.Enable {
+		return errors.New("rpc auth is disabled")
+	}
+	return nil
+}
+
+func (q *NetApi) rpcSyncCall(name string, inArgs map[string]interface{}) error {
+	outArgs := make(map[string]interface{})
+	q.feb.RpcSyncCall(&topic.EventRPCSyncCallMsg{Name: name, In: inArgs, Out: outArgs})
+
+	err, ok := outArgs["err"]
+	if !ok {
+		return errors.New("api not support")
+	}
+	if err != nil {
+		return err.(error)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/p2p"
 )
 
 type peersCount struct {
@@ -261,3 +262,64 @@ func TestNetApi_GetPeerId(t *testing.T) {
 		t.Fatal("get peer id error")
 	}
 }
+
+func TestNetApi_BannedPeers(t *testing.T) {
+	teardownTestCase, l, netApi := setupTestCaseNet(t)
+	defer teardownTestCase(t)
+
+	banned := &types.PeerInfo{PeerID: "QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC", BannedUntil: time.Now().Add(time.Hour).Unix()}
+	expired := &types.PeerInfo{PeerID: "QmfMSZSGBaLobW6WKzqaVhXnbVg8kJEaRbWyEfsxi94dMw", BannedUntil: time.Now().Add(-time.Hour).Unix()}
+	for _, pi := range []*types.PeerInfo{banned, expired} {
+		if err := l.AddPeerInfo(pi); err != nil {
+			t.Fatal(err)
+		}
+	}
+	peers, err := netApi.BannedPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 || peers[0].PeerID != banned.PeerID {
+		t.Fatal("invalid banned peers", peers)
+	}
+}
+
+func TestNetApi_BanPeer(t *testing.T) {
+	teardownTestCase, _, netApi := setupTestCaseNet(t)
+	defer teardownTestCase(t)
+
+	if err := netApi.BanPeer("QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC", 0, ""); err == nil {
+		t.Fatal("ban should fail without rpc auth")
+	}
+	if err := netApi.UnbanPeer("QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"); err == nil {
+		t.Fatal("unban should fail without rpc auth")
+	}
+	cfg, _ := netApi.cc.Config()
+	cfg.RPC.Auth.Enable = true
+
+	if err := netApi.BanPeer("QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC", -1, ""); err == nil {
+		t.Fatal("negative duration should fail")
+	}
+
+	// reply the calls as p2p service
+	ch := make(chan *topic.EventRPCSyncCallMsg, 10)
+	sub := netApi.feb.Subscribe(topic.EventRpcSyncCall, ch)
+	defer sub.Unsubscribe()
+	var in map[string]interface{}
+	go func() {
+		for msg := range ch {
+			in = msg.In.(map[string]interface{})
+			msg.Out.(map[string]interface{})["err"] = nil
+			msg.ResponseChan <- msg.Out
+		}
+	}()
+
+	if err := netApi.BanPeer("QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC", 0, ""); err != nil {
+		t.Fatal(err)
+	}
+	if in["duration"].(time.Duration) != p2p.DefaultBanDuration || in["reason"] == "" {
+		t.Fatal("invalid ban args", in)
+	}
+	if err := netApi.UnbanPeer("QmYPq8Cqqfyhaj6pKCiCMVX3KFRMZwi4w6fU6wGLU2T9JC"); err != nil {
+		t.Fatal(err)
+	}
+}
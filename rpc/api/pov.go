@@ -20,6 +20,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/eventlog"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/trie"
 )
@@ -93,6 +94,30 @@ type PovApiContractState struct {
 	AllKVs    [][2]types.HexBytes `json:"allKVs"`
 }
 
+type PovApiTrieProof struct {
+	RootHash types.Hash       `json:"rootHash"`
+	Key      types.HexBytes   `json:"key"`
+	Value    types.HexBytes   `json:"value"`
+	Proof    []types.HexBytes `json:"proof"`
+}
+
+type PovApiAccountStateProof struct {
+	BlockHash    types.Hash             `json:"blockHash"`
+	Height       uint64                 `json:"height"`
+	StateHash    types.Hash             `json:"stateHash"`
+	AccountState *types.PovAccountState `json:"accountState"`
+	StateProof   *PovApiTrieProof       `json:"stateProof"`
+}
+
+type PovApiContractStateProof struct {
+	BlockHash     types.Hash              `json:"blockHash"`
+	Height        uint64                  `json:"height"`
+	StateHash     types.Hash              `json:"stateHash"`
+	ContractState *types.PovContractState `json:"contractState"`
+	StateProof    *PovApiTrieProof        `json:"stateProof"`
+	ValueProof    *PovApiTrieProof        `json:"valueProof"`
+}
+
 type PovApiTxLookup struct {
 	TxHash   types.Hash         `json:"txHash"`
 	TxLookup *types.PovTxLookup `json:"txLookup"`
@@ -492,6 +517,99 @@ func (api *PovApi) GetAccountStateByBlockHeight(address types.Address, height ui
 	return api.GetAccountState(address, header.GetStateHash())
 }
 
+func (api *PovApi) newTrieProof(rootHash types.Hash, key []byte) (*PovApiTrieProof, error) {
+	stateTrie := trie.NewTrie(api.l.DBStore(), &rootHash, nil)
+	proof, err := stateTrie.Prove(key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := trie.VerifyProof(rootHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	apiProof := &PovApiTrieProof{
+		RootHash: rootHash,
+		Key:      key,
+		Value:    value,
+	}
+	for _, p := range proof {
+		apiProof.Proof = append(apiProof.Proof, p)
+	}
+	return apiProof, nil
+}
+
+// GetAccountStateProof returns the account state of address and its merkle proof
+// under the state hash of the pov block, it also proves absence of the account.
+func (api *PovApi) GetAccountStateProof(address types.Address, blockHash types.Hash) (*PovApiAccountStateProof, error) {
+	header, err := api.l.GetPovHeaderByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	stateHash := header.GetStateHash()
+	stateProof, err := api.newTrieProof(stateHash, statedb.PovCreateAccountStateKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	apiProof := &PovApiAccountStateProof{
+		BlockHash:  header.GetHash(),
+		Height:     header.GetHeight(),
+		StateHash:  stateHash,
+		StateProof: stateProof,
+	}
+	if len(stateProof.Value) > 0 {
+		as := types.NewPovAccountState()
+		if err := as.Deserialize(stateProof.Value); err != nil {
+			return nil, fmt.Errorf("deserialize account state err %s", err)
+		}
+		apiProof.AccountState = as
+	}
+
+	return apiProof, nil
+}
+
+// GetContractStateProof returns the contract state of address with its merkle proof
+// under the state hash of the pov block, and the proof of key in the contract trie.
+// It also proves absence of the contract state, whose contract state and value proof are nil.
+func (api *PovApi) GetContractStateProof(address types.Address, key types.HexBytes, blockHash types.Hash) (*PovApiContractStateProof, error) {
+	header, err := api.l.GetPovHeaderByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	stateHash := header.GetStateHash()
+	stateProof, err := api.newTrieProof(stateHash, statedb.PovCreateContractStateKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	apiProof := &PovApiContractStateProof{
+		BlockHash:  header.GetHash(),
+		Height:     header.GetHeight(),
+		StateHash:  stateHash,
+		StateProof: stateProof,
+	}
+	if len(stateProof.Value) == 0 {
+		return apiProof, nil
+	}
+
+	cs := types.NewPovContractState()
+	if err := cs.Deserialize(stateProof.Value); err != nil {
+		return nil, fmt.Errorf("deserialize contract state err %s", err)
+	}
+	apiProof.ContractState = cs
+
+	apiProof.ValueProof, err = api.newTrieProof(cs.StateHash, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiProof, nil
+}
+
 func (api *PovApi) DumpBlockState(blockHash types.Hash) (*PovApiDumpState, error) {
 	block, err := api.l.GetPovBlockByHash(blockHash)
 	if err != nil {
@@ -1240,6 +1358,8 @@ type PovApiGetMiningInfo struct {
 	AlgoName       string `json:"algoName"`
 	AlgoEfficiency uint   `json:"algoEfficiency"`
 	CpuMining      bool   `json:"cpuMining"`
+
+	StratumWorkers []*types.PovStratumWorkerStat `json:"stratumWorkers,omitempty"`
 }
 
 func (api *PovApi) StartMining(minerAddr types.Address, algoName string) error {
@@ -1335,6 +1455,9 @@ func (api *PovApi) GetMiningInfo() (*PovApiGetMiningInfo, error) {
 	}
 	apiRsp.AlgoEfficiency = latestBlock.GetAlgoEfficiency()
 	apiRsp.CpuMining = outArgs["cpuMining"].(bool)
+	if stratumWorkers, ok := outArgs["stratumWorkers"]; ok {
+		apiRsp.StratumWorkers = stratumWorkers.([]*types.PovStratumWorkerStat)
+	}
 
 	return apiRsp, nil
 }
@@ -1692,7 +1815,20 @@ func (api *PovApi) GetRepStatesByHeightAndAccount(header *types.PovHeader, acc t
 	return nil
 }
 
-func (api *PovApi) NewBlock(ctx context.Context) (*rpc.Subscription, error) {
+// NewBlock notifies pov best headers, if fromCursor is set, headers after the cursor are replayed
+// and notified as APIEvent
+func (api *PovApi) NewBlock(ctx context.Context, fromCursor *uint64) (*rpc.Subscription, error) {
+	if fromCursor != nil {
+		return createCursorSubscription(ctx, api.cc, *fromCursor, eventlog.KindPovBlock, api.logger, func(e *eventlog.Event) (interface{}, error) {
+			header, err := api.l.GetPovHeader(e.Height, e.Hash)
+			if err != nil {
+				return nil, err
+			}
+			apiHdr := &PovApiHeader{PovHeader: header}
+			FillHeader(apiHdr)
+			return apiHdr, nil
+		})
+	}
 	return CreatePovSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
 		go func() {
 			notifyCh := make(chan struct{})
@@ -19,6 +19,7 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/trie"
 )
 
 type mockDataTestPovApi struct {
@@ -486,7 +487,7 @@ func TestPovAPI_PubSub_NewBlock(t *testing.T) {
 
 	rpcCtx := rpc.SubscriptionContext()
 
-	subBlk, err := md.api.NewBlock(rpcCtx)
+	subBlk, err := md.api.NewBlock(rpcCtx, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -499,3 +500,83 @@ func TestPovAPI_PubSub_NewBlock(t *testing.T) {
 
 	md.api.pubsub.RemoveChan(string(subBlk.ID))
 }
+
+func TestPovAPI_StateProof(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	acc := mock.Account()
+	allBlks := mockPovApiGeneratePovBlocksToLedger(t, md, 2)
+
+	gsdb := statedb.NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+	as := types.NewPovAccountState()
+	as.Balance = types.NewBalance(1234)
+	_ = gsdb.SetAccountState(acc.Address(), as)
+	csKey := []byte("contract key")
+	csVal := []byte("contract value")
+	_ = gsdb.SetContractValue(contractaddress.PubKeyDistributionAddress, csKey, csVal)
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := md.l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := md.l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	blk, td := mock.GeneratePovBlock(allBlks[len(allBlks)-1], 0)
+	blk.Header.CbTx.StateHash = gsdb.GetCurHash()
+	mock.UpdatePovHash(blk)
+	if err := md.l.AddPovBlock(blk, td); err != nil {
+		t.Fatal(err)
+	}
+
+	asProof, err := md.api.GetAccountStateProof(acc.Address(), blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asProof.AccountState == nil || asProof.AccountState.Balance.Compare(as.Balance) != types.BalanceCompEqual {
+		t.Fatal("invalid account state", asProof.AccountState)
+	}
+	var proof [][]byte
+	for _, p := range asProof.StateProof.Proof {
+		proof = append(proof, p)
+	}
+	val, err := trie.VerifyProof(blk.GetStateHash(), statedb.PovCreateAccountStateKey(acc.Address()), proof)
+	if err != nil || len(val) == 0 {
+		t.Fatal("verify account state proof failed", err)
+	}
+
+	asProof, err = md.api.GetAccountStateProof(mock.Address(), blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asProof.AccountState != nil || len(asProof.StateProof.Value) != 0 {
+		t.Fatal("account state should not exist")
+	}
+
+	csProof, err := md.api.GetContractStateProof(contractaddress.PubKeyDistributionAddress, csKey, blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(csProof.ValueProof.Value) != string(csVal) || csProof.ValueProof.RootHash != csProof.ContractState.StateHash {
+		t.Fatal("invalid contract state proof", csProof)
+	}
+
+	csProof, err = md.api.GetContractStateProof(mock.Address(), csKey, blk.GetHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if csProof.ContractState != nil || csProof.ValueProof != nil || len(csProof.StateProof.Value) != 0 {
+		t.Fatal("contract state should not exist")
+	}
+	proof = nil
+	for _, p := range csProof.StateProof.Proof {
+		proof = append(proof, p)
+	}
+	if val, err := trie.VerifyProof(blk.GetStateHash(), statedb.PovCreateContractStateKey(mock.Address()), proof); err != nil || len(val) != 0 {
+		t.Fatal("verify contract state absence proof failed", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"go.uber.org/zap"
 
@@ -310,3 +311,164 @@ func (r *RepApi) GetRewardHistory(account types.Address) (*RepHistoryRewardInfo,
 
 	return history, nil
 }
+
+type RepPerformance struct {
+	Rank    int           `json:"rank"`
+	Account types.Address `json:"account"`
+	Weight  types.Balance `json:"weight"`
+	// percentages of the total weight, the expected heartbeats and the confirmed blocks
+	StakeShare    float64 `json:"stakeShare"`
+	Uptime        float64 `json:"uptime"`
+	Participation float64 `json:"participation"`
+	// average milliseconds from the start of the elections to the votes
+	AvgVoteLatency uint64 `json:"avgVoteLatency"`
+	HeartCount     uint64 `json:"heartCount"`
+	VoteCount      uint64 `json:"voteCount"`
+	MissedBlocks   uint64 `json:"missedBlocks"`
+}
+
+type RepPerformanceInfo struct {
+	StartHeight    uint64            `json:"startHeight"`
+	EndHeight      uint64            `json:"endHeight"`
+	BlockCount     uint64            `json:"blockCount"`
+	ExpectedHearts uint64            `json:"expectedHearts"`
+	Reps           []*RepPerformance `json:"reps"`
+}
+
+// Performance reports uptime, vote participation, vote latency and stake share of representatives in the
+// pov height window, which is extended to whole online periods, endHeight 0 means the latest height.
+// Weight and stake share are taken from the rep states of the pov state at the end height of the window.
+// Representatives are ranked by participation, uptime and vote latency.
+func (r *RepApi) Performance(startHeight, endHeight uint64) (*RepPerformanceInfo, error) {
+	latestPovHeader, err := r.ledger.GetLatestPovHeader()
+	if err != nil {
+		return nil, err
+	}
+	latestHeight := latestPovHeader.GetHeight()
+	if endHeight == 0 || endHeight > latestHeight {
+		endHeight = latestHeight
+	}
+	if startHeight > endHeight {
+		return nil, fmt.Errorf("invalid height window [%d, %d]", startHeight, endHeight)
+	}
+
+	rsp := &RepPerformanceInfo{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Reps:        make([]*RepPerformance, 0),
+	}
+	startPeriod := startHeight / common.DPosOnlinePeriod
+	endPeriod := endHeight / common.DPosOnlinePeriod
+	for p := startPeriod; p <= endPeriod; p++ {
+		// the latest period is not finished
+		heights := common.DPosOnlinePeriod
+		if p == latestHeight/common.DPosOnlinePeriod {
+			heights = latestHeight%common.DPosOnlinePeriod + 1
+		}
+		rsp.ExpectedHearts += heights * common.DPosHeartCountPerPeriod / common.DPosOnlinePeriod
+	}
+
+	reps := make(map[types.Address]*RepPerformance)
+	getRep := func(account types.Address) *RepPerformance {
+		rp, ok := reps[account]
+		if !ok {
+			rp = &RepPerformance{Account: account, Weight: types.ZeroBalance}
+			reps[account] = rp
+		}
+		return rp
+	}
+
+	latencySums := make(map[types.Address]uint64)
+	err = r.ledger.GetRepOnlineStatsByRange(startPeriod, endPeriod, func(stats *types.RepOnlineStats) error {
+		rsp.BlockCount += stats.BlockCount
+		for _, s := range stats.Stats {
+			rp := getRep(s.Account)
+			rp.HeartCount += s.HeartCount
+			rp.VoteCount += s.VoteCount
+			latencySums[s.Account] += s.LatencySum
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := r.ledger.GetPovHeaderByHeight(endHeight)
+	if err != nil {
+		return nil, err
+	}
+	supply := config.GenesisBlock().Balance
+	minWeight, _ := supply.Div(common.DposVoteDivisor)
+	totalWeight := types.ZeroBalance
+	stateHash := header.GetStateHash()
+	stateTrie := trie.NewTrie(r.ledger.DBStore(), &stateHash, nil)
+	it := stateTrie.NewIterator(statedb.PovCreateGlobalStateKey(statedb.PovGlobalStatePrefixRep, nil))
+	for key, val, ok := it.Next(); ok; key, val, ok = it.Next() {
+		if len(val) == 0 {
+			continue
+		}
+		account, err := statedb.PovStateKeyToAddress(key)
+		if err != nil {
+			return nil, err
+		}
+		rs := types.NewPovRepState()
+		if err := rs.Deserialize(val); err != nil {
+			return nil, fmt.Errorf("deserialize rep state err %s", err)
+		}
+		totalWeight = totalWeight.Add(rs.Total)
+		if rp, ok := reps[account]; ok {
+			rp.Weight = rs.Total
+		} else if rs.Total.Compare(minWeight) != types.BalanceCompSmaller {
+			getRep(account).Weight = rs.Total
+		}
+	}
+
+	for account, rp := range reps {
+		if totalWeight.Sign() > 0 {
+			rp.StakeShare = percent(rp.Weight.Int, totalWeight.Int)
+		}
+		if rsp.ExpectedHearts > 0 {
+			rp.Uptime = percent(new(big.Int).SetUint64(rp.HeartCount), new(big.Int).SetUint64(rsp.ExpectedHearts))
+		}
+		if rsp.BlockCount > 0 {
+			rp.Participation = percent(new(big.Int).SetUint64(rp.VoteCount), new(big.Int).SetUint64(rsp.BlockCount))
+		}
+		if rp.VoteCount < rsp.BlockCount {
+			rp.MissedBlocks = rsp.BlockCount - rp.VoteCount
+		}
+		if rp.VoteCount > 0 {
+			rp.AvgVoteLatency = latencySums[account] / rp.VoteCount
+		}
+		rsp.Reps = append(rsp.Reps, rp)
+	}
+
+	sort.Slice(rsp.Reps, func(i, j int) bool {
+		a, b := rsp.Reps[i], rsp.Reps[j]
+		if a.Participation != b.Participation {
+			return a.Participation > b.Participation
+		}
+		if a.Uptime != b.Uptime {
+			return a.Uptime > b.Uptime
+		}
+		if a.AvgVoteLatency != b.AvgVoteLatency {
+			return a.AvgVoteLatency < b.AvgVoteLatency
+		}
+		if c := a.Weight.Compare(b.Weight); c != types.BalanceCompEqual {
+			return c == types.BalanceCompBigger
+		}
+		return a.Account.String() < b.Account.String()
+	})
+	for i, rp := range rsp.Reps {
+		rp.Rank = i + 1
+	}
+	return rsp, nil
+}
+
+// percent returns x/y in percentage with two decimals, it is at most 100
+func percent(x, y *big.Int) float64 {
+	p := new(big.Int).Div(new(big.Int).Mul(x, big.NewInt(10000)), y).Int64()
+	if p > 10000 {
+		p = 10000
+	}
+	return float64(p) / 100
+}
@@ -6,6 +6,7 @@ import (
 
 	chainctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/statedb"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
@@ -263,3 +264,106 @@ func TestRepApi_GetRewardHistory(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestRepApi_Performance(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	cc := chainctx.NewChainContext(cfgFile)
+	cfg, _ := cc.Config()
+	r := NewRepApi(cfg, l)
+
+	if _, err := r.Performance(0, 0); err == nil {
+		t.Fatal("pov block should not be found")
+	}
+	rep1 := mock.Address()
+	rep2 := mock.Address()
+	gsdb := statedb.NewPovGlobalStateDB(l.DBStore(), types.ZeroHash)
+	for account, weight := range map[types.Address]int64{rep1: 30, rep2: 10} {
+		rs := types.NewPovRepState()
+		rs.Account = account
+		rs.Balance = types.NewBalance(weight)
+		rs.Total = types.NewBalance(weight)
+		if err := gsdb.SetRepState(account, rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+	pb, td := mock.GeneratePovBlock(nil, 0)
+	pb.Header.BasHdr.Height = common.DPosOnlinePeriod*2 + 59
+	pb.Header.CbTx.StateHash = gsdb.GetCurHash()
+	mock.UpdatePovHash(pb)
+	if err := l.AddPovBlock(pb, td); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetPovLatestHeight(pb.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddPovBestHash(pb.GetHeight(), pb.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	for _, stats := range []*types.RepOnlineStats{
+		{Period: 0, BlockCount: 100, Stats: []*types.RepPeriodStat{{Account: rep2, HeartCount: 60, VoteCount: 100}}},
+		{Period: 1, BlockCount: 10, Stats: []*types.RepPeriodStat{
+			{Account: rep1, HeartCount: 60, VoteCount: 10, LatencySum: 1000},
+			{Account: rep2, HeartCount: 30, VoteCount: 5, LatencySum: 5000},
+		}},
+		{Period: 2, BlockCount: 10, Stats: []*types.RepPeriodStat{{Account: rep1, HeartCount: 30, VoteCount: 10, LatencySum: 1000}}},
+	} {
+		if err := l.AddOrUpdateRepOnlineStats(stats); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the current weights are not used by the performance of the window
+	for account, weight := range map[types.Address]int64{rep1: 10, rep2: 1000} {
+		benefit := &types.Benefit{
+			Balance: types.NewBalance(weight),
+			Vote:    types.ZeroBalance,
+			Network: types.ZeroBalance,
+			Storage: types.ZeroBalance,
+			Oracle:  types.ZeroBalance,
+			Total:   types.NewBalance(weight),
+		}
+		if err := l.AddRepresentation(account, benefit, l.Cache().GetCache()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Performance(common.DPosOnlinePeriod*3, 0); err == nil {
+		t.Fatal("height window should be invalid")
+	}
+	rp, err := r.Performance(common.DPosOnlinePeriod, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rp.EndHeight != common.DPosOnlinePeriod*2+59 || rp.BlockCount != 20 || rp.ExpectedHearts != 90 || len(rp.Reps) < 2 {
+		t.Fatal("invalid performance", rp)
+	}
+	p1, p2 := rp.Reps[0], rp.Reps[1]
+	if p1.Rank != 1 || p1.Account != rep1 || p1.Participation != 100 || p1.Uptime != 100 || p1.AvgVoteLatency != 100 ||
+		p1.MissedBlocks != 0 {
+		t.Fatal("invalid performance of rep1", p1)
+	}
+	if p2.Rank != 2 || p2.Account != rep2 || p2.Participation != 25 || p2.Uptime != 33.33 || p2.AvgVoteLatency != 1000 ||
+		p2.MissedBlocks != 15 {
+		t.Fatal("invalid performance of rep2", p2)
+	}
+	if p1.StakeShare != 75 || p2.StakeShare != 25 || p1.Weight.Compare(types.NewBalance(30)) != types.BalanceCompEqual {
+		t.Fatal("invalid stake share", p1.StakeShare, p2.StakeShare)
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/wallet"
+)
+
+type WalletApi struct {
+	wallet *wallet.WalletStore
+	logger *zap.SugaredLogger
+}
+
+func NewWalletApi(w *wallet.WalletStore) *WalletApi {
+	return &WalletApi{wallet: w, logger: log.NewLogger("rpc/wallet")}
+}
+
+// NewWallet creates a wallet from the hex seed, a random seed is used if the seed is not set
+func (w *WalletApi) NewWallet(password string, seed *string) (types.Address, error) {
+	if seed == nil || *seed == "" {
+		s, err := types.NewSeed()
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+		return w.wallet.NewWalletBySeed(s.String(), password)
+	}
+	return w.wallet.NewWalletBySeed(*seed, password)
+}
+
+// NewWalletByMnemonic creates a HD wallet from the BIP-39 mnemonic, accounts are derived under the path
+func (w *WalletApi) NewWalletByMnemonic(mnemonic, passphrase, password string, path *string) (types.Address, error) {
+	var p string
+	if path != nil {
+		p = *path
+	}
+	return w.wallet.NewWalletByMnemonic(mnemonic, passphrase, password, p)
+}
+
+func (w *WalletApi) List() ([]types.Address, error) {
+	return w.wallet.WalletIds()
+}
+
+// Remove removes the wallet after the password is verified
+func (w *WalletApi) Remove(id types.Address, password string) error {
+	if _, err := w.session(id, password); err != nil {
+		return err
+	}
+	return w.wallet.RemoveWallet(id)
+}
+
+func (w *WalletApi) ChangePassword(id types.Address, password, newPassword string) error {
+	session := w.wallet.NewSession(id)
+	if _, err := session.VerifyPassword(password); err != nil {
+		return err
+	}
+	return session.ChangePassword(newPassword)
+}
+
+// ExportWallet exports the seed of the wallet to a keystore encrypted by the wallet password
+func (w *WalletApi) ExportWallet(id types.Address, password string) (*keystore.Keystore, error) {
+	session, err := w.session(id, password)
+	if err != nil {
+		return nil, err
+	}
+	return session.ExportKeystore()
+}
+
+// ExportAccount exports the private key of the account in the wallet to a keystore encrypted by the wallet password
+func (w *WalletApi) ExportAccount(id types.Address, account types.Address, password string) (*keystore.Keystore, error) {
+	session, err := w.session(id, password)
+	if err != nil {
+		return nil, err
+	}
+	return session.ExportAccount(account)
+}
+
+// ImportKeystore creates a wallet from the wallet keystore json, the keystore password becomes the wallet password
+func (w *WalletApi) ImportKeystore(content string, password string) (types.Address, error) {
+	return w.wallet.ImportKeystore([]byte(content), password)
+}
+
+func (w *WalletApi) session(id types.Address, password string) (*wallet.Session, error) {
+	if b, err := w.wallet.IsWalletExist(id); err != nil || !b {
+		return nil, fmt.Errorf("wallet[%s] does not exist", id)
+	}
+	session := w.wallet.NewSession(id)
+	if _, err := session.VerifyPassword(password); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
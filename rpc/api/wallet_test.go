@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/wallet"
+)
+
+func TestWalletApi(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "api", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	if _, err := cm.Load(); err != nil {
+		t.Fatal(err)
+	}
+	w := wallet.NewWalletStore(cm.ConfigFile)
+	defer func() {
+		_ = w.Close()
+		_ = os.RemoveAll(dir)
+	}()
+	api := NewWalletApi(w)
+
+	id1, err := api.NewWallet("pwd", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _ := hd.NewMnemonic(12)
+	id2, err := api.NewWalletByMnemonic(m, "", "pwd", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := api.List(); err != nil || len(ids) != 2 || ids[0] != id1 || ids[1] != id2 {
+		t.Fatal("invalid wallets", ids, err)
+	}
+	if err := api.ChangePassword(id2, "pwd", "pwd2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.ChangePassword(id2, "pwd", "pwd3"); err == nil {
+		t.Fatal("password should be changed")
+	}
+
+	ks, err := api.ExportWallet(id2, "pwd2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.ExportWallet(id2, "pwd"); err == nil {
+		t.Fatal("password should be invalid")
+	}
+	session := w.NewSession(id2)
+	_, _ = session.VerifyPassword("pwd2")
+	account, err := session.Account(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aks, err := api.ExportAccount(id2, account.Address(), "pwd2"); err != nil || aks.Address != account.Address() {
+		t.Fatal("invalid account keystore", err)
+	}
+	content, _ := json.Marshal(ks)
+	if err := api.Remove(id2, "pwd"); err == nil {
+		t.Fatal("wallet should not be removed by invalid password")
+	}
+	if err := api.Remove(id2, "pwd2"); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := api.ImportKeystore(string(content), "pwd2"); err != nil || id != id2 {
+		t.Fatal("invalid imported wallet", err)
+	}
+
+	if err := api.Remove(id1, "pwd"); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ := api.List(); len(ids) != 1 {
+		t.Fatal("wallet is not removed")
+	}
+}
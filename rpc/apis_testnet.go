@@ -21,7 +21,7 @@ func (r *RPC) getApi(apiModule string) rpc.API {
 		return rpc.API{
 			Namespace: "ledger",
 			Version:   "1.0",
-			Service:   api.NewLedgerApi(r.ctx, r.ledger, r.eb, r.cc),
+			Service:   api.NewLedgerApi(r.apiCtx, r.ledger, r.eb, r.cc),
 			Public:    true,
 		}
 	case "net":
@@ -70,7 +70,7 @@ func (r *RPC) getApi(apiModule string) rpc.API {
 		return rpc.API{
 			Namespace: "pov",
 			Version:   "1.0",
-			Service:   api.NewPovApi(r.ctx, r.config, r.ledger, r.eb, r.cc),
+			Service:   api.NewPovApi(r.apiCtx, r.config, r.ledger, r.eb, r.cc),
 			Public:    true,
 		}
 	case "miner":
@@ -171,6 +171,13 @@ func (r *RPC) getApi(apiModule string) rpc.API {
 			Service:   api.NewKYCApi(r.cfgFile, r.ledger),
 			Public:    true,
 		}
+	case "wallet":
+		return rpc.API{
+			Namespace: "wallet",
+			Version:   "1.0",
+			Service:   api.NewWalletApi(r.wallet),
+			Public:    true,
+		}
 	default:
 		return rpc.API{}
 	}
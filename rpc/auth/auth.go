@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package auth authenticates rpc calls by API keys or JWT bearer tokens, authorizes them by the
+// allow/deny rules of roles per namespace.method and limits the call rate of each key.
+// The same rules are enforced on HTTP, WebSocket, IPC and gRPC endpoints.
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	TransportHTTP = "http"
+	TransportWS   = "ws"
+	TransportIPC  = "ipc"
+	TransportGRPC = "grpc"
+
+	// limiters of anonymous hosts and JWT subjects
+	limiterCacheSize = 4096
+)
+
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("method not allowed")
+	ErrRateLimited  = errors.New("rate limit exceeded")
+)
+
+type role struct {
+	name  string
+	allow []string
+	deny  []string
+	rate  float64
+	burst int
+}
+
+// allowed checks the method like ledger.accountInfo by the rules of the role
+func (r *role) allowed(method string) bool {
+	for _, rule := range r.deny {
+		if ok, _ := path.Match(rule, method); ok {
+			return false
+		}
+	}
+	for _, rule := range r.allow {
+		if ok, _ := path.Match(rule, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type apiKey struct {
+	name    string
+	key     []byte
+	role    *role
+	limiter *limiter
+}
+
+// Identity is the authenticated caller
+type Identity struct {
+	Name    string
+	Role    string
+	role    *role
+	limiter *limiter
+}
+
+type Authorizer struct {
+	keys      []*apiKey
+	roles     map[string]*role
+	jwtSecret []byte
+	anonymous *role
+	ipc       *Identity
+	limiters  *lru.Cache
+	logger    *zap.SugaredLogger
+	audit     *zap.SugaredLogger
+}
+
+// NewAuthorizer creates the authorizer from config, it returns nil if auth is disabled
+func NewAuthorizer(cfg *config.RPCAuth) (*Authorizer, error) {
+	if cfg == nil || !cfg.Enable {
+		return nil, nil
+	}
+	limiters, _ := lru.New(limiterCacheSize)
+	a := &Authorizer{
+		roles:     make(map[string]*role),
+		jwtSecret: []byte(cfg.JWTSecret),
+		limiters:  limiters,
+		logger:    log.NewLogger("rpc_auth"),
+		audit:     log.NewLogger("rpc_audit"),
+	}
+	for _, r := range cfg.Roles {
+		if _, ok := a.roles[r.Name]; ok {
+			return nil, fmt.Errorf("duplicate role %s", r.Name)
+		}
+		for _, rule := range append(append([]string{}, r.Allow...), r.Deny...) {
+			if _, err := path.Match(rule, ""); err != nil {
+				return nil, fmt.Errorf("invalid rule %s of role %s", rule, r.Name)
+			}
+		}
+		a.roles[r.Name] = &role{name: r.Name, allow: r.Allow, deny: r.Deny, rate: r.Rate, burst: r.Burst}
+	}
+	for _, k := range cfg.APIKeys {
+		r, ok := a.roles[k.Role]
+		if !ok {
+			return nil, fmt.Errorf("unknown role %s of api key %s", k.Role, k.Name)
+		}
+		if k.Key == "" {
+			return nil, fmt.Errorf("empty api key %s", k.Name)
+		}
+		rate, burst := r.rate, r.burst
+		if k.Rate > 0 {
+			rate, burst = k.Rate, k.Burst
+		}
+		a.keys = append(a.keys, &apiKey{name: k.Name, key: []byte(k.Key), role: r, limiter: newLimiter(rate, burst)})
+	}
+	if cfg.AnonymousRole != "" {
+		r, ok := a.roles[cfg.AnonymousRole]
+		if !ok {
+			return nil, fmt.Errorf("unknown anonymous role %s", cfg.AnonymousRole)
+		}
+		a.anonymous = r
+	}
+	if cfg.IPCRole != "" {
+		r, ok := a.roles[cfg.IPCRole]
+		if !ok {
+			return nil, fmt.Errorf("unknown ipc role %s", cfg.IPCRole)
+		}
+		a.ipc = &Identity{Name: TransportIPC, Role: r.name, role: r, limiter: newLimiter(r.rate, r.burst)}
+	}
+	return a, nil
+}
+
+// Authenticate returns the identity of the API key or JWT, calls without token are anonymous
+func (a *Authorizer) Authenticate(token string, remote string) (*Identity, error) {
+	if token == "" {
+		if a.anonymous == nil {
+			return nil, ErrUnauthorized
+		}
+		host := remoteHost(remote)
+		return &Identity{
+			Name:    "anonymous@" + host,
+			Role:    a.anonymous.name,
+			role:    a.anonymous,
+			limiter: a.limiter("anonymous@"+host, a.anonymous.rate, a.anonymous.burst),
+		}, nil
+	}
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare(k.key, []byte(token)) == 1 {
+			return &Identity{Name: k.name, Role: k.role.name, role: k.role, limiter: k.limiter}, nil
+		}
+	}
+	if len(a.jwtSecret) > 0 && strings.Count(token, ".") == 2 {
+		claims, err := verifyJWT(token, a.jwtSecret)
+		if err != nil {
+			a.logger.Debugf("invalid jwt: %s", err)
+			return nil, ErrUnauthorized
+		}
+		r, ok := a.roles[claims.Role]
+		if !ok {
+			return nil, ErrUnauthorized
+		}
+		name := "jwt:" + claims.Subject
+		return &Identity{Name: name, Role: r.name, role: r, limiter: a.limiter(name, r.rate, r.burst)}, nil
+	}
+	return nil, ErrUnauthorized
+}
+
+// IPCIdentity returns the identity of IPC connections
+func (a *Authorizer) IPCIdentity() (*Identity, error) {
+	if a.ipc == nil {
+		return nil, ErrUnauthorized
+	}
+	return a.ipc, nil
+}
+
+// Authorize checks the method like ledger.accountInfo by the role and the rate limit of the identity
+func (a *Authorizer) Authorize(id *Identity, method string) error {
+	if !id.role.allowed(method) {
+		return ErrForbidden
+	}
+	if !id.limiter.allow() {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// Audit logs the denied call
+func (a *Authorizer) Audit(transport string, id *Identity, remote, method string, err error) {
+	name, roleName := "", ""
+	if id != nil {
+		name, roleName = id.Name, id.Role
+	}
+	a.audit.Warnw("rpc call denied", "transport", transport, "identity", name, "role", roleName,
+		"method", method, "remote", remote, "reason", err.Error())
+}
+
+func (a *Authorizer) limiter(name string, rate float64, burst int) *limiter {
+	if v, ok := a.limiters.Get(name); ok {
+		return v.(*limiter)
+	}
+	l := newLimiter(rate, burst)
+	if ok, _ := a.limiters.ContainsOrAdd(name, l); ok {
+		if v, ok := a.limiters.Get(name); ok {
+			return v.(*limiter)
+		}
+	}
+	return l
+}
+
+func remoteHost(remote string) string {
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		return host
+	}
+	return remote
+}
@@ -0,0 +1,323 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	rpc "github.com/qlcchain/jsonrpc2"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+type testService struct{}
+
+func (s *testService) Echo(v string) string {
+	return v
+}
+
+func (s *testService) Send(v string) string {
+	return v
+}
+
+func testConfig() *config.RPCAuth {
+	return &config.RPCAuth{
+		Enable:        true,
+		JWTSecret:     "secret",
+		AnonymousRole: "public",
+		IPCRole:       "public",
+		APIKeys: []*config.APIKey{
+			{Name: "admin", Key: "admin-key", Role: "admin"},
+			{Name: "limited", Key: "limited-key", Role: "admin", Rate: 1, Burst: 2},
+		},
+		Roles: []*config.RPCRole{
+			{Name: "admin", Allow: []string{"*"}},
+			{Name: "public", Allow: []string{"test.*"}, Deny: []string{"test.send"}},
+		},
+	}
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	if a, err := NewAuthorizer(nil); a != nil || err != nil {
+		t.Fatal("auth should be disabled")
+	}
+	cfg := testConfig()
+	cfg.APIKeys[0].Role = "unknown"
+	if _, err := NewAuthorizer(cfg); err == nil {
+		t.Fatal("role should be unknown")
+	}
+	cfg = testConfig()
+	cfg.Roles[1].Allow = []string{"["}
+	if _, err := NewAuthorizer(cfg); err == nil {
+		t.Fatal("rule should be invalid")
+	}
+}
+
+func TestAuthorizer_Authenticate(t *testing.T) {
+	a, err := NewAuthorizer(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, err := a.Authenticate("admin-key", "127.0.0.1:1000"); err != nil || id.Name != "admin" || id.Role != "admin" {
+		t.Fatal("invalid api key identity", id, err)
+	}
+	if id, err := a.Authenticate("", "127.0.0.1:1000"); err != nil || id.Role != "public" {
+		t.Fatal("invalid anonymous identity", id, err)
+	}
+	if _, err := a.Authenticate("wrong", "127.0.0.1:1000"); err != ErrUnauthorized {
+		t.Fatal("key should be invalid", err)
+	}
+
+	token, _ := NewJWT(&Claims{Subject: "alice", Role: "admin", ExpiresAt: time.Now().Add(time.Hour).Unix()}, []byte("secret"))
+	if id, err := a.Authenticate(token, ""); err != nil || id.Name != "jwt:alice" || id.Role != "admin" {
+		t.Fatal("invalid jwt identity", id, err)
+	}
+	token, _ = NewJWT(&Claims{Subject: "alice", Role: "admin", ExpiresAt: time.Now().Add(-time.Hour).Unix()}, []byte("secret"))
+	if _, err := a.Authenticate(token, ""); err != ErrUnauthorized {
+		t.Fatal("jwt should be expired", err)
+	}
+	token, _ = NewJWT(&Claims{Subject: "alice", Role: "admin"}, []byte("other"))
+	if _, err := a.Authenticate(token, ""); err != ErrUnauthorized {
+		t.Fatal("jwt signature should be invalid", err)
+	}
+	token, _ = NewJWT(&Claims{Subject: "alice", Role: "root"}, []byte("secret"))
+	if _, err := a.Authenticate(token, ""); err != ErrUnauthorized {
+		t.Fatal("jwt role should be unknown", err)
+	}
+
+	cfg := testConfig()
+	cfg.AnonymousRole = ""
+	a, _ = NewAuthorizer(cfg)
+	if _, err := a.Authenticate("", "127.0.0.1:1000"); err != ErrUnauthorized {
+		t.Fatal("anonymous should be rejected", err)
+	}
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	a, _ := NewAuthorizer(testConfig())
+	public, _ := a.Authenticate("", "127.0.0.1:1000")
+	if err := a.Authorize(public, "test.echo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Authorize(public, "test.send"); err != ErrForbidden {
+		t.Fatal("deny rule should be applied", err)
+	}
+	if err := a.Authorize(public, "ledger.accountInfo"); err != ErrForbidden {
+		t.Fatal("method should not be allowed", err)
+	}
+
+	limited, _ := a.Authenticate("limited-key", "")
+	for i := 0; i < 2; i++ {
+		if err := a.Authorize(limited, "ledger.accountInfo"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Authorize(limited, "ledger.accountInfo"); err != ErrRateLimited {
+		t.Fatal("call should be rate limited", err)
+	}
+	// the bucket is shared by all calls of the key
+	limited, _ = a.Authenticate("limited-key", "")
+	if err := a.Authorize(limited, "ledger.accountInfo"); err != ErrRateLimited {
+		t.Fatal("call should be rate limited", err)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	if l := newLimiter(0, 0); l != nil || !l.allow() {
+		t.Fatal("limiter should be disabled")
+	}
+	now := time.Now()
+	l := newLimiter(2, 2)
+	l.now = func() time.Time { return now }
+	l.last = now
+	if !l.allow() || !l.allow() || l.allow() {
+		t.Fatal("burst should be 2")
+	}
+	now = now.Add(500 * time.Millisecond)
+	if !l.allow() || l.allow() {
+		t.Fatal("1 token should be refilled")
+	}
+}
+
+func TestAclMethod(t *testing.T) {
+	cases := map[string]string{
+		`{"method":"ledger_accountInfo","params":[]}`:          "ledger.accountInfo",
+		`{"method":"ledger_subscribe","params":["newBlock"]}`:  "ledger.newBlock",
+		`{"method":"ledger_subscribe","params":[]}`:            "ledger.subscribe",
+		`{"method":"rpc_modules"}`:                             "rpc.modules",
+		`{"method":"ledger_unsubscribe","params":["0x1234"]}`:  "ledger.unsubscribe",
+		`{"method":"pov_subscribe","params":["newBlock", 10]}`: "pov.newBlock",
+	}
+	for raw, expect := range cases {
+		msgs, batch, err := parseMessages(json.RawMessage(raw))
+		if err != nil || batch || len(msgs) != 1 {
+			t.Fatal(err)
+		}
+		if m := aclMethod(msgs[0]); m != expect {
+			t.Fatal("invalid method", m, expect)
+		}
+	}
+	if msgs, batch, err := parseMessages(json.RawMessage(` [{"method":"a_b"},{"method":"c_d"}]`)); err != nil || !batch || len(msgs) != 2 {
+		t.Fatal("invalid batch", err)
+	}
+}
+
+func TestGrpcMethod(t *testing.T) {
+	cases := map[string]string{
+		"/proto.LedgerAPI/AccountInfo":         "ledger.accountInfo",
+		"/proto.NEP5PledgeAPI/GetPledgeInfo":   "pledge.getPledgeInfo",
+		"/proto.BlackHoleAPI/GetSendBlock":     "destroy.getSendBlock",
+		"/proto.PublicKeyDistributionAPI/Get":  "dpki.get",
+		"/proto.UnknownAPI/DoSomething":        "unknown.doSomething",
+		"/grpc.reflection.v1alpha.X/Y":         "x.y",
+		"/proto.PovAPI/GetFittestHeader":       "pov.getFittestHeader",
+		"/proto.RewardsAPI/GetTotalRewards":    "rewards.getTotalRewards",
+		"/proto.SettlementAPI/GetAllContracts": "settlement.getAllContracts",
+	}
+	for full, expect := range cases {
+		if m := grpcMethod(full); m != expect {
+			t.Fatal("invalid method", m, expect)
+		}
+	}
+}
+
+func newTestServer(t *testing.T) *rpc.Server {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("test", new(testService)); err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func TestAuthorizer_HTTPHandler(t *testing.T) {
+	a, _ := NewAuthorizer(testConfig())
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(a.HTTPHandler(srv))
+	defer ts.Close()
+
+	call := func(body, token string) (int, string) {
+		req, _ := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+
+	if code, body := call(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hi"]}`, ""); code != http.StatusOK || !strings.Contains(body, `"result":"hi"`) {
+		t.Fatal("anonymous call should be allowed", code, body)
+	}
+	if code, body := call(`{"jsonrpc":"2.0","id":2,"method":"test_send","params":["hi"]}`, ""); code != http.StatusForbidden || !strings.Contains(body, `"id":2`) {
+		t.Fatal("anonymous call should be denied", code, body)
+	}
+	if code, body := call(`{"jsonrpc":"2.0","id":3,"method":"test_send","params":["hi"]}`, "admin-key"); code != http.StatusOK || !strings.Contains(body, `"result":"hi"`) {
+		t.Fatal("admin call should be allowed", code, body)
+	}
+	if code, _ := call(`{"jsonrpc":"2.0","id":4,"method":"test_echo","params":["hi"]}`, "wrong"); code != http.StatusUnauthorized {
+		t.Fatal("key should be invalid", code)
+	}
+	if code, body := call(`[{"jsonrpc":"2.0","id":5,"method":"test_echo","params":["hi"]},{"jsonrpc":"2.0","id":6,"method":"test_send","params":["hi"]}]`, ""); code != http.StatusForbidden || !strings.Contains(body, `"id":6`) {
+		t.Fatal("batch should be denied", code, body)
+	}
+}
+
+func TestAuthorizer_ServeIPC(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "auth", uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	a, _ := NewAuthorizer(testConfig())
+	srv := newTestServer(t)
+	defer srv.Stop()
+	l, err := net.Listen("unix", filepath.Join(dir, "test.ipc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		_ = a.ServeIPC(srv, l)
+	}()
+
+	client, err := rpc.DialIPC(context.Background(), filepath.Join(dir, "test.ipc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	var r string
+	if err := client.Call(&r, "test_echo", "hi"); err != nil || r != "hi" {
+		t.Fatal("call should be allowed", r, err)
+	}
+	if err := client.Call(&r, "test_send", "hi"); err == nil || !strings.Contains(err.Error(), ErrForbidden.Error()) {
+		t.Fatal("call should be denied", err)
+	}
+	// the connection is still usable after a denied call
+	if err := client.Call(&r, "test_echo", "again"); err != nil || r != "again" {
+		t.Fatal("call should be allowed", r, err)
+	}
+}
+
+func TestErrorResponses(t *testing.T) {
+	msgs, batch, _ := parseMessages(json.RawMessage(`{"method":"test_send"}`))
+	b, _ := json.Marshal(errorResponses(msgs, batch, ErrForbidden))
+	if !bytes.Contains(b, []byte(`"id":null`)) || !bytes.Contains(b, []byte(`-32002`)) {
+		t.Fatal("invalid error response", string(b))
+	}
+}
+
+func TestAuthorizer_WSHandler(t *testing.T) {
+	cfg := testConfig()
+	cfg.AnonymousRole = ""
+	a, _ := NewAuthorizer(cfg)
+	srv := newTestServer(t)
+	defer srv.Stop()
+	ts := httptest.NewServer(a.WSHandler(srv, []string{"*"}))
+	defer ts.Close()
+	endpoint := "ws" + strings.TrimPrefix(ts.URL, "http")
+
+	client, err := rpc.DialWebsocket(context.Background(), endpoint+"/?token=admin-key", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	var r string
+	if err := client.Call(&r, "test_send", "hi"); err != nil || r != "hi" {
+		t.Fatal("call should be allowed", r, err)
+	}
+
+	anonymous, err := rpc.DialWebsocket(context.Background(), endpoint, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer anonymous.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := anonymous.CallContext(ctx, &r, "test_echo", "hi"); err == nil {
+		t.Fatal("anonymous connection should be closed")
+	}
+}
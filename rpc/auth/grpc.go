@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcNamespaces maps gRPC services to json-rpc namespaces, so the same rules apply to both
+var grpcNamespaces = map[string]string{
+	"AccountAPI":               "account",
+	"BlackHoleAPI":             "destroy",
+	"ChainAPI":                 "chain",
+	"ContractAPI":              "contract",
+	"KYCAPI":                   "KYC",
+	"LedgerAPI":                "ledger",
+	"MetricsAPI":               "metrics",
+	"MinerAPI":                 "miner",
+	"MintageAPI":               "mintage",
+	"NEP5PledgeAPI":            "pledge",
+	"NetAPI":                   "net",
+	"PermissionAPI":            "permission",
+	"PovAPI":                   "pov",
+	"PrivacyAPI":               "privacy",
+	"PtmKeyAPI":                "ptmkey",
+	"PublicKeyDistributionAPI": "dpki",
+	"RepAPI":                   "rep",
+	"RewardsAPI":               "rewards",
+	"SettlementAPI":            "settlement",
+	"UtilAPI":                  "util",
+}
+
+// grpcMethod converts full method like /proto.LedgerAPI/AccountInfo to ledger.accountInfo
+func grpcMethod(fullMethod string) string {
+	s := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(s) != 2 || s[1] == "" {
+		return fullMethod
+	}
+	service := s[0]
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	namespace, ok := grpcNamespaces[service]
+	if !ok {
+		namespace = strings.ToLower(strings.TrimSuffix(service, "API"))
+	}
+	return namespace + "." + strings.ToLower(s[1][:1]) + s[1][1:]
+}
+
+// grpcToken returns the bearer token or the API key of the call, the authorization header
+// of the gRPC gateway is forwarded as metadata
+func grpcToken(md metadata.MD) string {
+	for _, h := range md.Get("authorization") {
+		if len(h) > 7 && strings.EqualFold(h[:7], "bearer ") {
+			return strings.TrimSpace(h[7:])
+		}
+	}
+	if k := md.Get("x-api-key"); len(k) > 0 {
+		return k[0]
+	}
+	return ""
+}
+
+// grpcRemote returns the peer address, the forwarded address is only trusted from the local gateway,
+// which connects over loopback or the in-memory listener if TLS is enabled
+func grpcRemote(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ip := net.ParseIP(remoteHost(p.Addr.String())); (ip != nil && ip.IsLoopback()) || p.Addr.Network() == "bufconn" {
+		if f := md.Get("x-forwarded-for"); len(f) > 0 {
+			return strings.TrimSpace(strings.Split(f[0], ",")[0])
+		}
+	}
+	return p.Addr.String()
+}
+
+func (a *Authorizer) authorizeGRPC(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	remote := grpcRemote(ctx, md)
+	method := grpcMethod(fullMethod)
+	id, err := a.Authenticate(grpcToken(md), remote)
+	if err == nil {
+		err = a.Authorize(id, method)
+	}
+	if err != nil {
+		a.Audit(TransportGRPC, id, remote, method, err)
+		switch err {
+		case ErrUnauthorized:
+			return status.Error(codes.Unauthenticated, err.Error())
+		case ErrForbidden:
+			return status.Error(codes.PermissionDenied, err.Error())
+		case ErrRateLimited:
+			return status.Error(codes.ResourceExhausted, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// UnaryServerInterceptor authorizes unary gRPC calls
+func (a *Authorizer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorizeGRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor authorizes streaming gRPC calls when the stream is opened
+func (a *Authorizer) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorizeGRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
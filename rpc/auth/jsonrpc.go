@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"golang.org/x/net/websocket"
+)
+
+// same as the max request size of the rpc server
+const maxRequestSize = 10 << 20
+
+type message struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *jsonError      `json:"error"`
+}
+
+// parseMessages parses a (batch) json-rpc message, the same way as the rpc server
+func parseMessages(raw json.RawMessage) ([]*message, bool, error) {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var msgs []*message
+		if err := json.Unmarshal(trimmed, &msgs); err != nil {
+			return nil, true, err
+		}
+		return msgs, true, nil
+	}
+	msg := new(message)
+	if err := json.Unmarshal(trimmed, msg); err != nil {
+		return nil, false, err
+	}
+	return []*message{msg}, false, nil
+}
+
+// aclMethod converts method like ledger_accountInfo to ledger.accountInfo,
+// and ledger_subscribe with subscription name newBlock to ledger.newBlock
+func aclMethod(msg *message) string {
+	i := strings.Index(msg.Method, "_")
+	if i < 0 {
+		return msg.Method
+	}
+	namespace, name := msg.Method[:i], msg.Method[i+1:]
+	if name == "subscribe" {
+		var params []json.RawMessage
+		if err := json.Unmarshal(msg.Params, &params); err == nil && len(params) > 0 {
+			var sub string
+			if err := json.Unmarshal(params[0], &sub); err == nil && sub != "" {
+				name = sub
+			}
+		}
+	}
+	return namespace + "." + name
+}
+
+// authorizeMessage authorizes all calls of the message, it returns the denied method
+func (a *Authorizer) authorizeMessage(id *Identity, msgs []*message) (string, error) {
+	for _, msg := range msgs {
+		if msg.Method == "" {
+			continue
+		}
+		method := aclMethod(msg)
+		if err := a.Authorize(id, method); err != nil {
+			return method, err
+		}
+	}
+	return "", nil
+}
+
+func errorCode(err error) int {
+	switch err {
+	case ErrUnauthorized:
+		return -32001
+	case ErrForbidden:
+		return -32002
+	case ErrRateLimited:
+		return -32003
+	default:
+		return -32000
+	}
+}
+
+func httpStatus(err error) int {
+	switch err {
+	case ErrUnauthorized:
+		return http.StatusUnauthorized
+	case ErrForbidden:
+		return http.StatusForbidden
+	case ErrRateLimited:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorResponses returns an error response for each call of the message
+func errorResponses(msgs []*message, batch bool, err error) interface{} {
+	e := &jsonError{Code: errorCode(err), Message: err.Error()}
+	if !batch {
+		var id json.RawMessage
+		if len(msgs) > 0 {
+			id = msgs[0].ID
+		}
+		return &errorResponse{Version: "2.0", ID: nullID(id), Error: e}
+	}
+	rs := make([]*errorResponse, 0, len(msgs))
+	for _, msg := range msgs {
+		rs = append(rs, &errorResponse{Version: "2.0", ID: nullID(msg.ID), Error: e})
+	}
+	return rs
+}
+
+func nullID(id json.RawMessage) json.RawMessage {
+	if len(id) == 0 {
+		return json.RawMessage("null")
+	}
+	return id
+}
+
+// requestToken returns the bearer token or the API key of the request,
+// the token query parameter is supported for WebSocket clients which can not set headers
+func requestToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); len(h) > 7 && strings.EqualFold(h[:7], "bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	if k := r.Header.Get("X-Api-Key"); k != "" {
+		return k
+	}
+	return r.URL.Query().Get("token")
+}
+
+// HTTPHandler authorizes json-rpc calls over HTTP before they are served by next
+func (a *Authorizer) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// health checks are served without auth
+		if r.Method == http.MethodGet && r.ContentLength == 0 && r.URL.RawQuery == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		msgs, batch, perr := parseMessages(body)
+		id, err := a.Authenticate(requestToken(r), r.RemoteAddr)
+		method := ""
+		if err == nil && perr == nil {
+			method, err = a.authorizeMessage(id, msgs)
+		}
+		if err != nil {
+			a.Audit(TransportHTTP, id, r.RemoteAddr, method, err)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(httpStatus(err))
+			_ = json.NewEncoder(w).Encode(errorResponses(msgs, batch, err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WSHandler serves json-rpc over WebSocket like srv.WebsocketHandler, the connection is authenticated
+// during the handshake and each call is authorized
+func (a *Authorizer) WSHandler(srv *rpc.Server, allowedOrigins []string) http.Handler {
+	ws, ok := srv.WebsocketHandler(allowedOrigins).(websocket.Server)
+	if !ok {
+		ws = websocket.Server{}
+	}
+	ws.Handler = func(conn *websocket.Conn) {
+		r := conn.Request()
+		id, err := a.Authenticate(requestToken(r), r.RemoteAddr)
+		if err != nil {
+			a.Audit(TransportWS, nil, r.RemoteAddr, "", err)
+			_ = websocket.JSON.Send(conn, errorResponses(nil, false, err))
+			_ = conn.Close()
+			return
+		}
+		conn.MaxPayloadBytes = maxRequestSize
+		encode := func(v interface{}) error {
+			return websocket.JSON.Send(conn, v)
+		}
+		decode := func(v interface{}) error {
+			return websocket.JSON.Receive(conn, v)
+		}
+		codec := rpc.NewCodec(conn, encode, a.guard(TransportWS, id, r.RemoteAddr, encode, decode))
+		srv.ServeCodec(codec, rpc.OptionMethodInvocation|rpc.OptionSubscriptions)
+	}
+	return ws
+}
+
+// ServeIPC serves json-rpc on connections accepted by the listener like srv.ServeListener,
+// connections have the IPC identity and each call is authorized
+func (a *Authorizer) ServeIPC(srv *rpc.Server, l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return err
+		}
+		go a.serveIPCConn(srv, conn)
+	}
+}
+
+func (a *Authorizer) serveIPCConn(srv *rpc.Server, conn net.Conn) {
+	remote := conn.RemoteAddr().String()
+	id, err := a.IPCIdentity()
+	if err != nil {
+		a.Audit(TransportIPC, nil, remote, "", err)
+		_ = json.NewEncoder(conn).Encode(errorResponses(nil, false, err))
+		_ = conn.Close()
+		return
+	}
+	var mu sync.Mutex
+	enc := json.NewEncoder(conn)
+	dec := json.NewDecoder(conn)
+	dec.UseNumber()
+	encode := func(v interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return enc.Encode(v)
+	}
+	codec := rpc.NewCodec(conn, encode, a.guard(TransportIPC, id, remote, encode, dec.Decode))
+	srv.ServeCodec(codec, rpc.OptionMethodInvocation|rpc.OptionSubscriptions)
+}
+
+// guard wraps the decoder of a connection, denied messages are answered with errors and not passed to the server
+func (a *Authorizer) guard(transport string, id *Identity, remote string, encode, decode func(v interface{}) error) func(v interface{}) error {
+	return func(v interface{}) error {
+		target, ok := v.(*json.RawMessage)
+		if !ok {
+			return errors.New("unsupported message type")
+		}
+		for {
+			var raw json.RawMessage
+			if err := decode(&raw); err != nil {
+				return err
+			}
+			msgs, batch, err := parseMessages(raw)
+			if err == nil {
+				var method string
+				if method, err = a.authorizeMessage(id, msgs); err != nil {
+					a.Audit(transport, id, remote, method, err)
+					if err := encode(errorResponses(msgs, batch, err)); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+			*target = raw
+			return nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Claims of the JWT, the role must be defined in config
+type Claims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	NotBefore int64  `json:"nbf,omitempty"`
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// NewJWT signs the claims by HS256
+func NewJWT(claims *Claims, secret []byte) (string, error) {
+	header, err := json.Marshal(&jwtHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signing := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signing + "." + base64.RawURLEncoding.EncodeToString(signJWT(signing, secret)), nil
+}
+
+// verifyJWT verifies the HS256 signature and the time of the token
+func verifyJWT(token string, secret []byte) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, err
+	}
+	if header.Alg != "HS256" {
+		return nil, errors.New("unsupported algorithm " + header.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(sig, signJWT(parts[0]+"."+parts[1], secret)) {
+		return nil, errors.New("invalid signature")
+	}
+	if b, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		return nil, err
+	}
+	claims := new(Claims)
+	if err := json.Unmarshal(b, claims); err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	if claims.ExpiresAt > 0 && now >= claims.ExpiresAt {
+		return nil, errors.New("token is expired")
+	}
+	if claims.NotBefore > 0 && now < claims.NotBefore {
+		return nil, errors.New("token is not valid yet")
+	}
+	return claims, nil
+}
+
+func signJWT(signing string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signing))
+	return mac.Sum(nil)
+}
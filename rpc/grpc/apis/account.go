@@ -77,6 +77,34 @@ func (a *AccountApi) Validate(ctx context.Context, str *pb.String) (*pb.Boolean,
 	return toBoolean(r), nil
 }
 
+func (a *AccountApi) NewMnemonic(ctx context.Context, words *pb.Int32) (*pb.String, error) {
+	var w *int
+	if words.GetValue() > 0 {
+		v := int(words.GetValue())
+		w = &v
+	}
+	r, err := a.account.NewMnemonic(w)
+	if err != nil {
+		return nil, err
+	}
+	return toString(r), nil
+}
+
+func (a *AccountApi) ValidateMnemonic(ctx context.Context, str *pb.String) (*pb.Boolean, error) {
+	r := a.account.ValidateMnemonic(toOriginString(str))
+	return toBoolean(r), nil
+}
+
+func (a *AccountApi) CreateByMnemonic(ctx context.Context, para *pb.CreateByMnemonicRequest) (*pb.CreateResponse, error) {
+	r, err := a.account.CreateByMnemonic(para.GetMnemonic(), para.GetPassphrase(), toStringPoint(para.GetPath()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateResponse{
+		Value: r,
+	}, nil
+}
+
 func toAccounts(accs []*api.Accounts) *pb.AccountsResponse {
 	as := make([]*pb.Account, 0)
 	for _, a := range accs {
@@ -1,8 +1,13 @@
 package apis
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
 
+	"github.com/golang/protobuf/jsonpb"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
 	"github.com/qlcchain/go-qlc/common/types"
 	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
 	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
@@ -62,6 +67,7 @@ func toStateBlock(blk *types.StateBlock) *pbtypes.StateBlock {
 		PrivateGroupID: blk.PrivateGroupID,
 		Work:           toWorkValue(blk.GetWork()),
 		Signature:      toSignatureValue(blk.GetSignature()),
+		MultiSig:       toMultiSig(blk.MultiSig),
 		//Flag:           blk.Flag,
 		//PrivateRecvRsp: blk.PrivateRecvRsp,
 		//PrivatePayload: blk.PrivatePayload,
@@ -109,6 +115,10 @@ func toOriginStateBlock(blk *pbtypes.StateBlock) (*types.StateBlock, error) {
 	if err != nil {
 		return nil, err
 	}
+	multiSig, err := toOriginMultiSig(blk.GetMultiSig())
+	if err != nil {
+		return nil, err
+	}
 	return &types.StateBlock{
 		Type:           toOriginBlockValue(blk.GetType()),
 		Token:          token,
@@ -133,6 +143,7 @@ func toOriginStateBlock(blk *pbtypes.StateBlock) (*types.StateBlock, error) {
 		PrivateGroupID: blk.GetPrivateGroupID(),
 		Work:           toOriginWorkByValue(blk.GetWork()),
 		Signature:      sign,
+		MultiSig:       multiSig,
 		//Flag:           blk.GetFlag(),
 		//PrivateRecvRsp: blk.GetPrivateRecvRsp(),
 		//PrivatePayload: blk.GetPrivatePayload(),
@@ -151,6 +162,53 @@ func toOriginStateBlocks(blks []*pbtypes.StateBlock) ([]*types.StateBlock, error
 	return blocks, nil
 }
 
+// MultiSig
+
+func toMultiSig(m *types.MultiSig) *pbtypes.MultiSig {
+	if m == nil {
+		return nil
+	}
+	signatures := make([]*pbtypes.OwnerSignature, 0)
+	for _, s := range m.Signatures {
+		signatures = append(signatures, &pbtypes.OwnerSignature{
+			Owner:     toAddressValue(s.Owner),
+			Signature: toSignatureValue(s.Signature),
+		})
+	}
+	return &pbtypes.MultiSig{
+		Threshold:  uint32(m.Threshold),
+		Owners:     toAddressValues(m.Owners),
+		Signatures: signatures,
+	}
+}
+
+func toOriginMultiSig(m *pbtypes.MultiSig) (*types.MultiSig, error) {
+	if m == nil {
+		return nil, nil
+	}
+	owners, err := toOriginAddressesByValues(m.GetOwners())
+	if err != nil {
+		return nil, err
+	}
+	signatures := make([]types.OwnerSignature, 0)
+	for _, s := range m.GetSignatures() {
+		owner, err := toOriginAddressByValue(s.GetOwner())
+		if err != nil {
+			return nil, err
+		}
+		sign, err := toOriginSignatureByValue(s.GetSignature())
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, types.OwnerSignature{Owner: owner, Signature: sign})
+	}
+	return &types.MultiSig{
+		Threshold:  uint8(m.GetThreshold()),
+		Owners:     owners,
+		Signatures: signatures,
+	}, nil
+}
+
 // BlockType
 
 func toBlockTypeValue(b types.BlockType) string {
@@ -394,3 +452,16 @@ func toOriginUInt64(v *pb.UInt64) uint64 {
 func toOriginUInt32(v *pb.UInt32) uint32 {
 	return v.GetValue()
 }
+
+// toStruct converts results without proto messages, like map[string]interface{}, to the same json object as json-rpc
+func toStruct(v interface{}) (*structpb.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	s := new(structpb.Struct)
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -1 +1,36 @@
 package apis
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestStateBlock_MultiSig(t *testing.T) {
+	owners := []*types.Account{mock.Account(), mock.Account()}
+	m, err := types.NewMultiSig(2, []types.Address{owners[0].Address(), owners[1].Address()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = m.Address()
+	blk.MultiSig = m
+	hash := blk.GetHash()
+	for _, o := range owners {
+		if err := m.AddSignature(o.Address(), hash, o.Sign(hash)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := toOriginStateBlock(toStateBlock(blk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetHash() != hash || r.MultiSig == nil {
+		t.Fatal("multisig is not converted")
+	}
+	if err := r.MultiSig.Verify(blk.Address, hash); err != nil {
+		t.Fatal(err)
+	}
+}
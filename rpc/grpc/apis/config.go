@@ -0,0 +1,64 @@
+package apis
+
+import (
+	"context"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+)
+
+type ConfigAPI struct {
+	config *api.ConfigApi
+	logger *zap.SugaredLogger
+}
+
+func NewConfigAPI(cfgFile string) *ConfigAPI {
+	return &ConfigAPI{
+		config: api.NewConfigApi(cfgFile),
+		logger: log.NewLogger("grpc_config"),
+	}
+}
+
+func (c *ConfigAPI) CurrentConfig(ctx context.Context, params *pb.String) (*structpb.Struct, error) {
+	r, err := c.config.CurrentConfig(toOriginString(params))
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (c *ConfigAPI) Update(ctx context.Context, params *pb.UpdateConfigRequest) (*structpb.Struct, error) {
+	r, err := c.config.Update(params.GetParams(), params.GetToken(), params.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (c *ConfigAPI) Difference(ctx context.Context, params *pb.ConfigMark) (*pb.String, error) {
+	r, err := c.config.Difference(params.GetToken(), params.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toString(r), nil
+}
+
+func (c *ConfigAPI) Commit(ctx context.Context, params *pb.ConfigMark) (*pb.Boolean, error) {
+	r, err := c.config.Commit(params.GetToken(), params.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toBoolean(r), nil
+}
+
+func (c *ConfigAPI) Save(ctx context.Context, params *pb.ConfigMark) (*pb.Boolean, error) {
+	r, err := c.config.Save(params.GetToken(), params.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toBoolean(r), nil
+}
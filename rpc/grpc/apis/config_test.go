@@ -0,0 +1,58 @@
+package apis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+)
+
+func TestConfigAPI(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "config", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := config.NewCfgManager(dir)
+	cm.Load()
+	cfg, err := cm.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := cfg.Manager.AdminToken
+	c := NewConfigAPI(cm.ConfigFile)
+	ctx := context.Background()
+
+	if _, err := c.CurrentConfig(ctx, toString("invalid")); err == nil {
+		t.Fatal("invalid token should return error")
+	}
+	current, err := c.CurrentConfig(ctx, toString(token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.GetFields()["dataDir"].GetStringValue() != cfg.DataDir {
+		t.Fatal(current.GetFields()["dataDir"])
+	}
+
+	mark := "abc"
+	r, err := c.Update(ctx, &pb.UpdateConfigRequest{Params: []string{"pov.povEnabled=false"}, Token: token, Mark: mark})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := r.GetFields()["pov"].GetStructValue().GetFields()["povEnabled"]; !ok || v.GetBoolValue() {
+		t.Fatal("pov should be disabled")
+	}
+	if diff, err := c.Difference(ctx, &pb.ConfigMark{Token: token, Mark: mark}); err != nil || diff.GetValue() == "" {
+		t.Fatal(err)
+	}
+	if b, err := c.Commit(ctx, &pb.ConfigMark{Token: token, Mark: mark}); err != nil || !b.GetValue() {
+		t.Fatal(err)
+	}
+	if _, err := c.Save(ctx, &pb.ConfigMark{Token: token, Mark: "abcd"}); err == nil {
+		t.Fatal("another mark should return error")
+	}
+}
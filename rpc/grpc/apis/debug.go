@@ -0,0 +1,359 @@
+package apis
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+type DebugAPI struct {
+	debug  *api.DebugApi
+	logger *zap.SugaredLogger
+}
+
+func NewDebugAPI(cfgFile string, eb event.EventBus) *DebugAPI {
+	return &DebugAPI{
+		debug:  api.NewDebugApi(cfgFile, eb),
+		logger: log.NewLogger("grpc_debug"),
+	}
+}
+
+func (d *DebugAPI) BlockCacheCount(ctx context.Context, params *empty.Empty) (*pb.DebugUInt64Map, error) {
+	r, err := d.debug.BlockCacheCount()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugUInt64Map{Value: r}, nil
+}
+
+func (d *DebugAPI) BlockCaches(ctx context.Context, params *empty.Empty) (*pbtypes.Hashes, error) {
+	r, err := d.debug.BlockCaches()
+	if err != nil {
+		return nil, err
+	}
+	return toHashes(r), nil
+}
+
+func (d *DebugAPI) Action(ctx context.Context, params *pb.ActionRequest) (*pb.String, error) {
+	r, err := d.debug.Action(storage.ActionType(params.GetAction()), int(params.GetT()))
+	if err != nil {
+		return nil, err
+	}
+	return toString(r), nil
+}
+
+func (d *DebugAPI) BlockLink(ctx context.Context, params *pbtypes.Hash) (*pb.DebugStringMap, error) {
+	hash, err := toOriginHash(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.BlockLink(hash)
+	if err != nil {
+		return nil, err
+	}
+	links := make(map[string]string)
+	for k, v := range r {
+		links[k] = toHashValue(v)
+	}
+	return &pb.DebugStringMap{Value: links}, nil
+}
+
+func (d *DebugAPI) BlockLinks(ctx context.Context, params *pbtypes.Hash) (*pb.DebugHashesMap, error) {
+	hash, err := toOriginHash(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.BlockLinks(hash)
+	if err != nil {
+		return nil, err
+	}
+	links := make(map[string]*pbtypes.Hashes)
+	for k, v := range r {
+		links[k] = toHashes(v)
+	}
+	return &pb.DebugHashesMap{Value: links}, nil
+}
+
+func (d *DebugAPI) BlocksCountByType(ctx context.Context, params *pb.String) (*pb.DebugInt64Map, error) {
+	r, err := d.debug.BlocksCountByType(toOriginString(params))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugInt64Map{Value: r}, nil
+}
+
+func (d *DebugAPI) GetSyncBlockNum(ctx context.Context, params *empty.Empty) (*pb.DebugUInt64Map, error) {
+	r, err := d.debug.GetSyncBlockNum()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugUInt64Map{Value: r}, nil
+}
+
+func (d *DebugAPI) Representative(ctx context.Context, params *pbtypes.Address) (*pb.APIRepresentative, error) {
+	addr, err := toOriginAddress(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.Representative(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIRepresentative{
+		Address: toAddressValue(r.Address),
+		Balance: toBalanceValue(r.Balance),
+		Vote:    toBalanceValue(r.Vote),
+		Network: toBalanceValue(r.Network),
+		Storage: toBalanceValue(r.Storage),
+		Oracle:  toBalanceValue(r.Oracle),
+		Total:   toBalanceValue(r.Total),
+	}, nil
+}
+
+func (d *DebugAPI) AccountPending(ctx context.Context, params *pb.AccountPendingRequest) (*pb.APIPending, error) {
+	addr, err := toOriginAddressByValue(params.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	hash, err := toOriginHashByValue(params.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.AccountPending(addr, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIPending{
+		Address:   toAddressValue(r.Address),
+		Hash:      toHashValue(r.Hash),
+		Source:    toAddressValue(r.Source),
+		Amount:    toBalanceValue(r.Amount),
+		Type:      toHashValue(r.Type),
+		TokenName: r.TokenName,
+		Timestamp: r.Timestamp,
+	}, nil
+}
+
+func (d *DebugAPI) PendingsAmount(ctx context.Context, params *empty.Empty) (*pb.PendingsAmountResponse, error) {
+	r, err := d.debug.PendingsAmount()
+	if err != nil {
+		return nil, err
+	}
+	amounts := make(map[string]*pb.DebugInt64Map)
+	for addr, tokens := range r {
+		m := make(map[string]int64)
+		for token, amount := range tokens {
+			m[token] = toBalanceValue(amount)
+		}
+		amounts[toAddressValue(addr)] = &pb.DebugInt64Map{Value: m}
+	}
+	return &pb.PendingsAmountResponse{Value: amounts}, nil
+}
+
+func (d *DebugAPI) PendingsCount(ctx context.Context, params *empty.Empty) (*pb.Int64, error) {
+	r, err := d.debug.PendingsCount()
+	if err != nil {
+		return nil, err
+	}
+	return toInt64(int64(r)), nil
+}
+
+func (d *DebugAPI) GetOnlineInfo(ctx context.Context, params *empty.Empty) (*structpb.Struct, error) {
+	r, err := d.debug.GetOnlineInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) GetPovInfo(ctx context.Context, params *empty.Empty) (*structpb.Struct, error) {
+	r, err := d.debug.GetPovInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) ContractCount(ctx context.Context, params *empty.Empty) (*pb.DebugInt64Map, error) {
+	r, err := d.debug.ContractCount()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugInt64Map{Value: r}, nil
+}
+
+func (d *DebugAPI) GetConsInfo(ctx context.Context, params *empty.Empty) (*structpb.Struct, error) {
+	r, err := d.debug.GetConsInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) SetConsPerf(ctx context.Context, params *pb.Int32) (*structpb.Struct, error) {
+	r, err := d.debug.SetConsPerf(int(params.GetValue()))
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) GetConsPerf(ctx context.Context, params *empty.Empty) (*structpb.Struct, error) {
+	r, err := d.debug.GetConsPerf()
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) GetCache(ctx context.Context, params *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.GetCache(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) GetCacheStat(ctx context.Context, params *empty.Empty) (*pb.CacheStats, error) {
+	r := d.debug.GetCacheStat()
+	stats := make([]*pb.CacheStat, 0)
+	for _, c := range r {
+		stats = append(stats, &pb.CacheStat{
+			Index: int32(c.Index),
+			Key:   int32(c.Key),
+			Block: int32(c.Block),
+			Start: c.Start,
+			Span:  c.Span,
+		})
+	}
+	return &pb.CacheStats{Stats: stats}, nil
+}
+
+func (d *DebugAPI) GetCacheStatus(ctx context.Context, params *empty.Empty) (*pb.DebugStringMap, error) {
+	return &pb.DebugStringMap{Value: d.debug.GetCacheStatus()}, nil
+}
+
+func (d *DebugAPI) UncheckAnalysis(ctx context.Context, params *empty.Empty) (*pb.UncheckInfos, error) {
+	r, err := d.debug.UncheckAnalysis()
+	if err != nil {
+		return nil, err
+	}
+	return toUncheckInfos(r), nil
+}
+
+func (d *DebugAPI) UncheckBlock(ctx context.Context, params *pbtypes.Hash) (*pb.UncheckInfos, error) {
+	hash, err := toOriginHash(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.UncheckBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+	return toUncheckInfos(r), nil
+}
+
+func (d *DebugAPI) UncheckBlocks(ctx context.Context, params *empty.Empty) (*pb.APIUncheckBlocks, error) {
+	r, err := d.debug.UncheckBlocks()
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*pb.APIUncheckBlock, 0)
+	for _, b := range r {
+		blocks = append(blocks, &pb.APIUncheckBlock{
+			Block:       toStateBlock(b.Block),
+			Hash:        toHashValue(b.Hash),
+			Link:        toHashValue(b.Link),
+			UncheckType: b.UnCheckType,
+			SyncType:    int32(b.SyncType),
+			PovHeight:   b.Height,
+		})
+	}
+	return &pb.APIUncheckBlocks{Blocks: blocks}, nil
+}
+
+func (d *DebugAPI) UncheckBlocksCount(ctx context.Context, params *empty.Empty) (*pb.DebugInt64Map, error) {
+	r, err := d.debug.UncheckBlocksCount()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64)
+	for k, v := range r {
+		counts[k] = int64(v)
+	}
+	return &pb.DebugInt64Map{Value: counts}, nil
+}
+
+func (d *DebugAPI) FeedConsensus(ctx context.Context, params *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.FeedConsensus(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) DebugConsensus(ctx context.Context, params *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.DebugConsensus(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) GetPrivacyInfo(ctx context.Context, params *empty.Empty) (*structpb.Struct, error) {
+	r, err := d.debug.GetPrivacyInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(r)
+}
+
+func (d *DebugAPI) BadgerTableSize(ctx context.Context, params *pb.KeyPrefixes) (*pb.Int64, error) {
+	prefixes := make([]storage.KeyPrefix, 0)
+	for _, p := range params.GetValue() {
+		prefixes = append(prefixes, storage.KeyPrefix(p))
+	}
+	r, err := d.debug.BadgerTableSize(prefixes)
+	if err != nil {
+		return nil, err
+	}
+	return toInt64(r), nil
+}
+
+// NewBlock sends a mock block every 30 seconds like the json-rpc subscription, until the client cancels the stream
+func (d *DebugAPI) NewBlock(params *empty.Empty, srv pb.DebugAPI_NewBlockServer) error {
+	t := time.NewTicker(30 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := srv.Send(toStateBlock(mock.StateBlock())); err != nil {
+				d.logger.Errorf("notify error: %s", err)
+				return err
+			}
+		case <-srv.Context().Done():
+			return nil
+		}
+	}
+}
+
+func toUncheckInfos(infos []*api.UncheckInfo) *pb.UncheckInfos {
+	r := make([]*pb.UncheckInfo, 0)
+	for _, info := range infos {
+		r = append(r, &pb.UncheckInfo{
+			Hash:      toHashValue(info.Hash),
+			GapType:   info.GapType,
+			GapHash:   toHashValue(info.GapHash),
+			GapHeight: info.GapHeight,
+		})
+	}
+	return &pb.UncheckInfos{Infos: r}
+}
@@ -0,0 +1,70 @@
+package apis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/mock"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+type debugAPINewBlockServer struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (x *debugAPINewBlockServer) Context() context.Context {
+	return x.ctx
+}
+
+func (x *debugAPINewBlockServer) Send(m *pbtypes.StateBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func TestDebugAPI(t *testing.T) {
+	teardownTestCase, l, cfgFile := getTestLedger()
+	defer teardownTestCase()
+
+	blk := mock.StateBlockWithoutWork()
+	if err := l.AddStateBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	debugApi := NewDebugAPI(cfgFile, event.GetEventBus(cfgFile))
+	ctx := context.Background()
+
+	if r, err := debugApi.BlocksCountByType(ctx, toString("address")); err != nil || r.GetValue()[blk.Address.String()] != 1 {
+		t.Fatal(err, r)
+	}
+	if r, err := debugApi.BlockLinks(ctx, toHash(blk.Previous)); err != nil || len(r.GetValue()["child"].GetHashes()) != 1 {
+		t.Fatal(err, r)
+	}
+	if _, err := debugApi.BlockCacheCount(ctx, new(empty.Empty)); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := debugApi.PendingsCount(ctx, new(empty.Empty)); err != nil || r.GetValue() != 0 {
+		t.Fatal(err, r)
+	}
+	if _, err := debugApi.UncheckBlocksCount(ctx, new(empty.Empty)); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := debugApi.ContractCount(ctx, new(empty.Empty)); err != nil || len(r.GetValue()) == 0 {
+		t.Fatal(err, r)
+	}
+	if _, err := debugApi.BadgerTableSize(ctx, &pb.KeyPrefixes{Value: []uint32{1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := debugApi.NewBlock(new(empty.Empty), &debugAPINewBlockServer{ServerStream: new(baseStream), ctx: cctx}); err != nil {
+		t.Fatal(err)
+	}
+}
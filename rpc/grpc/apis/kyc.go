@@ -0,0 +1,216 @@
+// +build testnet
+
+package apis
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+type KYCAPI struct {
+	kyc    *api.KYCApi
+	logger *zap.SugaredLogger
+}
+
+func NewKYCAPI(cfgFile string, l ledger.Store) *KYCAPI {
+	return &KYCAPI{
+		kyc:    api.NewKYCApi(cfgFile, l),
+		logger: log.NewLogger("grpc_kyc"),
+	}
+}
+
+func (k *KYCAPI) GetAdminHandoverBlock(ctx context.Context, params *pb.KYCAdminUpdateParam) (*pbtypes.StateBlock, error) {
+	admin, err := toOriginAddressByValue(params.GetAdmin())
+	if err != nil {
+		return nil, err
+	}
+	successor, err := toOriginAddressByValue(params.GetSuccessor())
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetAdminHandoverBlock(&api.KYCAdminUpdateParam{
+		Admin:     admin,
+		Successor: successor,
+		Comment:   params.GetComment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (k *KYCAPI) GetAdmin(ctx context.Context, params *empty.Empty) (*pb.KYCAdminUser, error) {
+	r, err := k.kyc.GetAdmin()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KYCAdminUser{
+		Account: toAddressValue(r.Account),
+		Comment: r.Comment,
+	}, nil
+}
+
+func (k *KYCAPI) GetUpdateStatusBlock(ctx context.Context, params *pb.KYCUpdateStatusParam) (*pbtypes.StateBlock, error) {
+	operator, err := toOriginAddressByValue(params.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+	chainAddress, err := toOriginAddressByValue(params.GetChainAddress())
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetUpdateStatusBlock(&api.KYCUpdateStatusParam{
+		Operator:     operator,
+		ChainAddress: chainAddress,
+		Status:       params.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (k *KYCAPI) GetStatusCount(ctx context.Context, params *empty.Empty) (*pb.Int32, error) {
+	r := k.kyc.GetStatusCount()
+	return &pb.Int32{
+		Value: int32(r),
+	}, nil
+}
+
+func (k *KYCAPI) GetStatus(ctx context.Context, params *pb.Offset) (*pb.KYCStatusInfos, error) {
+	r, err := k.kyc.GetStatus(int(params.GetCount()), int(params.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*pb.KYCStatusInfo, 0)
+	for _, info := range r {
+		infos = append(infos, toKYCStatusInfo(info))
+	}
+	return &pb.KYCStatusInfos{
+		Infos: infos,
+	}, nil
+}
+
+func (k *KYCAPI) GetStatusByChainAddress(ctx context.Context, params *pbtypes.Address) (*pb.KYCStatusInfo, error) {
+	addr, err := toOriginAddress(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetStatusByChainAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return toKYCStatusInfo(r), nil
+}
+
+func (k *KYCAPI) GetStatusByTradeAddress(ctx context.Context, params *pb.String) (*pb.KYCStatusInfo, error) {
+	r, err := k.kyc.GetStatusByTradeAddress(toOriginString(params))
+	if err != nil {
+		return nil, err
+	}
+	return toKYCStatusInfo(r), nil
+}
+
+func (k *KYCAPI) GetUpdateTradeAddressBlock(ctx context.Context, params *pb.KYCUpdateTradeAddressParam) (*pbtypes.StateBlock, error) {
+	operator, err := toOriginAddressByValue(params.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+	chainAddress, err := toOriginAddressByValue(params.GetChainAddress())
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetUpdateTradeAddressBlock(&api.KYCUpdateTradeAddressParam{
+		Operator:     operator,
+		ChainAddress: chainAddress,
+		Action:       params.GetAction(),
+		TradeAddress: params.GetTradeAddress(),
+		Comment:      params.GetComment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (k *KYCAPI) GetTradeAddress(ctx context.Context, params *pbtypes.Address) (*pb.KYCTradeAddressPack, error) {
+	addr, err := toOriginAddress(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetTradeAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	tas := make([]*pb.KYCTradeAddress, 0)
+	for _, ta := range r.TradeAddress {
+		tas = append(tas, &pb.KYCTradeAddress{
+			Address: ta.Address,
+			Comment: ta.Comment,
+		})
+	}
+	return &pb.KYCTradeAddressPack{
+		ChainAddress: toAddressValue(r.ChainAddress),
+		TradeAddress: tas,
+	}, nil
+}
+
+func (k *KYCAPI) GetUpdateOperatorBlock(ctx context.Context, params *pb.KYCUpdateOperatorParam) (*pbtypes.StateBlock, error) {
+	admin, err := toOriginAddressByValue(params.GetAdmin())
+	if err != nil {
+		return nil, err
+	}
+	operator, err := toOriginAddressByValue(params.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.kyc.GetUpdateOperatorBlock(&api.KYCUpdateOperatorParam{
+		Admin:    admin,
+		Operator: operator,
+		Action:   params.GetAction(),
+		Comment:  params.GetComment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (k *KYCAPI) GetOperatorCount(ctx context.Context, params *empty.Empty) (*pb.Int32, error) {
+	r := k.kyc.GetOperatorCount()
+	return &pb.Int32{
+		Value: int32(r),
+	}, nil
+}
+
+func (k *KYCAPI) GetOperator(ctx context.Context, params *pb.Offset) (*pb.KYCOperatorInfos, error) {
+	r, err := k.kyc.GetOperator(int(params.GetCount()), int(params.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	operators := make([]*pb.KYCOperatorInfo, 0)
+	for _, info := range r {
+		operators = append(operators, &pb.KYCOperatorInfo{
+			Operator: toAddressValue(info.Operator),
+			Comment:  info.Comment,
+		})
+	}
+	return &pb.KYCOperatorInfos{
+		Operators: operators,
+	}, nil
+}
+
+func toKYCStatusInfo(info *api.KYCStatusInfo) *pb.KYCStatusInfo {
+	return &pb.KYCStatusInfo{
+		ChainAddress: toAddressValue(info.ChainAddress),
+		Status:       info.Status,
+	}
+}
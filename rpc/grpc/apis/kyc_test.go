@@ -0,0 +1,164 @@
+// +build testnet
+
+package apis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func addKYCTestData(t *testing.T, l *ledger.Ledger, key []byte, data []byte) {
+	povBlk, povTd := mock.GeneratePovBlockByFakePow(nil, 0)
+	povBlk.Header.BasHdr.Height = 10
+
+	gsdb := statedb.NewPovGlobalStateDB(l.DBStore(), types.ZeroHash)
+	csdb, err := gsdb.LookupContractStateDB(contractaddress.KYCAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := csdb.SetValue(key, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	povBlk.Header.CbTx.StateHash = gsdb.GetCurHash()
+	mock.UpdatePovHash(povBlk)
+	if err := l.AddPovBlock(povBlk, povTd); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddPovBestHash(povBlk.GetHeight(), povBlk.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetPovLatestHeight(povBlk.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKYCAPI_GetAdmin(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	p := NewKYCAPI(cfgFile, l)
+	if _, err := p.GetAdmin(context.Background(), nil); err == nil {
+		t.Fatal()
+	}
+
+	admin := &abi.KYCAdminAccount{Account: mock.Address(), Comment: "admin", Valid: true}
+	data, err := admin.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addKYCTestData(t, l, statedb.PovCreateContractLocalStateKey(abi.KYCDataAdmin, admin.Account.Bytes()), data)
+
+	r, err := p.GetAdmin(context.Background(), nil)
+	if err != nil || r.GetAccount() != admin.Account.String() || r.GetComment() != admin.Comment {
+		t.Fatal(err, r)
+	}
+}
+
+func TestKYCAPI_GetStatus(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	p := NewKYCAPI(cfgFile, l)
+	if r, _ := p.GetStatusCount(context.Background(), nil); r.GetValue() != 0 {
+		t.Fatal()
+	}
+
+	ks := &abi.KYCStatus{ChainAddress: mock.Address(), Status: "KYC_STATUS_APPROVED", Valid: true}
+	if _, err := p.GetStatusByChainAddress(context.Background(), toAddress(ks.ChainAddress)); err == nil {
+		t.Fatal()
+	}
+	data, err := ks.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addKYCTestData(t, l, statedb.PovCreateContractLocalStateKey(abi.KYCDataStatus, ks.ChainAddress.Bytes()), data)
+
+	if r, _ := p.GetStatusCount(context.Background(), nil); r.GetValue() != 1 {
+		t.Fatal()
+	}
+	infos, err := p.GetStatus(context.Background(), &pb.Offset{Count: 10})
+	if err != nil || len(infos.GetInfos()) != 1 || infos.GetInfos()[0].GetChainAddress() != ks.ChainAddress.String() {
+		t.Fatal(err, infos)
+	}
+	info, err := p.GetStatusByChainAddress(context.Background(), toAddress(ks.ChainAddress))
+	if err != nil || info.GetStatus() != ks.Status {
+		t.Fatal(err, info)
+	}
+}
+
+func TestKYCAPI_GetOperator(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	p := NewKYCAPI(cfgFile, l)
+	if _, err := p.GetOperator(context.Background(), &pb.Offset{Count: 10}); err == nil {
+		t.Fatal()
+	}
+
+	koa := &abi.KYCOperatorAccount{Account: mock.Address(), Action: abi.KYCActionAdd, Comment: "op1", Valid: true}
+	data, err := koa.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addKYCTestData(t, l, statedb.PovCreateContractLocalStateKey(abi.KYCDataOperator, koa.Account.Bytes()), data)
+
+	if r, _ := p.GetOperatorCount(context.Background(), nil); r.GetValue() != 1 {
+		t.Fatal()
+	}
+	ops, err := p.GetOperator(context.Background(), &pb.Offset{Count: 10})
+	if err != nil || len(ops.GetOperators()) != 1 || ops.GetOperators()[0].GetOperator() != koa.Account.String() {
+		t.Fatal(err, ops)
+	}
+}
+
+func TestKYCAPI_GetUpdateTradeAddressBlock(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	p := NewKYCAPI(cfgFile, l)
+	if _, err := p.GetUpdateTradeAddressBlock(context.Background(), &pb.KYCUpdateTradeAddressParam{
+		Operator:     mock.Address().String(),
+		ChainAddress: mock.Address().String(),
+		Action:       "invalid",
+	}); err == nil {
+		t.Fatal()
+	}
+	if _, err := p.GetUpdateOperatorBlock(context.Background(), &pb.KYCUpdateOperatorParam{
+		Admin:    "invalid",
+		Operator: mock.Address().String(),
+		Action:   "add",
+	}); err == nil {
+		t.Fatal()
+	}
+}
@@ -173,6 +173,43 @@ func (l *LedgerAPI) AccountsBalance(ctx context.Context, addresses *pbtypes.Addr
 	return &pb.AccountsBalanceRsp{AccountsBalances: result}, nil
 }
 
+func (l *LedgerAPI) AccountBalanceAt(ctx context.Context, param *pb.AccountBalanceAtReq) (*pb.APIBalanceRecord, error) {
+	address, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	token, err := toOriginHashByValue(param.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	r, err := l.ledger.AccountBalanceAt(address, token, param.GetTimestamp(), param.GetPovHeight())
+	if err != nil {
+		return nil, err
+	}
+	return toAPIBalanceRecord(r), nil
+}
+
+func (l *LedgerAPI) AccountBalanceHistory(ctx context.Context, param *pb.AccountBalanceHistoryReq) (*pb.APIBalanceRecords, error) {
+	address, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	token, err := toOriginHashByValue(param.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	count, offset := toOffsetByValue(param.GetCount(), param.GetOffset())
+	r, err := l.ledger.AccountBalanceHistory(address, token, count, offset)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*pb.APIBalanceRecord, 0)
+	for _, b := range r {
+		records = append(records, toAPIBalanceRecord(b))
+	}
+	return &pb.APIBalanceRecords{Records: records}, nil
+}
+
 func (l *LedgerAPI) AccountsFrontiers(ctx context.Context, addresses *pbtypes.Addresses) (*pb.AccountsFrontiersRsp, error) {
 	addrs, err := toOriginAddresses(addresses)
 	if err != nil {
@@ -329,6 +366,56 @@ func (l *LedgerAPI) BlocksCountByType(context.Context, *empty.Empty) (*pb.Blocks
 	}, nil
 }
 
+func (l *LedgerAPI) SearchBlocks(ctx context.Context, para *pb.SearchBlocksReq) (*pb.APIBlocksPage, error) {
+	query := &api.APIBlockQuery{
+		StartTime: para.GetStartTime(),
+		EndTime:   para.GetEndTime(),
+		Method:    para.GetMethod(),
+		Cursor:    para.GetCursor(),
+		Count:     int(para.GetCount()),
+	}
+	for _, t := range para.GetTypes() {
+		query.Types = append(query.Types, toOriginBlockValue(t))
+	}
+	var err error
+	if para.GetToken() != "" {
+		if query.Token, err = toOriginHashByValue(para.GetToken()); err != nil {
+			return nil, err
+		}
+	}
+	if para.GetAddress() != "" {
+		if query.Address, err = toOriginAddressByValue(para.GetAddress()); err != nil {
+			return nil, err
+		}
+	}
+	if para.GetLink() != "" {
+		if query.Link, err = toOriginHashByValue(para.GetLink()); err != nil {
+			return nil, err
+		}
+	}
+	if para.GetRepresentative() != "" {
+		if query.Representative, err = toOriginAddressByValue(para.GetRepresentative()); err != nil {
+			return nil, err
+		}
+	}
+	if para.GetMinAmount() > 0 {
+		amount := toOriginBalanceByValue(para.GetMinAmount())
+		query.MinAmount = &amount
+	}
+	if para.GetMaxAmount() > 0 {
+		amount := toOriginBalanceByValue(para.GetMaxAmount())
+		query.MaxAmount = &amount
+	}
+	r, err := l.ledger.SearchBlocks(query)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIBlocksPage{
+		Blocks: toAPIBlocks(r.Blocks).GetBlocks(),
+		Cursor: r.Cursor,
+	}, nil
+}
+
 func (l *LedgerAPI) Chain(ctx context.Context, para *pb.ChainReq) (*pbtypes.Hashes, error) {
 	hash, err := toOriginHashByValue(para.GetHash())
 	if err != nil {
@@ -640,83 +727,80 @@ func getReqId() string {
 }
 
 func (l *LedgerAPI) NewBlock(tx *empty.Empty, srv pb.LedgerAPI_NewBlockServer) error {
-	go func() {
-		id := getReqId()
-		ch := make(chan struct{})
-		l.logger.Infof("subscription block done, %s", id)
-		l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
-		defer l.pubsub.RemoveChan(id)
-		for {
-			select {
-			case <-ch:
-				l.logger.Debug("to publish block")
-				blocks := l.pubsub.FetchBlocks(id)
-				if len(blocks) == 0 {
-					continue
-				}
+	id := getReqId()
+	ch := make(chan struct{})
+	l.logger.Infof("subscription block done, %s", id)
+	l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
+	defer l.pubsub.RemoveChan(id)
+	for {
+		select {
+		case <-ch:
+			l.logger.Debug("to publish block")
+			blocks := l.pubsub.FetchBlocks(id)
+			if len(blocks) == 0 {
+				continue
+			}
 
-				latestPov, _ := l.store.GetLatestPovHeader()
+			latestPov, _ := l.store.GetLatestPovHeader()
 
-				for _, block := range blocks {
-					apiBlk, err := api.GenerateAPIBlock(l.store, block, latestPov)
-					if err != nil {
-						l.logger.Errorf("generateAPIBlock error: %s", err)
-						continue
-					}
-					l.logger.Debugf("send block [%s]", apiBlk.GetHash())
-					if err := srv.Send(toAPIBlock(apiBlk)); err != nil {
-						l.logger.Errorf("notify block error: %s", err)
-						return
-					}
+			for _, block := range blocks {
+				apiBlk, err := api.GenerateAPIBlock(l.store, block, latestPov)
+				if err != nil {
+					l.logger.Errorf("generateAPIBlock error: %s", err)
+					continue
+				}
+				l.logger.Debugf("send block [%s]", apiBlk.GetHash())
+				if err := srv.Send(toAPIBlock(apiBlk)); err != nil {
+					l.logger.Errorf("notify block error: %s", err)
+					return err
 				}
-			case <-srv.Context().Done():
-				l.logger.Infof("subscription block finished, %s ", id)
-				return
 			}
+		case <-srv.Context().Done():
+			l.logger.Infof("subscription block finished, %s ", id)
+			return nil
 		}
-	}()
-	return nil
+	}
 }
 
 func (l *LedgerAPI) NewAccountBlock(addr *pbtypes.Address, srv pb.LedgerAPI_NewAccountBlockServer) error {
-	go func() {
-		id := getReqId()
-		ch := make(chan struct{})
-		l.logger.Infof("subscription account block done, %s", id)
-		l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
-		defer l.pubsub.RemoveChan(id)
-
-		for {
-			select {
-			case <-ch:
-				l.logger.Debug("to publish account block")
-				blocks := l.pubsub.FetchBlocks(id)
-				if len(blocks) == 0 {
-					continue
-				}
+	address, err := toOriginAddress(addr)
+	if err != nil {
+		return err
+	}
+	id := getReqId()
+	ch := make(chan struct{})
+	l.logger.Infof("subscription account block done, %s", id)
+	l.pubsub.AddChan(id, address, true, ch)
+	defer l.pubsub.RemoveChan(id)
+
+	for {
+		select {
+		case <-ch:
+			l.logger.Debug("to publish account block")
+			blocks := l.pubsub.FetchBlocks(id)
+			if len(blocks) == 0 {
+				continue
+			}
 
-				latestPov, _ := l.store.GetLatestPovHeader()
+			latestPov, _ := l.store.GetLatestPovHeader()
 
-				for _, block := range blocks {
-					apiBlk, err := api.GenerateAPIBlock(l.store, block, latestPov)
-					if err != nil {
-						l.logger.Errorf("generateAPIBlock error: %s", err)
-						continue
-					}
-					l.logger.Debugf("send account block [%s:%s]", addr, apiBlk.GetHash())
-					if err := srv.Send(toAPIBlock(apiBlk)); err != nil {
-						l.logger.Errorf("notify account block error: %s", err)
-						return
-					}
+			for _, block := range blocks {
+				apiBlk, err := api.GenerateAPIBlock(l.store, block, latestPov)
+				if err != nil {
+					l.logger.Errorf("generateAPIBlock error: %s", err)
+					continue
+				}
+				l.logger.Debugf("send account block [%s:%s]", addr, apiBlk.GetHash())
+				if err := srv.Send(toAPIBlock(apiBlk)); err != nil {
+					l.logger.Errorf("notify account block error: %s", err)
+					return err
 				}
-			case <-srv.Context().Done():
-				l.logger.Infof("subscription account block finished, %s ", id)
-				return
 			}
+		case <-srv.Context().Done():
+			l.logger.Infof("subscription account block finished, %s ", id)
+			return nil
 		}
-	}()
-	return nil
-
+	}
 }
 
 func (l *LedgerAPI) BalanceChange(addr *pbtypes.Address, srv pb.LedgerAPI_BalanceChangeServer) error {
@@ -724,45 +808,42 @@ func (l *LedgerAPI) BalanceChange(addr *pbtypes.Address, srv pb.LedgerAPI_Balanc
 	if err != nil {
 		return err
 	}
-	go func() {
-		id := getReqId()
-		ch := make(chan struct{})
-		l.logger.Infof("subscription balance change done, %s", id)
-		l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
-		defer l.pubsub.RemoveChan(id)
-		for {
-			select {
-			case <-ch:
-				l.logger.Debug("to publish balance")
-				block := l.pubsub.FetchAddrBlock(id)
-				if block == nil {
+	id := getReqId()
+	ch := make(chan struct{})
+	l.logger.Infof("subscription balance change done, %s", id)
+	l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
+	defer l.pubsub.RemoveChan(id)
+	for {
+		select {
+		case <-ch:
+			l.logger.Debug("to publish balance")
+			block := l.pubsub.FetchAddrBlock(id)
+			if block == nil {
+				continue
+			}
+
+			if block.GetAddress() == address {
+				am, err := l.store.GetAccountMeta(address)
+				if err != nil {
+					l.logger.Errorf("get account meta: %s", err)
 					continue
 				}
-
-				if block.GetAddress() == address {
-					am, err := l.store.GetAccountMeta(address)
-					if err != nil {
-						l.logger.Errorf("get account meta: %s", err)
-						continue
-					}
-					aa, err := api.GenerateAPIAccountMeta(l.store, am)
-					if err != nil {
-						l.logger.Errorf("generate APIAccountMeta error: %s", err)
-						continue
-					}
-					l.logger.Debugf("send balance [%s]", aa.Address.String())
-					if err := srv.Send(toAPIAccount(aa)); err != nil {
-						l.logger.Errorf("notify balance change error: %s", err)
-						return
-					}
+				aa, err := api.GenerateAPIAccountMeta(l.store, am)
+				if err != nil {
+					l.logger.Errorf("generate APIAccountMeta error: %s", err)
+					continue
+				}
+				l.logger.Debugf("send balance [%s]", aa.Address.String())
+				if err := srv.Send(toAPIAccount(aa)); err != nil {
+					l.logger.Errorf("notify balance change error: %s", err)
+					return err
 				}
-			case <-srv.Context().Done():
-				l.logger.Infof("subscription balance change finished, %s ", id)
-				return
 			}
+		case <-srv.Context().Done():
+			l.logger.Infof("subscription balance change finished, %s ", id)
+			return nil
 		}
-	}()
-	return nil
+	}
 }
 
 func (l *LedgerAPI) NewPending(addr *pbtypes.Address, srv pb.LedgerAPI_NewPendingServer) error {
@@ -770,66 +851,63 @@ func (l *LedgerAPI) NewPending(addr *pbtypes.Address, srv pb.LedgerAPI_NewPendin
 	if err != nil {
 		return err
 	}
-	go func() {
-		id := getReqId()
-		ch := make(chan struct{})
-		l.logger.Infof("subscription new pending done, %s", id)
-		l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
-		defer l.pubsub.RemoveChan(id)
-
-		for {
-			select {
-			case <-ch:
-				l.logger.Debug("to publish pending")
-				blocks := l.pubsub.FetchBlocks(id)
-				if len(blocks) == 0 {
-					continue
-				}
+	id := getReqId()
+	ch := make(chan struct{})
+	l.logger.Infof("subscription new pending done, %s", id)
+	l.pubsub.AddChan(id, types.ZeroAddress, true, ch)
+	defer l.pubsub.RemoveChan(id)
+
+	for {
+		select {
+		case <-ch:
+			l.logger.Debug("to publish pending")
+			blocks := l.pubsub.FetchBlocks(id)
+			if len(blocks) == 0 {
+				continue
+			}
+
+			for _, block := range blocks {
+				if block.IsSendBlock() {
+					if block.Type == types.Send && block.GetLink() != types.Hash(address) {
+						continue
+					}
+					pk := &types.PendingKey{
+						Address: address,
+						Hash:    block.GetHash(),
+					}
+					if pi, _ := l.store.GetPending(pk); pi != nil {
+						token, err := l.store.GetTokenById(pi.Type)
+						if err != nil {
+							l.logger.Errorf("get token info: %s", err)
+							continue
+						}
 
-				for _, block := range blocks {
-					if block.IsSendBlock() {
-						if block.Type == types.Send && block.GetLink() != types.Hash(address) {
+						blk, err := l.store.GetStateBlockConfirmed(pk.Hash)
+						if err != nil {
+							l.logger.Errorf("get block info: %s", err)
 							continue
 						}
-						pk := &types.PendingKey{
-							Address: address,
-							Hash:    block.GetHash(),
+
+						ap := &api.APIPending{
+							PendingKey:  pk,
+							PendingInfo: pi,
+							TokenName:   token.TokenName,
+							Timestamp:   blk.Timestamp,
+							BlockType:   blk.GetType(),
 						}
-						if pi, _ := l.store.GetPending(pk); pi != nil {
-							token, err := l.store.GetTokenById(pi.Type)
-							if err != nil {
-								l.logger.Errorf("get token info: %s", err)
-								continue
-							}
-
-							blk, err := l.store.GetStateBlockConfirmed(pk.Hash)
-							if err != nil {
-								l.logger.Errorf("get block info: %s", err)
-								continue
-							}
-
-							ap := &api.APIPending{
-								PendingKey:  pk,
-								PendingInfo: pi,
-								TokenName:   token.TokenName,
-								Timestamp:   blk.Timestamp,
-								BlockType:   blk.GetType(),
-							}
-							l.logger.Debugf("send pending [%s]", ap.Address)
-							if err := srv.Send(toAPIPending(ap)); err != nil {
-								l.logger.Errorf("notify new pending error: %s", err)
-								return
-							}
+						l.logger.Debugf("send pending [%s]", ap.Address)
+						if err := srv.Send(toAPIPending(ap)); err != nil {
+							l.logger.Errorf("notify new pending error: %s", err)
+							return err
 						}
 					}
 				}
-			case <-srv.Context().Done():
-				l.logger.Infof("subscription new pending finished, %s ", id)
-				return
 			}
+		case <-srv.Context().Done():
+			l.logger.Infof("subscription new pending finished, %s ", id)
+			return nil
 		}
-	}()
-	return nil
+	}
 }
 
 func toAPIBlock(blk *api.APIBlock) *pb.APIBlock {
@@ -876,6 +954,19 @@ func toAPIBlocks(blks []*api.APIBlock) *pb.APIBlocks {
 	return &pb.APIBlocks{Blocks: blk}
 }
 
+func toAPIBalanceRecord(r *api.APIBalanceRecord) *pb.APIBalanceRecord {
+	return &pb.APIBalanceRecord{
+		Address:   toAddressValue(r.Address),
+		Token:     toHashValue(r.Token),
+		TokenName: r.TokenName,
+		Balance:   toBalanceValue(r.Balance),
+		Hash:      toHashValue(r.Hash),
+		Type:      toBlockTypeValue(r.Type),
+		Timestamp: r.Timestamp,
+		PovHeight: r.PoVHeight,
+	}
+}
+
 func toAPIAccount(acc *api.APIAccount) *pb.APIAccount {
 	tms := make([]*pb.APITokenMeta, 0)
 	for _, tm := range acc.Tokens {
@@ -157,6 +157,55 @@ func TestLedgerAPI_AccountHistoryTopn(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_AccountBalanceHistory(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	am, err := l.GetAccountMetaConfirmed(account1.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := am.Tokens[0]
+	r, err := ledgerApi.AccountBalanceHistory(context.Background(), &pb.AccountBalanceHistoryReq{
+		Address: account1.Address().String(),
+		Token:   tm.Type.String(),
+		Count:   100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.GetRecords()) != int(tm.BlockCount) {
+		t.Fatal()
+	}
+	b, err := ledgerApi.AccountBalanceAt(context.Background(), &pb.AccountBalanceAtReq{
+		Address:   account1.Address().String(),
+		Token:     tm.Type.String(),
+		Timestamp: r.GetRecords()[0].GetTimestamp(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.GetHash() != tm.Header.String() {
+		t.Fatal()
+	}
+}
+
+func TestLedgerAPI_SearchBlocks(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	time.Sleep(1 * time.Second)
+	r, err := ledgerApi.SearchBlocks(context.Background(), &pb.SearchBlocksReq{
+		Address: account1.Address().String(),
+		Types:   []string{"Open", "Send"},
+		Count:   100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.GetBlocks()) != 4 || r.GetCursor() != "" {
+		t.Fatal()
+	}
+}
+
 func TestLedgerAPI_AccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -901,26 +950,26 @@ func TestLedgerAPI_NewBlock(t *testing.T) {
 		}
 	}()
 
-	err := ledgerApi.NewBlock(new(empty.Empty), &ledgerAPINewBlockServer{ServerStream: new(baseStream)})
-	if err != nil {
-		t.Fatal(err)
-	}
-	err = ledgerApi.NewAccountBlock(toAddress(ac1.Address()), &ledgerAPINewBlockServer{ServerStream: new(baseStream)})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = ledgerApi.BalanceChange(toAddress(ac1.Address()), &ledgerAPIBalanceChangeServer{ServerStream: new(baseStream)})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = ledgerApi.NewPending(toAddress(ac2.Address()), &ledgerAPINewPendingServer{ServerStream: new(baseStream)})
-	if err != nil {
-		t.Fatal(err)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	errs := make(chan error, 4)
+	go func() {
+		errs <- ledgerApi.NewBlock(new(empty.Empty), &ledgerAPINewBlockServer{ServerStream: &baseStream{ctx: ctx}})
+	}()
+	go func() {
+		errs <- ledgerApi.NewAccountBlock(toAddress(ac1.Address()), &ledgerAPINewBlockServer{ServerStream: &baseStream{ctx: ctx}})
+	}()
+	go func() {
+		errs <- ledgerApi.BalanceChange(toAddress(ac1.Address()), &ledgerAPIBalanceChangeServer{ServerStream: &baseStream{ctx: ctx}})
+	}()
+	go func() {
+		errs <- ledgerApi.NewPending(toAddress(ac2.Address()), &ledgerAPINewPendingServer{ServerStream: &baseStream{ctx: ctx}})
+	}()
+	for i := 0; i < 4; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
 	}
-
-	time.Sleep(3 * time.Second)
 }
 
 type ledgerAPINewBlockServer struct {
@@ -948,6 +997,7 @@ func (x *ledgerAPINewPendingServer) Send(m *pb.APIPending) error {
 }
 
 type baseStream struct {
+	ctx context.Context
 }
 
 func (s *baseStream) SetHeader(metadata.MD) error {
@@ -963,6 +1013,9 @@ func (s *baseStream) SetTrailer(metadata.MD) {
 }
 
 func (s *baseStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
 	return context.Background()
 }
 
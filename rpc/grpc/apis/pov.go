@@ -426,39 +426,36 @@ func (p *PovAPI) GetLastNHourInfo(ctx context.Context, param *pb.LastNHourInfoRe
 //}
 
 func (p *PovAPI) NewBlock(em *empty.Empty, srv pb.PovAPI_NewBlockServer) error {
-	go func() {
-		id := getReqId()
-		ch := make(chan struct{})
-		p.logger.Infof("subscription pov block done, %s", id)
-		p.pubsub.AddChan(id, ch)
-		defer p.pubsub.RemoveChan(id)
-
-		for {
-			select {
-			case <-ch:
-				p.logger.Debug("to publish pov block")
-				blocks := p.pubsub.FetchBlocks(id)
-
-				for _, block := range blocks {
-					header := block.GetHeader()
-					apiHdr := &api.PovApiHeader{PovHeader: header}
-					api.FillHeader(apiHdr)
-
-					p.logger.Debugf("send pov block %s", apiHdr.GetHash())
-					if err := srv.Send(toPovApiHeader(apiHdr)); err != nil {
-						p.logger.Errorf("notify pov header %d/%s error: %s",
-							err, header.GetHeight(), header.GetHash())
-						return
-					}
-
+	id := getReqId()
+	ch := make(chan struct{})
+	p.logger.Infof("subscription pov block done, %s", id)
+	p.pubsub.AddChan(id, ch)
+	defer p.pubsub.RemoveChan(id)
+
+	for {
+		select {
+		case <-ch:
+			p.logger.Debug("to publish pov block")
+			blocks := p.pubsub.FetchBlocks(id)
+
+			for _, block := range blocks {
+				header := block.GetHeader()
+				apiHdr := &api.PovApiHeader{PovHeader: header}
+				api.FillHeader(apiHdr)
+
+				p.logger.Debugf("send pov block %s", apiHdr.GetHash())
+				if err := srv.Send(toPovApiHeader(apiHdr)); err != nil {
+					p.logger.Errorf("notify pov header %d/%s error: %s",
+						err, header.GetHeight(), header.GetHash())
+					return err
 				}
-			case <-srv.Context().Done():
-				p.logger.Infof("subscription  pov block finished, %s ", id)
-				return
+
 			}
+		case <-srv.Context().Done():
+			p.logger.Infof("subscription  pov block finished, %s ", id)
+			return nil
 		}
-	}()
-	return nil
+	}
 }
 
 func toPovStatus(s *api.PovStatus) *pb.PovStatus {
@@ -522,11 +522,12 @@ func TestPovAPI_NewBlock(t *testing.T) {
 	//	}
 	//}()
 
-	err := md.api.NewBlock(new(empty.Empty), &povAPINewBlockServer{ServerStream: new(baseStream)})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := md.api.NewBlock(new(empty.Empty), &povAPINewBlockServer{ServerStream: &baseStream{ctx: ctx}})
 	if err != nil {
 		t.Fatal(err)
 	}
-	time.Sleep(1 * time.Second)
 }
 
 type povAPINewBlockServer struct {
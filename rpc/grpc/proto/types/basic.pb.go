@@ -218,29 +218,30 @@ type StateBlock struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type           string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Token          string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Address        string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Balance        int64    `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	Vote           int64    `protobuf:"varint,5,opt,name=vote,proto3" json:"vote,omitempty"`
-	Network        int64    `protobuf:"varint,6,opt,name=network,proto3" json:"network,omitempty"`
-	Storage        int64    `protobuf:"varint,7,opt,name=storage,proto3" json:"storage,omitempty"`
-	Oracle         int64    `protobuf:"varint,8,opt,name=oracle,proto3" json:"oracle,omitempty"`
-	Previous       string   `protobuf:"bytes,9,opt,name=previous,proto3" json:"previous,omitempty"`
-	Link           string   `protobuf:"bytes,10,opt,name=link,proto3" json:"link,omitempty"`
-	Sender         []byte   `protobuf:"bytes,11,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver       []byte   `protobuf:"bytes,12,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Message        string   `protobuf:"bytes,13,opt,name=message,proto3" json:"message,omitempty"`
-	Data           []byte   `protobuf:"bytes,14,opt,name=data,proto3" json:"data,omitempty"`
-	PoVHeight      uint64   `protobuf:"varint,15,opt,name=poVHeight,proto3" json:"poVHeight,omitempty"`
-	Timestamp      int64    `protobuf:"varint,16,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Extra          string   `protobuf:"bytes,17,opt,name=extra,proto3" json:"extra,omitempty"`
-	Representative string   `protobuf:"bytes,18,opt,name=representative,proto3" json:"representative,omitempty"`
-	PrivateFrom    string   `protobuf:"bytes,19,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
-	PrivateFor     []string `protobuf:"bytes,20,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
-	PrivateGroupID string   `protobuf:"bytes,21,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
-	Work           uint64   `protobuf:"varint,22,opt,name=work,proto3" json:"work,omitempty"`
-	Signature      string   `protobuf:"bytes,23,opt,name=signature,proto3" json:"signature,omitempty"`
+	Type           string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Token          string    `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Address        string    `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Balance        int64     `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Vote           int64     `protobuf:"varint,5,opt,name=vote,proto3" json:"vote,omitempty"`
+	Network        int64     `protobuf:"varint,6,opt,name=network,proto3" json:"network,omitempty"`
+	Storage        int64     `protobuf:"varint,7,opt,name=storage,proto3" json:"storage,omitempty"`
+	Oracle         int64     `protobuf:"varint,8,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	Previous       string    `protobuf:"bytes,9,opt,name=previous,proto3" json:"previous,omitempty"`
+	Link           string    `protobuf:"bytes,10,opt,name=link,proto3" json:"link,omitempty"`
+	Sender         []byte    `protobuf:"bytes,11,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver       []byte    `protobuf:"bytes,12,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Message        string    `protobuf:"bytes,13,opt,name=message,proto3" json:"message,omitempty"`
+	Data           []byte    `protobuf:"bytes,14,opt,name=data,proto3" json:"data,omitempty"`
+	PoVHeight      uint64    `protobuf:"varint,15,opt,name=poVHeight,proto3" json:"poVHeight,omitempty"`
+	Timestamp      int64     `protobuf:"varint,16,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Extra          string    `protobuf:"bytes,17,opt,name=extra,proto3" json:"extra,omitempty"`
+	Representative string    `protobuf:"bytes,18,opt,name=representative,proto3" json:"representative,omitempty"`
+	PrivateFrom    string    `protobuf:"bytes,19,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string  `protobuf:"bytes,20,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string    `protobuf:"bytes,21,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+	Work           uint64    `protobuf:"varint,22,opt,name=work,proto3" json:"work,omitempty"`
+	Signature      string    `protobuf:"bytes,23,opt,name=signature,proto3" json:"signature,omitempty"`
+	MultiSig       *MultiSig `protobuf:"bytes,27,opt,name=multiSig,proto3" json:"multiSig,omitempty"`
 }
 
 func (x *StateBlock) Reset() {
@@ -436,6 +437,131 @@ func (x *StateBlock) GetSignature() string {
 	return ""
 }
 
+func (x *StateBlock) GetMultiSig() *MultiSig {
+	if x != nil {
+		return x.MultiSig
+	}
+	return nil
+}
+
+type MultiSig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold  uint32            `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Owners     []string          `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	Signatures []*OwnerSignature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MultiSig) Reset() {
+	*x = MultiSig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_basic_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSig) ProtoMessage() {}
+
+func (x *MultiSig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_basic_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSig.ProtoReflect.Descriptor instead.
+func (*MultiSig) Descriptor() ([]byte, []int) {
+	return file_types_basic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MultiSig) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *MultiSig) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *MultiSig) GetSignatures() []*OwnerSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type OwnerSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *OwnerSignature) Reset() {
+	*x = OwnerSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_basic_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnerSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerSignature) ProtoMessage() {}
+
+func (x *OwnerSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_types_basic_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerSignature.ProtoReflect.Descriptor instead.
+func (*OwnerSignature) Descriptor() ([]byte, []int) {
+	return file_types_basic_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OwnerSignature) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 type TokenMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -454,7 +580,7 @@ type TokenMeta struct {
 func (x *TokenMeta) Reset() {
 	*x = TokenMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[5]
+		mi := &file_types_basic_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +593,7 @@ func (x *TokenMeta) String() string {
 func (*TokenMeta) ProtoMessage() {}
 
 func (x *TokenMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[5]
+	mi := &file_types_basic_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +606,7 @@ func (x *TokenMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenMeta.ProtoReflect.Descriptor instead.
 func (*TokenMeta) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{5}
+	return file_types_basic_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TokenMeta) GetType() string {
@@ -556,7 +682,7 @@ type AccountMeta struct {
 func (x *AccountMeta) Reset() {
 	*x = AccountMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[6]
+		mi := &file_types_basic_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +695,7 @@ func (x *AccountMeta) String() string {
 func (*AccountMeta) ProtoMessage() {}
 
 func (x *AccountMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[6]
+	mi := &file_types_basic_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +708,7 @@ func (x *AccountMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountMeta.ProtoReflect.Descriptor instead.
 func (*AccountMeta) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{6}
+	return file_types_basic_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AccountMeta) GetAddress() string {
@@ -650,7 +776,7 @@ type Benefit struct {
 func (x *Benefit) Reset() {
 	*x = Benefit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[7]
+		mi := &file_types_basic_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +789,7 @@ func (x *Benefit) String() string {
 func (*Benefit) ProtoMessage() {}
 
 func (x *Benefit) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[7]
+	mi := &file_types_basic_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +802,7 @@ func (x *Benefit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Benefit.ProtoReflect.Descriptor instead.
 func (*Benefit) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{7}
+	return file_types_basic_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Benefit) GetBalance() int64 {
@@ -741,7 +867,7 @@ type TokenInfo struct {
 func (x *TokenInfo) Reset() {
 	*x = TokenInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[8]
+		mi := &file_types_basic_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -754,7 +880,7 @@ func (x *TokenInfo) String() string {
 func (*TokenInfo) ProtoMessage() {}
 
 func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[8]
+	mi := &file_types_basic_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,7 +893,7 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{8}
+	return file_types_basic_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TokenInfo) GetTokenId() string {
@@ -852,7 +978,7 @@ type PendingKey struct {
 func (x *PendingKey) Reset() {
 	*x = PendingKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[9]
+		mi := &file_types_basic_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +991,7 @@ func (x *PendingKey) String() string {
 func (*PendingKey) ProtoMessage() {}
 
 func (x *PendingKey) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[9]
+	mi := &file_types_basic_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1004,7 @@ func (x *PendingKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingKey.ProtoReflect.Descriptor instead.
 func (*PendingKey) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{9}
+	return file_types_basic_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PendingKey) GetAddress() string {
@@ -895,7 +1021,6 @@ func (x *PendingKey) GetHash() string {
 	return ""
 }
 
-//go:generate msgp
 type PendingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -909,7 +1034,7 @@ type PendingInfo struct {
 func (x *PendingInfo) Reset() {
 	*x = PendingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[10]
+		mi := &file_types_basic_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -922,7 +1047,7 @@ func (x *PendingInfo) String() string {
 func (*PendingInfo) ProtoMessage() {}
 
 func (x *PendingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[10]
+	mi := &file_types_basic_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1060,7 @@ func (x *PendingInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingInfo.ProtoReflect.Descriptor instead.
 func (*PendingInfo) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{10}
+	return file_types_basic_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PendingInfo) GetSource() string {
@@ -970,7 +1095,7 @@ type StateBlocks struct {
 func (x *StateBlocks) Reset() {
 	*x = StateBlocks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[11]
+		mi := &file_types_basic_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1108,7 @@ func (x *StateBlocks) String() string {
 func (*StateBlocks) ProtoMessage() {}
 
 func (x *StateBlocks) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[11]
+	mi := &file_types_basic_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1121,7 @@ func (x *StateBlocks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateBlocks.ProtoReflect.Descriptor instead.
 func (*StateBlocks) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{11}
+	return file_types_basic_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StateBlocks) GetStateBlocks() []*StateBlock {
@@ -1017,7 +1142,7 @@ type TokenInfos struct {
 func (x *TokenInfos) Reset() {
 	*x = TokenInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[12]
+		mi := &file_types_basic_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1030,7 +1155,7 @@ func (x *TokenInfos) String() string {
 func (*TokenInfos) ProtoMessage() {}
 
 func (x *TokenInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[12]
+	mi := &file_types_basic_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,7 +1168,7 @@ func (x *TokenInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfos.ProtoReflect.Descriptor instead.
 func (*TokenInfos) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{12}
+	return file_types_basic_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TokenInfos) GetTokenInfos() []*TokenInfo {
@@ -1064,7 +1189,7 @@ type Addresses struct {
 func (x *Addresses) Reset() {
 	*x = Addresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[13]
+		mi := &file_types_basic_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1077,7 +1202,7 @@ func (x *Addresses) String() string {
 func (*Addresses) ProtoMessage() {}
 
 func (x *Addresses) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[13]
+	mi := &file_types_basic_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1215,7 @@ func (x *Addresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Addresses.ProtoReflect.Descriptor instead.
 func (*Addresses) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{13}
+	return file_types_basic_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Addresses) GetAddresses() []string {
@@ -1111,7 +1236,7 @@ type Hashes struct {
 func (x *Hashes) Reset() {
 	*x = Hashes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_basic_proto_msgTypes[14]
+		mi := &file_types_basic_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1249,7 @@ func (x *Hashes) String() string {
 func (*Hashes) ProtoMessage() {}
 
 func (x *Hashes) ProtoReflect() protoreflect.Message {
-	mi := &file_types_basic_proto_msgTypes[14]
+	mi := &file_types_basic_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1262,7 @@ func (x *Hashes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hashes.ProtoReflect.Descriptor instead.
 func (*Hashes) Descriptor() ([]byte, []int) {
-	return file_types_basic_proto_rawDescGZIP(), []int{14}
+	return file_types_basic_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Hashes) GetHashes() []string {
@@ -1160,7 +1285,7 @@ var file_types_basic_proto_rawDesc = []byte{
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
 	0x22, 0x29, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a,
 	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xf2, 0x04, 0x0a, 0x0a,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9f, 0x05, 0x0a, 0x0a,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
@@ -1200,93 +1325,108 @@ var file_types_basic_proto_rawDesc = []byte{
 	0x0a, 0x04, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x77, 0x6f,
 	0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
 	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0xef, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
-	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x69, 0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x63, 0x6f, 0x69, 0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x69, 0x6e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x69, 0x6e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x28,
-	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x42, 0x65, 0x6e,
-	0x65, 0x66, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x6f,
-	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc3, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x4e, 0x45, 0x50, 0x35, 0x54, 0x78, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4e, 0x45, 0x50, 0x35, 0x54, 0x78, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x51, 0x0a, 0x0b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x0b, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a,
-	0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x29, 0x0a,
-	0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x06, 0x48, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x6c, 0x63, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x67, 0x6f, 0x2d, 0x71, 0x6c, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70,
-	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x22, 0x77, 0x0a,
+	0x08, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x35, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xef, 0x01, 0x0a,
+	0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
+	0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
+	0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf3,
+	0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x6f, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x69, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x69, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x69, 0x6e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x69,
+	0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x69, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x6f, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x69, 0x6e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x6f, 0x69, 0x6e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0xc3, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x45,
+	0x50, 0x35, 0x54, 0x78, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x45,
+	0x50, 0x35, 0x54, 0x78, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0x51, 0x0a, 0x0b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x29, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x06, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x6c, 0x63, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2d, 0x71, 0x6c, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1301,33 +1441,37 @@ func file_types_basic_proto_rawDescGZIP() []byte {
 	return file_types_basic_proto_rawDescData
 }
 
-var file_types_basic_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_types_basic_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_types_basic_proto_goTypes = []interface{}{
-	(*Address)(nil),     // 0: types.Address
-	(*Hash)(nil),        // 1: types.Hash
-	(*Balance)(nil),     // 2: types.Balance
-	(*Signature)(nil),   // 3: types.Signature
-	(*StateBlock)(nil),  // 4: types.StateBlock
-	(*TokenMeta)(nil),   // 5: types.TokenMeta
-	(*AccountMeta)(nil), // 6: types.AccountMeta
-	(*Benefit)(nil),     // 7: types.Benefit
-	(*TokenInfo)(nil),   // 8: types.TokenInfo
-	(*PendingKey)(nil),  // 9: types.PendingKey
-	(*PendingInfo)(nil), // 10: types.PendingInfo
-	(*StateBlocks)(nil), // 11: types.StateBlocks
-	(*TokenInfos)(nil),  // 12: types.TokenInfos
-	(*Addresses)(nil),   // 13: types.Addresses
-	(*Hashes)(nil),      // 14: types.Hashes
+	(*Address)(nil),        // 0: types.Address
+	(*Hash)(nil),           // 1: types.Hash
+	(*Balance)(nil),        // 2: types.Balance
+	(*Signature)(nil),      // 3: types.Signature
+	(*StateBlock)(nil),     // 4: types.StateBlock
+	(*MultiSig)(nil),       // 5: types.MultiSig
+	(*OwnerSignature)(nil), // 6: types.OwnerSignature
+	(*TokenMeta)(nil),      // 7: types.TokenMeta
+	(*AccountMeta)(nil),    // 8: types.AccountMeta
+	(*Benefit)(nil),        // 9: types.Benefit
+	(*TokenInfo)(nil),      // 10: types.TokenInfo
+	(*PendingKey)(nil),     // 11: types.PendingKey
+	(*PendingInfo)(nil),    // 12: types.PendingInfo
+	(*StateBlocks)(nil),    // 13: types.StateBlocks
+	(*TokenInfos)(nil),     // 14: types.TokenInfos
+	(*Addresses)(nil),      // 15: types.Addresses
+	(*Hashes)(nil),         // 16: types.Hashes
 }
 var file_types_basic_proto_depIdxs = []int32{
-	5, // 0: types.AccountMeta.tokens:type_name -> types.TokenMeta
-	4, // 1: types.StateBlocks.stateBlocks:type_name -> types.StateBlock
-	8, // 2: types.TokenInfos.tokenInfos:type_name -> types.TokenInfo
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5,  // 0: types.StateBlock.multiSig:type_name -> types.MultiSig
+	6,  // 1: types.MultiSig.signatures:type_name -> types.OwnerSignature
+	7,  // 2: types.AccountMeta.tokens:type_name -> types.TokenMeta
+	4,  // 3: types.StateBlocks.stateBlocks:type_name -> types.StateBlock
+	10, // 4: types.TokenInfos.tokenInfos:type_name -> types.TokenInfo
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_types_basic_proto_init() }
@@ -1397,7 +1541,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenMeta); i {
+			switch v := v.(*MultiSig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,7 +1553,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountMeta); i {
+			switch v := v.(*OwnerSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,7 +1565,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Benefit); i {
+			switch v := v.(*TokenMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1433,7 +1577,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenInfo); i {
+			switch v := v.(*AccountMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1445,7 +1589,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingKey); i {
+			switch v := v.(*Benefit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1457,7 +1601,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingInfo); i {
+			switch v := v.(*TokenInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1469,7 +1613,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateBlocks); i {
+			switch v := v.(*PendingKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1481,7 +1625,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenInfos); i {
+			switch v := v.(*PendingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1493,7 +1637,7 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Addresses); i {
+			switch v := v.(*StateBlocks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1505,6 +1649,30 @@ func file_types_basic_proto_init() {
 			}
 		}
 		file_types_basic_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_basic_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addresses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_basic_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hashes); i {
 			case 0:
 				return &v.state
@@ -1523,7 +1691,7 @@ func file_types_basic_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_basic_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,10 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/auth"
 	"github.com/qlcchain/go-qlc/rpc/grpc/apis"
 	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	"github.com/qlcchain/go-qlc/rpc/tlsutil"
 )
 
 type GRPCServer struct {
@@ -32,10 +34,13 @@ type GRPCServer struct {
 	cc      *chainctx.ChainContext
 	ctx     context.Context
 	hServer *http.Server
+	auth    *auth.Authorizer
+	tls     *tlsutil.Reloader
 	logger  *zap.SugaredLogger
 }
 
-func Start(cfgFile string, ctx context.Context) (*GRPCServer, error) {
+// Start starts the gRPC server, calls are authorized by the authorizer if it is not nil
+func Start(cfgFile string, ctx context.Context, authorizer *auth.Authorizer) (*GRPCServer, error) {
 	cc := chainctx.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
 	l := ledger.NewLedger(cfgFile)
@@ -57,8 +62,12 @@ func Start(cfgFile string, ctx context.Context) (*GRPCServer, error) {
 		cfgFile: cfgFile,
 		ctx:     ctx,
 		cc:      cc,
+		auth:    authorizer,
 		logger:  log.NewLogger("grpc"),
 	}
+	if lis, err = qrpc.listenTLS(lis); err != nil {
+		return nil, err
+	}
 
 	grpcServer := grpc.NewServer(grpc.StreamInterceptor(qrpc.StreamServerInterceptor),
 		grpc.UnaryInterceptor(qrpc.UnaryServerInterceptor))
@@ -88,8 +97,8 @@ func (r *GRPCServer) newGateway(grpcAddress string) error {
 	defer cancel()
 
 	gwmux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	if err := registerGWApi(ctx, gwmux, grpcAddress, opts); err != nil {
+	endpoint, opts := r.gatewayEndpoint(grpcAddress)
+	if err := registerGWApi(ctx, gwmux, endpoint, opts); err != nil {
 		r.logger.Errorf("gateway register: %s", err)
 		return err
 	}
@@ -100,7 +109,7 @@ func (r *GRPCServer) newGateway(grpcAddress string) error {
 	srv := &http.Server{Addr: address, Handler: gwmux}
 	//return http.ListenAndServe(address, gwmux)
 	r.hServer = srv
-	return srv.ListenAndServe()
+	return r.serveGateway(srv)
 }
 
 func (r *GRPCServer) Stop() {
@@ -125,7 +134,9 @@ func (r *GRPCServer) registerApi() {
 	pb.RegisterAccountAPIServer(r.rpc, apis.NewAccountApi())
 	pb.RegisterBlackHoleAPIServer(r.rpc, apis.NewBlackHoleAPI(r.ledger, r.cc))
 	pb.RegisterChainAPIServer(r.rpc, apis.NewChainAPI(r.ledger))
+	pb.RegisterConfigAPIServer(r.rpc, apis.NewConfigAPI(r.cfgFile))
 	pb.RegisterContractAPIServer(r.rpc, apis.NewContractAPI(r.cc, r.ledger))
+	pb.RegisterDebugAPIServer(r.rpc, apis.NewDebugAPI(r.cfgFile, r.eb))
 	pb.RegisterLedgerAPIServer(r.rpc, apis.NewLedgerApi(r.ctx, r.ledger, r.eb, r.cc))
 	pb.RegisterMetricsAPIServer(r.rpc, apis.NewMetricsAPI())
 	pb.RegisterMinerAPIServer(r.rpc, apis.NewMinerAPI(r.cfg, r.ledger))
@@ -152,9 +163,15 @@ func registerGWApi(ctx context.Context, gwmux *runtime.ServeMux, endpoint string
 	if err := pb.RegisterChainAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterConfigAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterContractAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterDebugAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterLedgerAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
@@ -202,6 +219,11 @@ func registerGWApi(ctx context.Context, gwmux *runtime.ServeMux, endpoint string
 
 func (r *GRPCServer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	r.logger.Debugf("before unary handling. info: %+v \n", info)
+	if r.auth != nil {
+		resp, err := r.auth.UnaryServerInterceptor(ctx, req, info, handler)
+		r.logger.Debugf("after unary handling. resp: %+v \n", resp)
+		return resp, err
+	}
 	resp, err := handler(ctx, req)
 	r.logger.Debugf("after unary handling. resp: %+v \n", resp)
 	return resp, err
@@ -210,6 +232,11 @@ func (r *GRPCServer) UnaryServerInterceptor(ctx context.Context, req interface{}
 // StreamServerInterceptor is a gRPC server-side interceptor that provides Prometheus monitoring for Streaming RPCs.
 func (r *GRPCServer) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	r.logger.Debugf("before stream handling. info: %+v \n", info)
+	if r.auth != nil {
+		err := r.auth.StreamServerInterceptor(srv, ss, info, handler)
+		r.logger.Debugf("after stream handling. err: %v \n", err)
+		return err
+	}
 	err := handler(srv, ss)
 	r.logger.Debugf("after stream handling. err: %v \n", err)
 	return err
@@ -26,7 +26,7 @@ func TestGRPCServer(t *testing.T) {
 	d, _ := json.Marshal(cfg.RPC)
 	fmt.Println(string(d))
 
-	server, err := Start(cfgFile, context.Background())
+	server, err := Start(cfgFile, context.Background(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,56 @@
+package grpcServer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/qlcchain/go-qlc/rpc/tlsutil"
+)
+
+// listenTLS wraps the listener of the gRPC server by TLS if it is enabled
+func (r *GRPCServer) listenTLS(lis net.Listener) (net.Listener, error) {
+	if !tlsutil.Enabled(r.cfg.RPC.GRPCConfig.TLS) {
+		return lis, nil
+	}
+	reloader, err := tlsutil.NewReloader(r.cfg.RPC.GRPCConfig.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("grpc tls: %s", err)
+	}
+	go reloader.Run(r.ctx)
+	r.tls = reloader
+	return tls.NewListener(lis, reloader.ServerConfig("h2")), nil
+}
+
+// gatewayEndpoint returns the endpoint and the dial options for the gateway to call the gRPC server,
+// with TLS enabled the gateway calls the server through an in-memory listener, so it needs no client certificate
+func (r *GRPCServer) gatewayEndpoint(grpcAddress string) (string, []grpc.DialOption) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if r.tls == nil {
+		return grpcAddress, opts
+	}
+	internal := bufconn.Listen(1 << 20)
+	go func() {
+		if err := r.rpc.Serve(internal); err != nil {
+			r.logger.Errorf("grpc gateway listener: %s", err)
+		}
+	}()
+	opts = append(opts, grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return internal.Dial()
+	}))
+	return "bufconn", opts
+}
+
+// serveGateway serves the gateway over TLS if it is enabled, the certificate of the gRPC server is used
+func (r *GRPCServer) serveGateway(srv *http.Server) error {
+	if r.tls == nil {
+		return srv.ListenAndServe()
+	}
+	srv.TLSConfig = r.tls.ServerConfig("h2", "http/1.1")
+	return srv.ListenAndServeTLS("", "")
+}
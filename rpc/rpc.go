@@ -2,13 +2,17 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	rpc "github.com/qlcchain/jsonrpc2"
 	"go.uber.org/zap"
@@ -18,10 +22,15 @@ import (
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/auth"
 	grpcServer "github.com/qlcchain/go-qlc/rpc/grpc/server"
+	"github.com/qlcchain/go-qlc/rpc/tlsutil"
 	"github.com/qlcchain/go-qlc/wallet"
 )
 
+// handlerStopDelay is the time for in-flight calls of a replaced handler to finish before it is stopped
+const handlerStopDelay = 5 * time.Second
+
 type RPC struct {
 	rpcAPIs          []rpc.API
 	inProcessHandler *rpc.Server
@@ -32,9 +41,11 @@ type RPC struct {
 	httpWhitelist []string
 	httpListener  net.Listener
 	httpHandler   *rpc.Server
+	httpSwitch    *handlerSwitch
 
 	wsListener net.Listener
 	wsHandler  *rpc.Server
+	wsSwitch   *handlerSwitch
 
 	config             *config.Config
 	DashboardTargetURL string
@@ -43,6 +54,9 @@ type RPC struct {
 	lock   sync.RWMutex
 	ctx    context.Context
 	cancel context.CancelFunc
+	// context of the api services, it is replaced when the public modules are changed
+	apiCtx    context.Context
+	apiCancel context.CancelFunc
 
 	ledger  ledger.Store
 	wallet  *wallet.WalletStore
@@ -51,11 +65,23 @@ type RPC struct {
 	logger  *zap.SugaredLogger
 	cc      *chainctx.ChainContext
 	grpc    *grpcServer.GRPCServer
+	auth    *auth.Authorizer
+	tls     *tlsutil.Reloader
 }
 
 func NewRPC(cfgFile string) (*RPC, error) {
 	cc := chainctx.NewChainContext(cfgFile)
 	cfg, _ := cc.Config()
+	authorizer, err := auth.NewAuthorizer(cfg.RPC.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("rpc auth: %s", err)
+	}
+	var reloader *tlsutil.Reloader
+	if tlsutil.Enabled(cfg.RPC.TLS) {
+		if reloader, err = tlsutil.NewReloader(cfg.RPC.TLS); err != nil {
+			return nil, fmt.Errorf("rpc tls: %s", err)
+		}
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 
 	r := RPC{
@@ -68,7 +94,10 @@ func NewRPC(cfgFile string) (*RPC, error) {
 		cancel:  cancel,
 		logger:  log.NewLogger("rpc"),
 		cc:      cc,
+		auth:    authorizer,
+		tls:     reloader,
 	}
+	r.apiCtx, r.apiCancel = context.WithCancel(ctx)
 	return &r, nil
 }
 
@@ -77,7 +106,16 @@ func (r *RPC) startIPC(apis []rpc.API) error {
 	if r.config.RPC.IPCEndpoint == "" {
 		return nil // IPC disabled.
 	}
-	listener, handler, err := rpc.StartIPCEndpoint(r.config.RPC.IPCEndpoint, apis)
+	var (
+		listener net.Listener
+		handler  *rpc.Server
+		err      error
+	)
+	if r.auth != nil {
+		listener, handler, err = r.startAuthIPCEndpoint(r.config.RPC.IPCEndpoint, apis)
+	} else {
+		listener, handler, err = rpc.StartIPCEndpoint(r.config.RPC.IPCEndpoint, apis)
+	}
 	if err != nil {
 		return err
 	}
@@ -86,6 +124,30 @@ func (r *RPC) startIPC(apis []rpc.API) error {
 	return nil
 }
 
+// startAuthIPCEndpoint starts the IPC endpoint like rpc.StartIPCEndpoint, calls are authorized by the authorizer
+func (r *RPC) startAuthIPCEndpoint(endpoint string, apis []rpc.API) (net.Listener, *rpc.Server, error) {
+	handler := rpc.NewServer()
+	for _, api := range apis {
+		if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
+			return nil, nil, err
+		}
+	}
+	// Ensure the IPC path exists and remove any previous leftover
+	if err := os.MkdirAll(filepath.Dir(endpoint), 0751); err != nil {
+		return nil, nil, err
+	}
+	_ = os.Remove(endpoint)
+	listener, err := net.Listen("unix", endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	_ = os.Chmod(endpoint, 0600)
+	go func() {
+		_ = r.auth.ServeIPC(handler, listener)
+	}()
+	return listener, handler, nil
+}
+
 // stopIPC terminates the IPC RpcCall endpoint.
 func (r *RPC) stopIPC() {
 	if r.ipcListener != nil {
@@ -228,6 +290,9 @@ func (r *RPC) StartRPC() error {
 	if err := r.startInProcess(r.GetInProcessApis(r.config.RPC.PublicModules)); err != nil {
 		return err
 	}
+	if r.tls != nil {
+		go r.tls.Run(r.ctx)
+	}
 
 	//Start rpc
 	if r.config.RPC.Enable && r.config.RPC.IPCEnabled {
@@ -260,7 +325,7 @@ func (r *RPC) StartRPC() error {
 	}
 
 	if r.config.RPC.Enable && r.config.RPC.GRPCConfig.Enable {
-		grpc, err := grpcServer.Start(r.cfgFile, r.ctx)
+		grpc, err := grpcServer.Start(r.cfgFile, r.ctx, r.auth)
 		if err != nil {
 			return fmt.Errorf("grpcserver start error: %s", err)
 		}
@@ -271,26 +336,12 @@ func (r *RPC) StartRPC() error {
 
 // StartHTTPEndpoint starts the HTTP RpcCall endpoint, configured with cors/vhosts/modules
 func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) (net.Listener, *rpc.Server, error) {
-	// Generate the whitelist based on the allowed modules
-	whitelist := make(map[string]bool)
-	for _, module := range modules {
-		whitelist[module] = true
-	}
-	// Register all the APIs exposed by the services
-	handler := rpc.NewServer()
-
-	for _, api := range apis {
-		if whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
-			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
-				return nil, nil, err
-			}
-		}
+	handler, err := newHandler(apis, modules, false)
+	if err != nil {
+		return nil, nil, err
 	}
 	// All APIs registered, start the HTTP listener
-	var (
-		listener net.Listener
-		err      error
-	)
+	var listener net.Listener
 	network, address, err := scheme(endpoint)
 	if err != nil {
 		return nil, nil, err
@@ -298,10 +349,15 @@ func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []strin
 	if listener, err = net.Listen(network, address); err != nil {
 		return nil, nil, err
 	}
+	if r.tls != nil {
+		listener = tls.NewListener(listener, r.tls.ServerConfig("http/1.1"))
+	}
 
+	sw := newHandlerSwitch(r.wrapHTTPHandler(handler))
+	r.httpSwitch = sw
 	hServer := new(http.Server)
 	go func(hServer *http.Server) {
-		hServer = rpc.NewHTTPServer(cors, vhosts, timeouts, handler)
+		hServer = rpc.NewHTTPServer(cors, vhosts, timeouts, sw)
 		hServer.Serve(listener)
 		select {
 		case <-r.ctx.Done():
@@ -314,26 +370,12 @@ func (r *RPC) StartHTTPEndpoint(endpoint string, apis []rpc.API, modules []strin
 
 // StartWSEndpoint starts a websocket endpoint
 func (r *RPC) StartWSEndpoint(endpoint string, apis []rpc.API, modules []string, wsOrigins []string, exposeAll bool) (net.Listener, *rpc.Server, error) {
-	// Generate the whitelist based on the allowed modules
-	whitelist := make(map[string]bool)
-	for _, module := range modules {
-		whitelist[module] = true
-	}
-	// Register all the APIs exposed by the services
-	handler := rpc.NewServer()
-	for _, api := range apis {
-		if exposeAll || whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
-			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
-				return nil, nil, err
-			}
-			//r.logger.Debug("WebSocket registered ", " service ", api.Service, " namespace ", api.Namespace)
-		}
+	handler, err := newHandler(apis, modules, exposeAll)
+	if err != nil {
+		return nil, nil, err
 	}
 	// All APIs registered, start the HTTP listener
-	var (
-		listener net.Listener
-		err      error
-	)
+	var listener net.Listener
 	network, address, err := scheme(endpoint)
 	if err != nil {
 		return nil, nil, err
@@ -342,11 +384,16 @@ func (r *RPC) StartWSEndpoint(endpoint string, apis []rpc.API, modules []string,
 	if listener, err = net.Listen(network, address); err != nil {
 		return nil, nil, err
 	}
+	if r.tls != nil {
+		listener = tls.NewListener(listener, r.tls.ServerConfig("http/1.1"))
+	}
 
 	//go rpc.NewWSServer(wsOrigins, handler).Serve(listener)
+	sw := newHandlerSwitch(r.wrapWSHandler(handler, wsOrigins))
+	r.wsSwitch = sw
 	hServer := new(http.Server)
 	go func(hServer *http.Server) {
-		hServer = rpc.NewWSServer(wsOrigins, handler)
+		hServer = &http.Server{Handler: sw}
 		hServer.Serve(listener)
 		select {
 		case <-r.ctx.Done():
@@ -357,6 +404,121 @@ func (r *RPC) StartWSEndpoint(endpoint string, apis []rpc.API, modules []string,
 	return listener, handler, err
 }
 
+// SetPublicModules replaces the apis of the in-process, IPC, HTTP and WebSocket endpoints by the modules,
+// the HTTP and WebSocket listeners are kept, and the replaced handlers are stopped after in-flight calls finished
+func (r *RPC) SetPublicModules(modules []string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var replaced []*rpc.Server
+	cancel := r.apiCancel
+	r.apiCtx, r.apiCancel = context.WithCancel(r.ctx)
+	defer func() {
+		go func() {
+			time.Sleep(handlerStopDelay)
+			for _, h := range replaced {
+				h.Stop()
+			}
+			cancel()
+		}()
+	}()
+
+	if r.inProcessHandler != nil {
+		handler, err := newHandler(r.GetInProcessApis(modules), nil, true)
+		if err != nil {
+			return err
+		}
+		replaced = append(replaced, r.inProcessHandler)
+		r.inProcessHandler = handler
+	}
+	if r.ipcListener != nil {
+		// the listener is closed first, closing it removes the socket file
+		_ = r.ipcListener.Close()
+		r.ipcListener = nil
+		replaced = append(replaced, r.ipcHandler)
+		r.ipcHandler = nil
+		if err := r.startIPC(r.GetIpcApis(modules)); err != nil {
+			return err
+		}
+	}
+	if r.httpSwitch != nil && r.httpHandler != nil {
+		handler, err := newHandler(r.GetHttpApis(modules), nil, false)
+		if err != nil {
+			return err
+		}
+		r.httpSwitch.Store(r.wrapHTTPHandler(handler))
+		replaced = append(replaced, r.httpHandler)
+		r.httpHandler = handler
+	}
+	if r.wsSwitch != nil && r.wsHandler != nil {
+		handler, err := newHandler(r.GetWSApis(modules), nil, false)
+		if err != nil {
+			return err
+		}
+		r.wsSwitch.Store(r.wrapWSHandler(handler, r.config.RPC.HTTPCors))
+		replaced = append(replaced, r.wsHandler)
+		r.wsHandler = handler
+	}
+	r.logger.Infof("rpc public modules changed to %s", strings.Join(modules, ","))
+	return nil
+}
+
+// newHandler registers the apis of the modules, or all public apis if modules is empty
+func newHandler(apis []rpc.API, modules []string, exposeAll bool) (*rpc.Server, error) {
+	// Generate the whitelist based on the allowed modules
+	whitelist := make(map[string]bool)
+	for _, module := range modules {
+		whitelist[module] = true
+	}
+	// Register all the APIs exposed by the services
+	handler := rpc.NewServer()
+	for _, api := range apis {
+		if exposeAll || whitelist[api.Namespace] || (len(whitelist) == 0 && api.Public) {
+			if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return handler, nil
+}
+
+func (r *RPC) wrapHTTPHandler(handler *rpc.Server) http.Handler {
+	if r.auth != nil {
+		return r.auth.HTTPHandler(handler)
+	}
+	return handler
+}
+
+func (r *RPC) wrapWSHandler(handler *rpc.Server, wsOrigins []string) http.Handler {
+	if r.auth != nil {
+		return r.auth.WSHandler(handler, wsOrigins)
+	}
+	return handler.WebsocketHandler(wsOrigins)
+}
+
+// handlerSwitch serves requests by the current handler, so the handler can be replaced without closing the listener
+type handlerSwitch struct {
+	lock    sync.RWMutex
+	handler http.Handler
+}
+
+func newHandlerSwitch(handler http.Handler) *handlerSwitch {
+	return &handlerSwitch{handler: handler}
+}
+
+func (s *handlerSwitch) Store(handler http.Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handler = handler
+}
+
+func (s *handlerSwitch) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.lock.RLock()
+	handler := s.handler
+	s.lock.RUnlock()
+	handler.ServeHTTP(w, req)
+}
+
 func scheme(endpoint string) (string, string, error) {
 	u, err := url.Parse(endpoint)
 	if err != nil {
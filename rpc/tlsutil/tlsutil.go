@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// Enabled returns true if TLS of the endpoint is configured
+func Enabled(cfg *config.TLSConfig) bool {
+	return cfg != nil && cfg.Enable
+}
+
+// Reloader keeps the certificate and the client CAs of the config,
+// files are reloaded when they are modified so certificates can be renewed without restarting the node
+type Reloader struct {
+	cfg       *config.TLSConfig
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+	logger    *zap.SugaredLogger
+}
+
+// NewReloader loads the certificate, the key and the client CAs of the config
+func NewReloader(cfg *config.TLSConfig) (*Reloader, error) {
+	if !Enabled(cfg) {
+		return nil, errors.New("tls is not enabled")
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("tls certificate and key files are required")
+	}
+	r := &Reloader{
+		cfg:    cfg,
+		logger: log.NewLogger("tls"),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	return files
+}
+
+func (r *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return err
+		}
+		modTimes[f] = info.ModTime()
+	}
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load key pair: %s", err)
+	}
+	var pool *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		if pool, err = LoadCertPool(r.cfg.ClientCAFile); err != nil {
+			return err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = pool
+	r.modTimes = modTimes
+	return nil
+}
+
+func (r *Reloader) modified() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return false
+		}
+		if !info.ModTime().Equal(r.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload reloads the files if any of them is modified, the current certificate is kept if they are invalid
+func (r *Reloader) Reload() error {
+	if !r.modified() {
+		return nil
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	r.logger.Infof("tls certificate %s reloaded", r.cfg.CertFile)
+	return nil
+}
+
+// Run checks the files every ReloadInterval seconds until ctx is done
+func (r *Reloader) Run(ctx context.Context) {
+	if r.cfg.ReloadInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(r.cfg.ReloadInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				r.logger.Errorf("reload tls certificate: %s", err)
+			}
+		}
+	}
+}
+
+// Certificate returns the current certificate
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// ServerConfig returns a server config which always uses the current certificate and client CAs
+func (r *Reloader) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if r.clientCAs != nil {
+				cfg.ClientCAs = r.clientCAs
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// LoadCertPool loads PEM certificates of the file
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+	return pool, nil
+}
+
+// ClientConfig returns a client config, only server certificates issued by CAs of caFile are trusted if it is set,
+// the client certificate is sent if certFile and keyFile are set
+func ClientConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pool, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load key pair: %s", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
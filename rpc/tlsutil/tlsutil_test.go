@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	signer, signerKey := tpl, key
+	if parent == nil {
+		tpl.IsCA = true
+		tpl.BasicConstraintsValid = true
+	} else {
+		tpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+		tpl.DNSNames = []string{"localhost"}
+		tpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func setupTestCase(t *testing.T) (string, func(t *testing.T)) {
+	dir := filepath.Join(config.QlcTestDataDir(), "tls", uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	return dir, func(t *testing.T) {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// handshake dials the listener with the client config and returns the error of the handshake
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) error {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		b := make([]byte, 1)
+		if _, err := conn.Read(b); err == nil {
+			_, _ = conn.Write(b)
+		}
+	}()
+	conn, err := tls.Dial("tcp", l.Addr().String(), clientConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// with TLS 1.3 the client certificate is verified after the client handshake is done
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Write([]byte{0}); err != nil {
+		return err
+	}
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	dir, teardown := setupTestCase(t)
+	defer teardown(t)
+
+	ca := newTestCert(t, "ca", nil, 0)
+	caFile, _ := ca.write(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, "node", ca, x509.ExtKeyUsageServerAuth).write(t, dir, "node")
+	clientCert, clientKey := newTestCert(t, "client", ca, x509.ExtKeyUsageClientAuth).write(t, dir, "client")
+	otherCert, otherKey := newTestCert(t, "other", newTestCert(t, "other ca", nil, 0), x509.ExtKeyUsageClientAuth).write(t, dir, "other")
+
+	r, err := NewReloader(&config.TLSConfig{Enable: true, CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := ClientConfig(caFile, clientCert, clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.ServerName = "localhost"
+	if err := handshake(t, r.ServerConfig(), c); err != nil {
+		t.Fatal(err)
+	}
+
+	// client certificate is required
+	c, _ = ClientConfig(caFile, "", "")
+	c.ServerName = "localhost"
+	if err := handshake(t, r.ServerConfig(), c); err == nil {
+		t.Fatal("client without certificate should be rejected")
+	}
+
+	// client certificate must be issued by the client CA
+	c, _ = ClientConfig(caFile, otherCert, otherKey)
+	c.ServerName = "localhost"
+	if err := handshake(t, r.ServerConfig(), c); err == nil {
+		t.Fatal("client certificate of other CA should be rejected")
+	}
+
+	// server certificate is not issued by the pinned CA
+	c, _ = ClientConfig(otherCert, clientCert, clientKey)
+	c.ServerName = "localhost"
+	if err := handshake(t, r.ServerConfig(), c); err == nil {
+		t.Fatal("server certificate should not be trusted")
+	}
+}
+
+func TestReloader_Reload(t *testing.T) {
+	dir, teardown := setupTestCase(t)
+	defer teardown(t)
+
+	ca := newTestCert(t, "ca", nil, 0)
+	certFile, keyFile := newTestCert(t, "node", ca, x509.ExtKeyUsageServerAuth).write(t, dir, "node")
+	r, err := NewReloader(&config.TLSConfig{Enable: true, CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := r.Certificate()
+	if err := r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Certificate() != old {
+		t.Fatal("certificate should not be reloaded if files are not modified")
+	}
+
+	// invalid files keep the current certificate
+	if err := ioutil.WriteFile(certFile, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.Chtimes(certFile, time.Now(), time.Now().Add(time.Minute))
+	if err := r.Reload(); err == nil {
+		t.Fatal("invalid certificate should not be loaded")
+	}
+	if r.Certificate() != old {
+		t.Fatal("certificate should be kept")
+	}
+
+	renewed := newTestCert(t, "node", ca, x509.ExtKeyUsageServerAuth)
+	renewed.write(t, dir, "node")
+	_ = os.Chtimes(certFile, time.Now(), time.Now().Add(2*time.Minute))
+	if err := r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(r.Certificate().Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leaf.SerialNumber.Cmp(renewed.cert.SerialNumber) != 0 {
+		t.Fatal("certificate should be reloaded")
+	}
+
+	caFile, _ := ca.write(t, dir, "ca")
+	c, err := ClientConfig(caFile, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.ServerName = "localhost"
+	if err := handshake(t, r.ServerConfig(), c); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewReloader(t *testing.T) {
+	if _, err := NewReloader(nil); err == nil {
+		t.Fatal("disabled tls should return error")
+	}
+	if _, err := NewReloader(&config.TLSConfig{Enable: true}); err == nil {
+		t.Fatal("missing files should return error")
+	}
+}
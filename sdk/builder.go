@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// SignBlock signs the block by the account and computes the work of the block
+func SignBlock(blk *types.StateBlock, acc *types.Account) error {
+	if blk.GetAddress() != acc.Address() {
+		return fmt.Errorf("block address (%s) is mismatch account (%s)", blk.GetAddress(), acc.Address())
+	}
+	var w types.Work
+	worker, err := types.NewWorker(w, blk.Root())
+	if err != nil {
+		return err
+	}
+	blk.Signature = acc.Sign(blk.GetHash())
+	blk.Work = worker.NewWork()
+	return nil
+}
+
+// SignAndProcess signs the block and sends it to the node
+func (c *Client) SignAndProcess(ctx context.Context, blk *types.StateBlock, acc *types.Account) (types.Hash, error) {
+	if err := SignBlock(blk, acc); err != nil {
+		return types.ZeroHash, err
+	}
+	return c.Ledger().Process(ctx, blk)
+}
+
+// tokenChain returns the token meta, the header block of the token chain and the pov height referred by the next block
+func (c *Client) tokenChain(ctx context.Context, address types.Address, token types.Hash) (*tokenChain, error) {
+	tm, err := c.Ledger().TokenMeta(ctx, address, token)
+	if err != nil {
+		return nil, err
+	}
+	tc := &tokenChain{balance: toBalance(tm.GetBalance())}
+	if tc.header, err = types.NewHash(tm.GetHeader()); err != nil {
+		return nil, err
+	}
+	if tc.representative, err = types.HexToAddress(tm.GetRepresentative()); err != nil {
+		return nil, err
+	}
+	if tc.prev, err = c.Ledger().Block(ctx, tc.header); err != nil {
+		return nil, fmt.Errorf("get header block %s: %s", tc.header, err)
+	}
+	if tc.povHeight, err = c.Pov().LatestHeight(ctx); err != nil {
+		return nil, fmt.Errorf("get pov header: %s", err)
+	}
+	return tc, nil
+}
+
+type tokenChain struct {
+	header         types.Hash
+	balance        types.Balance
+	representative types.Address
+	prev           *types.StateBlock
+	povHeight      uint64
+}
+
+// next returns the next block of the token chain, the balance and the representative are kept
+func (tc *tokenChain) next(typ types.BlockType, address types.Address, token types.Hash) *types.StateBlock {
+	return &types.StateBlock{
+		Type:           typ,
+		Token:          token,
+		Address:        address,
+		Balance:        tc.balance,
+		Vote:           tc.prev.GetVote(),
+		Network:        tc.prev.GetNetwork(),
+		Oracle:         tc.prev.GetOracle(),
+		Storage:        tc.prev.GetStorage(),
+		Previous:       tc.header,
+		Representative: tc.representative,
+		PoVHeight:      tc.povHeight,
+		Timestamp:      common.TimeNow().Unix(),
+	}
+}
+
+// NewSendBlock builds the block which sends amount of the token to the address, the block is not signed
+func (c *Client) NewSendBlock(ctx context.Context, from, to types.Address, tokenName string, amount types.Balance, message types.Hash) (*types.StateBlock, error) {
+	if amount.Int == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount %s", amount)
+	}
+	token, err := c.Ledger().TokenID(ctx, tokenName)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := c.tokenChain(ctx, from, token)
+	if err != nil {
+		return nil, err
+	}
+	if tc.balance.Compare(amount) == types.BalanceCompSmaller {
+		return nil, fmt.Errorf("not enought balance(%s) of %s", tc.balance, amount)
+	}
+	blk := tc.next(types.Send, from, token)
+	blk.Balance = tc.balance.Sub(amount)
+	blk.Link = to.ToHash()
+	blk.Message = message
+	return blk, nil
+}
+
+// NewReceiveBlock builds the block which receives the send block, the token chain is opened if it does not exist,
+// the block is not signed
+func (c *Client) NewReceiveBlock(ctx context.Context, sendHash types.Hash) (*types.StateBlock, error) {
+	info, err := c.Ledger().BlockInfo(ctx, sendHash)
+	if err != nil {
+		return nil, err
+	}
+	send, err := fromAPIBlock(info)
+	if err != nil {
+		return nil, err
+	}
+	if send.GetType() != types.Send {
+		return nil, fmt.Errorf("(%s) is not send block", sendHash)
+	}
+	address := types.Address(send.GetLink())
+	amount := toBalance(info.GetAmount())
+
+	tc, err := c.tokenChain(ctx, address, send.GetToken())
+	switch err {
+	case nil:
+		blk := tc.next(types.Receive, address, send.GetToken())
+		blk.Balance = tc.balance.Add(amount)
+		blk.Link = sendHash
+		return blk, nil
+	case ErrTokenNotFound:
+		povHeight, err := c.Pov().LatestHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get pov header: %s", err)
+		}
+		return &types.StateBlock{
+			Type:           types.Open,
+			Token:          send.GetToken(),
+			Address:        address,
+			Balance:        amount,
+			Vote:           types.ZeroBalance,
+			Network:        types.ZeroBalance,
+			Oracle:         types.ZeroBalance,
+			Storage:        types.ZeroBalance,
+			Previous:       types.ZeroHash,
+			Link:           sendHash,
+			Representative: send.GetRepresentative(),
+			PoVHeight:      povHeight,
+			Timestamp:      common.TimeNow().Unix(),
+		}, nil
+	default:
+		return nil, err
+	}
+}
+
+// NewChangeBlock builds the block which changes the representative of the account, the block is not signed
+func (c *Client) NewChangeBlock(ctx context.Context, address, representative types.Address) (*types.StateBlock, error) {
+	token, err := c.Ledger().ChainToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := c.tokenChain(ctx, address, token)
+	if err != nil {
+		return nil, err
+	}
+	blk := tc.next(types.Change, address, token)
+	blk.Representative = representative
+	return blk, nil
+}
+
+// NewContractSendBlock builds the block which calls the chain contract with data packed by vm/abi,
+// amount of the chain token is sent to the contract, the block is not signed
+func (c *Client) NewContractSendBlock(ctx context.Context, address, contract types.Address, amount types.Balance, data []byte) (*types.StateBlock, error) {
+	token, err := c.Ledger().ChainToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := c.tokenChain(ctx, address, token)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Int == nil {
+		amount = types.ZeroBalance
+	}
+	if tc.balance.Compare(amount) == types.BalanceCompSmaller {
+		return nil, fmt.Errorf("not enought balance(%s) of %s", tc.balance, amount)
+	}
+	blk := tc.next(types.ContractSend, address, token)
+	blk.Balance = tc.balance.Sub(amount)
+	blk.Link = contract.ToHash()
+	blk.Data = data
+	return blk, nil
+}
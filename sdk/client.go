@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package sdk is the Go client of the node, it wraps the gRPC services and the json-rpc APIs
+// which have no gRPC counterpart, and builds and signs blocks locally.
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// Config of the client, only GRPCEndpoint is required
+type Config struct {
+	// GRPCEndpoint is the host:port of the gRPC server
+	GRPCEndpoint string
+	// RPCEndpoint is the url of the json-rpc server, e.g. http://127.0.0.1:9735 or ws://127.0.0.1:9736,
+	// it is only used by APIs which are not served by gRPC
+	RPCEndpoint string
+	// Token is sent as bearer token of every call if the node requires authorization
+	Token string
+	// TLS is used to connect the node if it is set
+	TLS *tls.Config
+	// PoolSize is the count of gRPC connections, calls are spread over them
+	PoolSize int
+	// MaxRetries is the count of retries of a failed call, 0 disables retries
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, it is doubled on each retry up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Timeout of a single unary call if the context has no deadline
+	Timeout time.Duration
+}
+
+// DefaultConfig returns the config to connect the gRPC endpoint
+func DefaultConfig(grpcEndpoint string) *Config {
+	return &Config{
+		GRPCEndpoint: grpcEndpoint,
+		PoolSize:     4,
+		MaxRetries:   3,
+		MinBackoff:   200 * time.Millisecond,
+		MaxBackoff:   10 * time.Second,
+		Timeout:      30 * time.Second,
+	}
+}
+
+// Client of the node, it is safe for concurrent use
+type Client struct {
+	cfg    *Config
+	pool   *connPool
+	mu     sync.Mutex
+	rpc    *rpc.Client
+	logger *zap.SugaredLogger
+}
+
+// NewClient connects the gRPC endpoint of the config, the json-rpc endpoint is connected on first use
+func NewClient(cfg *Config) (*Client, error) {
+	if cfg == nil || cfg.GRPCEndpoint == "" {
+		return nil, errors.New("grpc endpoint is required")
+	}
+	c := *cfg
+	if c.PoolSize <= 0 {
+		c.PoolSize = 1
+	}
+	if c.MinBackoff <= 0 {
+		c.MinBackoff = 200 * time.Millisecond
+	}
+	if c.MaxBackoff < c.MinBackoff {
+		c.MaxBackoff = c.MinBackoff
+	}
+	pool, err := newConnPool(&c)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		cfg:    &c,
+		pool:   pool,
+		logger: log.NewLogger("sdk"),
+	}, nil
+}
+
+// Close closes all connections
+func (c *Client) Close() error {
+	c.mu.Lock()
+	if c.rpc != nil {
+		c.rpc.Close()
+		c.rpc = nil
+	}
+	c.mu.Unlock()
+	return c.pool.Close()
+}
+
+func (c *Client) Ledger() *LedgerClient {
+	return &LedgerClient{c: c}
+}
+
+func (c *Client) Pov() *PovClient {
+	return &PovClient{c: c}
+}
+
+func (c *Client) Contract() *ContractClient {
+	return &ContractClient{c: c}
+}
+
+func (c *Client) Pledge() *PledgeClient {
+	return &PledgeClient{c: c}
+}
+
+// Call calls the json-rpc method, e.g. ledger_accountInfo, the call is retried on connection errors
+func (c *Client) Call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if _, ok := ctx.Deadline(); !ok && c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+	return retry(ctx, c.cfg, func(ctx context.Context) error {
+		client, err := c.rpcClient(ctx)
+		if err != nil {
+			return err
+		}
+		if err := client.CallContext(ctx, result, method, args...); err != nil {
+			if _, ok := err.(rpc.Error); !ok {
+				// drop the broken connection, it is dialed again on retry
+				c.resetRPC(client)
+			}
+			return err
+		}
+		return nil
+	}, isRetryableRPC)
+}
+
+func (c *Client) rpcClient(ctx context.Context) (*rpc.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rpc != nil {
+		return c.rpc, nil
+	}
+	if c.cfg.RPCEndpoint == "" {
+		return nil, errors.New("json-rpc endpoint is not configured")
+	}
+	var client *rpc.Client
+	var err error
+	if strings.HasPrefix(c.cfg.RPCEndpoint, "http://") || strings.HasPrefix(c.cfg.RPCEndpoint, "https://") {
+		client, err = rpc.DialHTTPWithClient(c.cfg.RPCEndpoint, &http.Client{
+			Transport: &tokenTransport{
+				token: c.cfg.Token,
+				base:  &http.Transport{TLSClientConfig: c.cfg.TLS},
+			},
+		})
+	} else {
+		client, err = rpc.DialContext(ctx, c.cfg.RPCEndpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.rpc = client
+	return client, nil
+}
+
+func (c *Client) resetRPC(client *rpc.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rpc == client {
+		c.rpc.Close()
+		c.rpc = nil
+	}
+}
+
+// tokenTransport sets the bearer token of json-rpc requests over http
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return t.base.RoundTrip(req)
+}
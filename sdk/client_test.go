@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/rpc"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+type testNode struct {
+	cc     *chainctx.ChainContext
+	l      *ledger.Ledger
+	client *Client
+	// account1 has an open token chain of the chain token
+	account1 *types.Account
+}
+
+func freePort(t *testing.T) int {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+// setupTestNode starts the gRPC and json-rpc servers in process and connects the client to them
+func setupTestNode(t *testing.T) (func(t *testing.T), *testNode) {
+	dir := filepath.Join(config.QlcTestDataDir(), "sdk", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	cc := chainctx.NewChainContext(cm.ConfigFile)
+	cfg, _ := cc.Config()
+
+	grpcPort := freePort(t)
+	httpPort := freePort(t)
+	cfg.RPC.Enable = true
+	cfg.RPC.HTTPEnabled = true
+	cfg.RPC.HTTPEndpoint = fmt.Sprintf("tcp4://127.0.0.1:%d", httpPort)
+	cfg.RPC.WSEnabled = false
+	cfg.RPC.IPCEnabled = false
+	cfg.RPC.PublicModules = []string{"ledger"}
+	cfg.RPC.GRPCConfig.Enable = true
+	cfg.RPC.GRPCConfig.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", grpcPort)
+	cfg.RPC.GRPCConfig.HTTPEnable = false
+
+	l := ledger.NewLedger(cm.ConfigFile)
+	setPovStatus(t, l, cc)
+	setLedgerStatus(t, l)
+	ac1 := initAccount(t, l)
+
+	r, err := rpc.NewRPC(cm.ConfigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StartRPC(); err != nil {
+		t.Fatal(err)
+	}
+
+	c := DefaultConfig(fmt.Sprintf("127.0.0.1:%d", grpcPort))
+	c.RPCEndpoint = fmt.Sprintf("http://127.0.0.1:%d", httpPort)
+	c.MinBackoff = 10 * time.Millisecond
+	c.MaxBackoff = 100 * time.Millisecond
+	client, err := NewClient(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func(t *testing.T) {
+		_ = client.Close()
+		r.StopRPC()
+		_ = cc.Stop()
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, &testNode{cc: cc, l: l, client: client, account1: ac1}
+}
+
+func setPovStatus(t *testing.T, l *ledger.Ledger, cc *chainctx.ChainContext) {
+	block, td := mock.GeneratePovBlock(nil, 0)
+	if err := l.AddPovBlock(block, td); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddPovBestHash(block.GetHeight(), block.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetPovLatestHeight(block.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+	_ = cc.Init(func() error {
+		return nil
+	})
+	_ = cc.Start()
+	cc.EventBus().Publish(topic.EventPovSyncState, topic.SyncDone)
+	cc.EventBus().Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: "123", PeerInfo: "234"})
+}
+
+func setLedgerStatus(t *testing.T, l *ledger.Ledger) {
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	verifier := process.NewLedgerVerifier(l)
+	for _, v := range config.GenesisInfos() {
+		mb := v.Mintage
+		gb := v.Genesis
+		if err := ctx.SetStorage(contractaddress.MintageAddress[:], gb.Token[:], gb.Data); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.AddStateBlock(&mb); err != nil {
+			t.Fatal(err)
+		}
+		if err := verifier.BlockProcess(&gb); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.SetStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func initAccount(t *testing.T, l *ledger.Ledger) *types.Account {
+	ac := mock.Account()
+	balance := types.Balance{Int: big.NewInt(int64(100000000000))}
+	blk := &types.StateBlock{
+		Type:           types.Open,
+		Address:        ac.Address(),
+		Token:          config.ChainToken(),
+		Balance:        balance,
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Timestamp:      common.TimeNow().Unix(),
+		Link:           mock.Hash(),
+		Representative: ac.Address(),
+	}
+	am := mock.AccountMeta(ac.Address())
+	am.CoinBalance = balance
+	am.Tokens = []*types.TokenMeta{{
+		Type:           config.ChainToken(),
+		Header:         blk.GetHash(),
+		OpenBlock:      blk.GetHash(),
+		Representative: ac.Address(),
+		Balance:        balance,
+		BelongTo:       ac.Address(),
+		BlockCount:     1,
+	}}
+	if err := l.AddStateBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddAccountMeta(am, l.Cache().GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	return ac
+}
+
+func TestClient_Ledger(t *testing.T) {
+	teardown, node := setupTestNode(t)
+	defer teardown(t)
+
+	ctx := context.Background()
+	c := node.client
+	token, err := c.Ledger().ChainToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != config.ChainToken() {
+		t.Fatalf("invalid chain token %s", token)
+	}
+	id, err := c.Ledger().TokenID(ctx, "QLC")
+	if err != nil || id != token {
+		t.Fatal(id, err)
+	}
+
+	address := node.account1.Address()
+	tm, err := c.Ledger().TokenMeta(ctx, address, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if toBalance(tm.GetBalance()).Compare(types.Balance{Int: big.NewInt(100000000000)}) != types.BalanceCompEqual {
+		t.Fatal("invalid balance", tm.GetBalance())
+	}
+	if _, err := c.Ledger().TokenMeta(ctx, mock.Address(), token); err != ErrTokenNotFound {
+		t.Fatal(err)
+	}
+	if _, err := c.Ledger().Block(ctx, mock.Hash()); err == nil {
+		t.Fatal("block should not be found")
+	}
+
+	if _, err := c.Pov().LatestHeight(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// served by json-rpc
+	var ac api.APIAccount
+	if err := c.Call(ctx, &ac, "ledger_accountInfo", address); err != nil {
+		t.Fatal(err)
+	}
+	if ac.Address != address {
+		t.Fatal("invalid account", ac.Address)
+	}
+}
+
+func TestClient_SendReceive(t *testing.T) {
+	teardown, node := setupTestNode(t)
+	defer teardown(t)
+
+	ctx := context.Background()
+	c := node.client
+	ac1 := node.account1
+	ac2 := mock.Account()
+	amount := types.Balance{Int: big.NewInt(100000)}
+
+	send, err := c.NewSendBlock(ctx, ac1.Address(), ac2.Address(), "QLC", amount, types.ZeroHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SignAndProcess(ctx, send, ac2); err == nil {
+		t.Fatal("block should not be signed by other account")
+	}
+	sendHash, err := c.SignAndProcess(ctx, send, ac1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !send.IsValid() {
+		t.Fatal("invalid work")
+	}
+
+	blk, err := c.Ledger().Block(ctx, sendHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk.GetHash() != sendHash {
+		t.Fatal("invalid block", blk.GetHash())
+	}
+
+	open, err := c.NewReceiveBlock(ctx, sendHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if open.GetType() != types.Open || open.Address != ac2.Address() || open.Balance.Compare(amount) != types.BalanceCompEqual {
+		t.Fatal("invalid open block", open)
+	}
+	if _, err := c.NewReceiveBlock(ctx, open.GetHash()); err == nil {
+		t.Fatal("open block can not be received")
+	}
+}
+
+func TestClient_Pledge(t *testing.T) {
+	teardown, node := setupTestNode(t)
+	defer teardown(t)
+
+	ctx := context.Background()
+	c := node.client
+	param := &api.PledgeParam{
+		Beneficial:    mock.Address(),
+		PledgeAddress: node.account1.Address(),
+		Amount:        types.Balance{Int: big.NewInt(100000)},
+		PType:         "vote",
+		NEP5TxId:      mock.Hash().String(),
+	}
+	blk, err := c.Pledge().NewPledgeBlock(ctx, param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk.Link != types.Hash(contractaddress.NEP5PledgeAddress) {
+		t.Fatal("invalid link", blk.Link)
+	}
+	info, err := cabi.ParsePledgeParam(blk.GetData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Beneficial != param.Beneficial || info.PType != uint8(cabi.Vote) {
+		t.Fatal("invalid pledge data", info)
+	}
+}
+
+func TestClient_SubscribeBlocks(t *testing.T) {
+	teardown, node := setupTestNode(t)
+	defer teardown(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	blk := mock.StateBlockWithoutWork()
+	ch := make(chan *types.StateBlock, 1)
+	sub := node.client.SubscribeBlocks(ctx, func(b *types.StateBlock) {
+		select {
+		case ch <- b:
+		default:
+		}
+	})
+	defer sub.Unsubscribe()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case b := <-ch:
+			if b.GetHash() != blk.GetHash() {
+				t.Fatal("invalid block", b.GetHash())
+			}
+			sub.Unsubscribe()
+			<-sub.Done()
+			return
+		case <-ticker.C:
+			// the stream may not be registered yet, publish again
+			node.cc.EventBus().Publish(topic.EventAddRelation, blk)
+		case <-ctx.Done():
+			t.Fatal("no block received")
+		}
+	}
+}
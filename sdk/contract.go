@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	"github.com/qlcchain/go-qlc/vm/abi"
+)
+
+// ContractClient wraps the contract service
+type ContractClient struct {
+	c *Client
+}
+
+// API returns the gRPC client of the service for calls which are not wrapped
+func (c *ContractClient) API() pb.ContractAPIClient {
+	return pb.NewContractAPIClient(c.c.pool.Get())
+}
+
+// Addresses returns addresses of all chain contracts
+func (c *ContractClient) Addresses(ctx context.Context) ([]types.Address, error) {
+	r, err := c.API().ContractAddressList(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]types.Address, 0)
+	for _, a := range r.GetAddresses() {
+		addr, err := types.HexToAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// ABI returns the abi of the chain contract
+func (c *ContractClient) ABI(ctx context.Context, contract types.Address) (abi.ABIContract, error) {
+	r, err := c.API().GetAbiByContractAddress(ctx, &pbtypes.Address{Address: contract.String()})
+	if err != nil {
+		return abi.ABIContract{}, err
+	}
+	return abi.JSONToABIContract(strings.NewReader(r.GetValue()))
+}
+
+// PackMethod packs the call of the chain contract method locally, args are the go values of abi arguments,
+// e.g. types.Address, *big.Int and string
+func (c *ContractClient) PackMethod(ctx context.Context, contract types.Address, method string, args ...interface{}) ([]byte, error) {
+	a, err := c.ABI(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	return a.PackMethod(method, args...)
+}
+
+// RewardBlock returns the contract reward block of the contract send block, it is generated by the node
+// and has to be signed by the receiver
+func (c *ContractClient) RewardBlock(ctx context.Context, sendHash types.Hash) (*types.StateBlock, error) {
+	r, err := c.API().GenerateRewardBlock(ctx, &pb.ContractRewardBlockPara{SendHash: sendHash.String()})
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
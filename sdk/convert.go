@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"math/big"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+func toBalanceValue(b types.Balance) int64 {
+	if b.Int == nil {
+		return 0
+	}
+	return b.Int64()
+}
+
+func toBalance(v int64) types.Balance {
+	return types.Balance{Int: big.NewInt(v)}
+}
+
+func toStateBlock(blk *types.StateBlock) *pbtypes.StateBlock {
+	return &pbtypes.StateBlock{
+		Type:           blk.GetType().String(),
+		Token:          blk.GetToken().String(),
+		Address:        blk.GetAddress().String(),
+		Balance:        toBalanceValue(blk.GetBalance()),
+		Vote:           toBalanceValue(blk.GetVote()),
+		Network:        toBalanceValue(blk.GetNetwork()),
+		Storage:        toBalanceValue(blk.GetStorage()),
+		Oracle:         toBalanceValue(blk.GetOracle()),
+		Previous:       blk.GetPrevious().String(),
+		Link:           blk.GetLink().String(),
+		Sender:         blk.GetSender(),
+		Receiver:       blk.GetReceiver(),
+		Message:        blk.GetMessage().String(),
+		Data:           blk.GetData(),
+		PoVHeight:      blk.PoVHeight,
+		Timestamp:      blk.GetTimestamp(),
+		Extra:          blk.GetExtra().String(),
+		Representative: blk.GetRepresentative().String(),
+		PrivateFrom:    blk.PrivateFrom,
+		PrivateFor:     blk.PrivateFor,
+		PrivateGroupID: blk.PrivateGroupID,
+		Work:           uint64(blk.GetWork()),
+		Signature:      blk.GetSignature().String(),
+		MultiSig:       toMultiSig(blk.MultiSig),
+	}
+}
+
+func toMultiSig(m *types.MultiSig) *pbtypes.MultiSig {
+	if m == nil {
+		return nil
+	}
+	pm := &pbtypes.MultiSig{Threshold: uint32(m.Threshold)}
+	for _, o := range m.Owners {
+		pm.Owners = append(pm.Owners, o.String())
+	}
+	for _, s := range m.Signatures {
+		pm.Signatures = append(pm.Signatures, &pbtypes.OwnerSignature{Owner: s.Owner.String(), Signature: s.Signature.String()})
+	}
+	return pm
+}
+
+func toOriginMultiSig(pm *pbtypes.MultiSig) (*types.MultiSig, error) {
+	if pm == nil {
+		return nil, nil
+	}
+	m := &types.MultiSig{Threshold: uint8(pm.GetThreshold())}
+	for _, o := range pm.GetOwners() {
+		owner, err := types.HexToAddress(o)
+		if err != nil {
+			return nil, err
+		}
+		m.Owners = append(m.Owners, owner)
+	}
+	for _, s := range pm.GetSignatures() {
+		owner, err := types.HexToAddress(s.GetOwner())
+		if err != nil {
+			return nil, err
+		}
+		signature, err := types.NewSignature(s.GetSignature())
+		if err != nil {
+			return nil, err
+		}
+		m.Signatures = append(m.Signatures, types.OwnerSignature{Owner: owner, Signature: signature})
+	}
+	return m, nil
+}
+
+func toOriginStateBlock(blk *pbtypes.StateBlock) (*types.StateBlock, error) {
+	var err error
+	sb := &types.StateBlock{
+		Type:           types.BlockTypeFromStr(blk.GetType()),
+		Balance:        toBalance(blk.GetBalance()),
+		Vote:           toBalance(blk.GetVote()),
+		Network:        toBalance(blk.GetNetwork()),
+		Storage:        toBalance(blk.GetStorage()),
+		Oracle:         toBalance(blk.GetOracle()),
+		Sender:         blk.GetSender(),
+		Receiver:       blk.GetReceiver(),
+		Data:           blk.GetData(),
+		PoVHeight:      blk.GetPoVHeight(),
+		Timestamp:      blk.GetTimestamp(),
+		PrivateFrom:    blk.GetPrivateFrom(),
+		PrivateFor:     blk.GetPrivateFor(),
+		PrivateGroupID: blk.GetPrivateGroupID(),
+		Work:           types.Work(blk.GetWork()),
+	}
+	if sb.Token, err = types.NewHash(blk.GetToken()); err != nil {
+		return nil, err
+	}
+	if sb.Address, err = types.HexToAddress(blk.GetAddress()); err != nil {
+		return nil, err
+	}
+	if sb.Previous, err = types.NewHash(blk.GetPrevious()); err != nil {
+		return nil, err
+	}
+	if sb.Link, err = types.NewHash(blk.GetLink()); err != nil {
+		return nil, err
+	}
+	if sb.Message, err = types.NewHash(blk.GetMessage()); err != nil {
+		return nil, err
+	}
+	if sb.Extra, err = types.NewHash(blk.GetExtra()); err != nil {
+		return nil, err
+	}
+	if sb.Representative, err = types.HexToAddress(blk.GetRepresentative()); err != nil {
+		return nil, err
+	}
+	if sb.Signature, err = types.NewSignature(blk.GetSignature()); err != nil {
+		return nil, err
+	}
+	if sb.MultiSig, err = toOriginMultiSig(blk.GetMultiSig()); err != nil {
+		return nil, err
+	}
+	return sb, nil
+}
+
+// fromAPIBlock returns the state block of the block info returned by the node
+func fromAPIBlock(blk *pb.APIBlock) (*types.StateBlock, error) {
+	return toOriginStateBlock(&pbtypes.StateBlock{
+		Type:           blk.GetType(),
+		Token:          blk.GetToken(),
+		Address:        blk.GetAddress(),
+		Balance:        blk.GetBalance(),
+		Vote:           blk.GetVote(),
+		Network:        blk.GetNetwork(),
+		Storage:        blk.GetStorage(),
+		Oracle:         blk.GetOracle(),
+		Previous:       blk.GetPrevious(),
+		Link:           blk.GetLink(),
+		Sender:         blk.GetSender(),
+		Receiver:       blk.GetReceiver(),
+		Message:        blk.GetMessage(),
+		Data:           blk.GetData(),
+		PoVHeight:      blk.GetPoVHeight(),
+		Timestamp:      blk.GetTimestamp(),
+		Extra:          blk.GetExtra(),
+		Representative: blk.GetRepresentative(),
+		PrivateFrom:    blk.GetPrivateFrom(),
+		PrivateFor:     blk.GetPrivateFor(),
+		PrivateGroupID: blk.GetPrivateGroupID(),
+		Work:           blk.GetWork(),
+		Signature:      blk.GetSignature(),
+	})
+}
@@ -0,0 +1,53 @@
+// +build testnet
+
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+// DoDClient wraps the DoD settlement APIs, they are only served by json-rpc
+type DoDClient struct {
+	c *Client
+}
+
+func (c *Client) DoD() *DoDClient {
+	return &DoDClient{c: c}
+}
+
+// NewCreateOrderBlock returns the contract send block to create the order, the block is not signed
+func (d *DoDClient) NewCreateOrderBlock(ctx context.Context, param *api.DoDSettleCreateOrderParam) (*types.StateBlock, error) {
+	blk := new(types.StateBlock)
+	if err := d.c.Call(ctx, blk, "DoDSettlement_getCreateOrderBlock", param); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+// NewCreateOrderRewardBlock returns the contract reward block of the seller to confirm the order
+func (d *DoDClient) NewCreateOrderRewardBlock(ctx context.Context, param *api.DoDSettleResponseParam) (*types.StateBlock, error) {
+	blk := new(types.StateBlock)
+	if err := d.c.Call(ctx, blk, "DoDSettlement_getCreateOrderRewardBlock", param); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+func (d *DoDClient) OrderInfoByInternalId(ctx context.Context, internalId string) (*abi.DoDSettleOrderInfo, error) {
+	info := new(abi.DoDSettleOrderInfo)
+	if err := d.c.Call(ctx, info, "DoDSettlement_getOrderInfoByInternalId", internalId); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
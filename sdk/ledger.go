@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/status"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+var (
+	ErrBlockNotFound = errors.New("block not found")
+	ErrTokenNotFound = errors.New("token not found")
+)
+
+// LedgerClient wraps the ledger service
+type LedgerClient struct {
+	c *Client
+}
+
+// API returns the gRPC client of the service for calls which are not wrapped
+func (l *LedgerClient) API() pb.LedgerAPIClient {
+	return pb.NewLedgerAPIClient(l.c.pool.Get())
+}
+
+func (l *LedgerClient) AccountInfo(ctx context.Context, address types.Address) (*pb.APIAccount, error) {
+	return l.API().AccountInfo(ctx, &pbtypes.Address{Address: address.String()})
+}
+
+// TokenMeta returns the token chain of the account, ErrTokenNotFound is returned if the account has no such token
+func (l *LedgerClient) TokenMeta(ctx context.Context, address types.Address, token types.Hash) (*pb.APITokenMeta, error) {
+	info, err := l.AccountInfo(ctx, address)
+	if err != nil {
+		if isAccountNotFound(err) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, err
+	}
+	for _, tm := range info.GetTokens() {
+		if tm.GetType() == token.String() {
+			return tm, nil
+		}
+	}
+	return nil, ErrTokenNotFound
+}
+
+func (l *LedgerClient) BlockInfo(ctx context.Context, hash types.Hash) (*pb.APIBlock, error) {
+	r, err := l.API().BlocksInfo(ctx, &pbtypes.Hashes{Hashes: []string{hash.String()}})
+	if err != nil {
+		return nil, err
+	}
+	if len(r.GetBlocks()) == 0 {
+		return nil, ErrBlockNotFound
+	}
+	return r.GetBlocks()[0], nil
+}
+
+func (l *LedgerClient) Block(ctx context.Context, hash types.Hash) (*types.StateBlock, error) {
+	blk, err := l.BlockInfo(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return fromAPIBlock(blk)
+}
+
+// Pendings returns at most count pending receives of the account, -1 returns all of them
+func (l *LedgerClient) Pendings(ctx context.Context, address types.Address, count int) ([]*pb.APIPending, error) {
+	r, err := l.API().AccountsPending(ctx, &pb.AccountsPendingReq{Addresses: []string{address.String()}, Count: int32(count)})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetAccountsPendings()[address.String()].GetPendings(), nil
+}
+
+func (l *LedgerClient) TokenInfo(ctx context.Context, tokenName string) (*pbtypes.TokenInfo, error) {
+	return l.API().TokenInfoByName(ctx, &pb.String{Value: tokenName})
+}
+
+// TokenID returns the id of the token name
+func (l *LedgerClient) TokenID(ctx context.Context, tokenName string) (types.Hash, error) {
+	info, err := l.TokenInfo(ctx, tokenName)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	return types.NewHash(info.GetTokenId())
+}
+
+func (l *LedgerClient) ChainToken(ctx context.Context) (types.Hash, error) {
+	r, err := l.API().ChainToken(ctx, &empty.Empty{})
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	return types.NewHash(r.GetHash())
+}
+
+// Process sends the signed block to the node, the hash of the block is returned if it is accepted
+func (l *LedgerClient) Process(ctx context.Context, blk *types.StateBlock) (types.Hash, error) {
+	r, err := l.API().Process(ctx, toStateBlock(blk))
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	hash, err := types.NewHash(r.GetHash())
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	if hash != blk.GetHash() {
+		return hash, fmt.Errorf("processed block %s, expect %s", hash, blk.GetHash())
+	}
+	return hash, nil
+}
+
+func isAccountNotFound(err error) bool {
+	return strings.Contains(status.Convert(err).Message(), "account not found")
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+// PledgeClient wraps the nep5 pledge service
+type PledgeClient struct {
+	c *Client
+}
+
+// API returns the gRPC client of the service for calls which are not wrapped
+func (p *PledgeClient) API() pb.NEP5PledgeAPIClient {
+	return pb.NewNEP5PledgeAPIClient(p.c.pool.Get())
+}
+
+// PledgeInfos returns pledges of the pledge address
+func (p *PledgeClient) PledgeInfos(ctx context.Context, address types.Address) (*pb.PledgeInfos, error) {
+	return p.API().GetPledgeInfosByPledgeAddress(ctx, &pbtypes.Address{Address: address.String()})
+}
+
+// BeneficialPledgeInfos returns pledges of the beneficial address
+func (p *PledgeClient) BeneficialPledgeInfos(ctx context.Context, address types.Address) (*pb.PledgeInfos, error) {
+	return p.API().GetBeneficialPledgeInfosByAddress(ctx, &pbtypes.Address{Address: address.String()})
+}
+
+func (p *PledgeClient) TotalPledgeAmount(ctx context.Context) (types.Balance, error) {
+	r, err := p.API().GetTotalPledgeAmount(ctx, &empty.Empty{})
+	if err != nil {
+		return types.ZeroBalance, err
+	}
+	return toBalance(r.GetValue()), nil
+}
+
+// NewPledgeBlock builds the contract send block of the pledge, the block is not signed
+func (p *PledgeClient) NewPledgeBlock(ctx context.Context, param *api.PledgeParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, errors.New("invalid param")
+	}
+	if param.PledgeAddress.IsZero() || param.Beneficial.IsZero() || len(param.NEP5TxId) == 0 {
+		return nil, errors.New("invalid param")
+	}
+	t, err := cabi.StringToPledgeType(param.PType)
+	if err != nil {
+		return nil, err
+	}
+	data, err := (&cabi.PledgeParam{
+		Beneficial:    param.Beneficial,
+		PledgeAddress: param.PledgeAddress,
+		PType:         uint8(t),
+		NEP5TxId:      param.NEP5TxId,
+	}).ToABI()
+	if err != nil {
+		return nil, err
+	}
+	return p.c.NewContractSendBlock(ctx, param.PledgeAddress, contractaddress.NEP5PledgeAddress, param.Amount, data)
+}
+
+// NewWithdrawPledgeBlock builds the contract send block which withdraws the pledge, the block is not signed
+func (p *PledgeClient) NewWithdrawPledgeBlock(ctx context.Context, param *api.WithdrawPledgeParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, errors.New("invalid param")
+	}
+	if param.Beneficial.IsZero() || param.Amount.Int == nil || param.Amount.IsZero() || len(param.NEP5TxId) == 0 {
+		return nil, errors.New("invalid param")
+	}
+	t, err := cabi.StringToPledgeType(param.PType)
+	if err != nil {
+		return nil, err
+	}
+	data, err := (&cabi.WithdrawPledgeParam{
+		Beneficial: param.Beneficial,
+		Amount:     param.Amount.Int,
+		PType:      uint8(t),
+		NEP5TxId:   param.NEP5TxId,
+	}).ToABI()
+	if err != nil {
+		return nil, err
+	}
+	blk, err := p.c.NewContractSendBlock(ctx, param.Beneficial, contractaddress.NEP5PledgeAddress, types.ZeroBalance, data)
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case cabi.Network:
+		blk.Network = blk.Network.Sub(param.Amount)
+	case cabi.Vote:
+		blk.Vote = blk.Vote.Sub(param.Amount)
+	case cabi.Oracle:
+		blk.Oracle = blk.Oracle.Sub(param.Amount)
+	default:
+		return nil, fmt.Errorf("unsupport pledge type %s", param.PType)
+	}
+	return blk, nil
+}
+
+// RewardBlock returns the reward block of the pledge send block, it has to be signed by the beneficial
+func (p *PledgeClient) RewardBlock(ctx context.Context, sendHash types.Hash) (*types.StateBlock, error) {
+	r, err := p.API().GetPledgeRewardBlockBySendHash(ctx, &pbtypes.Hash{Hash: sendHash.String()})
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
+
+// WithdrawRewardBlock returns the reward block of the withdraw send block, it has to be signed by the pledge address
+func (p *PledgeClient) WithdrawRewardBlock(ctx context.Context, sendHash types.Hash) (*types.StateBlock, error) {
+	r, err := p.API().GetWithdrawRewardBlockBySendHash(ctx, &pbtypes.Hash{Hash: sendHash.String()})
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
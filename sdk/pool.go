@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// connPool keeps a fixed count of gRPC connections and hands them out round-robin,
+// each connection reconnects by itself when the node restarts
+type connPool struct {
+	conns []*grpc.ClientConn
+	next  uint32
+}
+
+func newConnPool(cfg *Config) (*connPool, error) {
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(timeoutInterceptor(cfg), retryInterceptor(cfg)),
+	}
+	if cfg.TLS != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg.TLS)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: cfg.Token, secure: cfg.TLS != nil}))
+	}
+	p := &connPool{}
+	for i := 0; i < cfg.PoolSize; i++ {
+		conn, err := grpc.Dial(cfg.GRPCEndpoint, opts...)
+		if err != nil {
+			_ = p.Close()
+			return nil, err
+		}
+		p.conns = append(p.conns, conn)
+	}
+	return p, nil
+}
+
+// Get returns the next connection
+func (p *connPool) Get() *grpc.ClientConn {
+	n := atomic.AddUint32(&p.next, 1)
+	return p.conns[int(n)%len(p.conns)]
+}
+
+func (p *connPool) Close() error {
+	var err error
+	for _, conn := range p.conns {
+		if e := conn.Close(); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// tokenCredentials sends the token as bearer token, the gRPC gateway forwards the same header
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+)
+
+// PovClient wraps the pov service
+type PovClient struct {
+	c *Client
+}
+
+// API returns the gRPC client of the service for calls which are not wrapped
+func (p *PovClient) API() pb.PovAPIClient {
+	return pb.NewPovAPIClient(p.c.pool.Get())
+}
+
+func (p *PovClient) Status(ctx context.Context) (*pb.PovStatus, error) {
+	return p.API().GetPovStatus(ctx, &empty.Empty{})
+}
+
+func (p *PovClient) LatestHeader(ctx context.Context) (*pb.PovApiHeader, error) {
+	return p.API().GetLatestHeader(ctx, &empty.Empty{})
+}
+
+// LatestHeight returns the height of the latest pov block, which is referred by new blocks
+func (p *PovClient) LatestHeight(ctx context.Context) (uint64, error) {
+	hdr, err := p.LatestHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hdr.GetBasHdr().GetHeight(), nil
+}
+
+func (p *PovClient) HeaderByHeight(ctx context.Context, height uint64) (*pb.PovApiHeader, error) {
+	return p.API().GetHeaderByHeight(ctx, &pb.UInt64{Value: height})
+}
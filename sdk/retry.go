@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	rpc "github.com/qlcchain/jsonrpc2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// backoff returns the wait before the retry, it doubles from MinBackoff up to MaxBackoff with jitter
+func backoff(cfg *Config, attempt int) time.Duration {
+	d := cfg.MinBackoff
+	for i := 0; i < attempt && d < cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > cfg.MaxBackoff {
+		d = cfg.MaxBackoff
+	}
+	// wait between d/2 and d, so clients reconnecting at the same time spread out
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retry calls fn until it succeeds, the error is not retryable, MaxRetries is reached or ctx is done
+func retry(ctx context.Context, cfg *Config, fn func(ctx context.Context) error, retryable func(error) bool) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = fn(ctx); err == nil || !retryable(err) || attempt >= cfg.MaxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff(cfg, attempt)):
+		}
+	}
+}
+
+// isRetryableGRPC returns true if the call is not handled by the node, e.g. the node is restarting
+// or the rate limit is exceeded
+func isRetryableGRPC(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableRPC returns true for connection errors, errors returned by the node are not retried
+func isRetryableRPC(err error) bool {
+	if err == context.Canceled || err == context.DeadlineExceeded || err == rpc.ErrClientQuit {
+		return false
+	}
+	_, ok := err.(rpc.Error)
+	return !ok
+}
+
+func retryInterceptor(cfg *Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return retry(ctx, cfg, func(ctx context.Context) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}, isRetryableGRPC)
+	}
+}
+
+// timeoutInterceptor sets the timeout of the config to calls without deadline, retries share the same deadline
+func timeoutInterceptor(cfg *Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && cfg.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
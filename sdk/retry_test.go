@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	cfg := &Config{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(cfg, attempt)
+		if d < cfg.MinBackoff/2 || d > cfg.MaxBackoff {
+			t.Fatalf("attempt %d, invalid backoff %s", attempt, d)
+		}
+	}
+	if d := backoff(cfg, 10); d < cfg.MaxBackoff/2 {
+		t.Fatalf("backoff should reach max, %s", d)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	cfg := &Config{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	calls := 0
+	err := retry(context.Background(), cfg, func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		return nil
+	}, isRetryableGRPC)
+	if err != nil || calls != 3 {
+		t.Fatal(err, calls)
+	}
+
+	calls = 0
+	err = retry(context.Background(), cfg, func(ctx context.Context) error {
+		calls++
+		return status.Error(codes.Unavailable, "unavailable")
+	}, isRetryableGRPC)
+	if status.Code(err) != codes.Unavailable || calls != cfg.MaxRetries+1 {
+		t.Fatal(err, calls)
+	}
+
+	calls = 0
+	err = retry(context.Background(), cfg, func(ctx context.Context) error {
+		calls++
+		return status.Error(codes.InvalidArgument, "invalid")
+	}, isRetryableGRPC)
+	if status.Code(err) != codes.InvalidArgument || calls != 1 {
+		t.Fatal(err, calls)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+// SettlementClient wraps the settlement service, it is only served by testnet nodes
+type SettlementClient struct {
+	c *Client
+}
+
+func (c *Client) Settlement() *SettlementClient {
+	return &SettlementClient{c: c}
+}
+
+// API returns the gRPC client of the service for calls which are not wrapped
+func (s *SettlementClient) API() pb.SettlementAPIClient {
+	return pb.NewSettlementAPIClient(s.c.pool.Get())
+}
+
+// AllContracts returns settlement contracts by page
+func (s *SettlementClient) AllContracts(ctx context.Context, count, offset int) (*pb.SettlementContracts, error) {
+	return s.API().GetAllContracts(ctx, &pb.Offset{Count: int32(count), Offset: int32(offset)})
+}
+
+// ContractsByAddress returns settlement contracts of the party address by page
+func (s *SettlementClient) ContractsByAddress(ctx context.Context, address types.Address, count, offset int) (*pb.SettlementContracts, error) {
+	return s.API().GetContractsByAddress(ctx, &pb.ContractsByAddressRequest{
+		Addr:   address.String(),
+		Count:  int32(count),
+		Offset: int32(offset),
+	})
+}
+
+// NewCreateContractBlock returns the contract send block to create the settlement contract, the block is not signed
+func (s *SettlementClient) NewCreateContractBlock(ctx context.Context, param *pb.CreateContractParam) (*types.StateBlock, error) {
+	r, err := s.API().GetCreateContractBlock(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
+
+// NewSignContractBlock returns the contract send block to sign the settlement contract, the block is not signed
+func (s *SettlementClient) NewSignContractBlock(ctx context.Context, param *pb.SignContractParam) (*types.StateBlock, error) {
+	r, err := s.API().GetSignContractBlock(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
+
+// RewardBlock returns the contract reward block of the settlement send block
+func (s *SettlementClient) RewardBlock(ctx context.Context, sendHash types.Hash) (*types.StateBlock, error) {
+	r, err := s.API().GetSettlementRewardsBlock(ctx, &pbtypes.Hash{Hash: sendHash.String()})
+	if err != nil {
+		return nil, err
+	}
+	return toOriginStateBlock(r)
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+// Subscription is a stream of notifications, the stream is opened again if it is broken, e.g. when the node restarts,
+// notifications sent while it is reconnecting are missed
+type Subscription struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Unsubscribe closes the stream and waits until the handler returns
+func (s *Subscription) Unsubscribe() {
+	s.cancel()
+	<-s.done
+}
+
+// Done is closed when the subscription is finished
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// subscribe opens the stream by open and passes received messages to handle until ctx is done,
+// open is called again with backoff when the stream is broken
+func (c *Client) subscribe(ctx context.Context, name string, open func(ctx context.Context) (func() error, error)) *Subscription {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Subscription{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		for attempt := 0; ; attempt++ {
+			recv, err := open(ctx)
+			if err == nil {
+				c.logger.Debugf("subscription %s opened", name)
+				for {
+					if err = recv(); err != nil {
+						break
+					}
+					attempt = 0
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			wait := backoff(c.cfg, attempt)
+			c.logger.Warnf("subscription %s broken: %s, reconnect in %s", name, err, wait)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+	}()
+	return s
+}
+
+// SubscribeBlocks calls fn with every new block of the node
+func (c *Client) SubscribeBlocks(ctx context.Context, fn func(*types.StateBlock)) *Subscription {
+	return c.subscribe(ctx, "blocks", func(ctx context.Context) (func() error, error) {
+		stream, err := c.Ledger().API().NewBlock(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			r, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			return c.handleBlock(r, fn)
+		}, nil
+	})
+}
+
+// SubscribeAccountBlocks calls fn with every new block of the account
+func (c *Client) SubscribeAccountBlocks(ctx context.Context, address types.Address, fn func(*types.StateBlock)) *Subscription {
+	return c.subscribe(ctx, "account blocks "+address.String(), func(ctx context.Context) (func() error, error) {
+		stream, err := c.Ledger().API().NewAccountBlock(ctx, &pbtypes.Address{Address: address.String()})
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			r, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			return c.handleBlock(r, fn)
+		}, nil
+	})
+}
+
+func (c *Client) handleBlock(r *pb.APIBlock, fn func(*types.StateBlock)) error {
+	blk, err := fromAPIBlock(r)
+	if err != nil {
+		// skip the invalid block, the stream is still usable
+		c.logger.Errorf("invalid block %s: %s", r.GetHash(), err)
+		return nil
+	}
+	fn(blk)
+	return nil
+}
+
+// SubscribeBalance calls fn with the account info when the balance of the account is changed
+func (c *Client) SubscribeBalance(ctx context.Context, address types.Address, fn func(*pb.APIAccount)) *Subscription {
+	return c.subscribe(ctx, "balance "+address.String(), func(ctx context.Context) (func() error, error) {
+		stream, err := c.Ledger().API().BalanceChange(ctx, &pbtypes.Address{Address: address.String()})
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			r, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			fn(r)
+			return nil
+		}, nil
+	})
+}
+
+// SubscribePendings calls fn with every new pending receive of the account
+func (c *Client) SubscribePendings(ctx context.Context, address types.Address, fn func(*pb.APIPending)) *Subscription {
+	return c.subscribe(ctx, "pendings "+address.String(), func(ctx context.Context) (func() error, error) {
+		stream, err := c.Ledger().API().NewPending(ctx, &pbtypes.Address{Address: address.String()})
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			r, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			fn(r)
+			return nil
+		}, nil
+	})
+}
+
+// SubscribePovHeaders calls fn with every new best pov header
+func (c *Client) SubscribePovHeaders(ctx context.Context, fn func(*pb.PovApiHeader)) *Subscription {
+	return c.subscribe(ctx, "pov headers", func(ctx context.Context) (func() error, error) {
+		stream, err := c.Pov().API().NewBlock(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			r, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			fn(r)
+			return nil
+		}, nil
+	})
+}
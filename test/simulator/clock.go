@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package simulator
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+type task struct {
+	at  time.Duration
+	seq uint64
+	fn  func()
+}
+
+type taskQueue []*task
+
+func (q taskQueue) Len() int { return len(q) }
+
+func (q taskQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q taskQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *taskQueue) Push(x interface{}) { *q = append(*q, x.(*task)) }
+
+func (q *taskQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return e
+}
+
+// Clock is the virtual clock of the simulator, the time is the duration since the simulation started.
+// Events are fired in the order of their time, and in the order they were scheduled for the same time.
+type Clock struct {
+	mu    sync.Mutex
+	now   time.Duration
+	seq   uint64
+	queue taskQueue
+}
+
+func NewClock() *Clock {
+	return &Clock{queue: make(taskQueue, 0)}
+}
+
+func (c *Clock) Now() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Schedule runs fn at the virtual time at, an event in the past is fired at the next advance
+func (c *Clock) Schedule(at time.Duration, fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if at < c.now {
+		at = c.now
+	}
+	c.seq++
+	heap.Push(&c.queue, &task{at: at, seq: c.seq, fn: fn})
+}
+
+// After runs fn when d is elapsed from now
+func (c *Clock) After(d time.Duration, fn func()) {
+	c.Schedule(c.Now()+d, fn)
+}
+
+// Pending returns the number of events not fired yet
+func (c *Clock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queue)
+}
+
+// advance moves the clock to the earliest event not later than deadline, and returns all the events of that time.
+// If there is no such event, the clock is moved to deadline and nil is returned.
+func (c *Clock) advance(deadline time.Duration) []*task {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.queue) == 0 || c.queue[0].at > deadline {
+		if deadline > c.now {
+			c.now = deadline
+		}
+		return nil
+	}
+
+	at := c.queue[0].at
+	c.now = at
+	tasks := make([]*task, 0)
+	for len(c.queue) > 0 && c.queue[0].at == at {
+		tasks = append(tasks, heap.Pop(&c.queue).(*task))
+	}
+	return tasks
+}
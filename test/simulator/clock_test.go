@@ -0,0 +1,51 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock_Advance(t *testing.T) {
+	c := NewClock()
+	fired := make([]int, 0)
+	record := func(i int) func() {
+		return func() { fired = append(fired, i) }
+	}
+
+	c.Schedule(2*time.Second, record(3))
+	c.Schedule(time.Second, record(1))
+	c.Schedule(time.Second, record(2))
+	c.Schedule(5*time.Second, record(4))
+	if c.Pending() != 4 {
+		t.Fatal("invalid pending", c.Pending())
+	}
+
+	for {
+		tasks := c.advance(3 * time.Second)
+		if tasks == nil {
+			break
+		}
+		for _, task := range tasks {
+			task.fn()
+		}
+	}
+	if len(fired) != 3 || fired[0] != 1 || fired[1] != 2 || fired[2] != 3 {
+		t.Fatal("invalid order", fired)
+	}
+	if c.Now() != 3*time.Second || c.Pending() != 1 {
+		t.Fatal("invalid clock", c.Now(), c.Pending())
+	}
+
+	// the past is fired at the current time
+	c.Schedule(time.Second, record(5))
+	tasks := c.advance(3 * time.Second)
+	if len(tasks) != 1 || c.Now() != 3*time.Second {
+		t.Fatal("invalid past task", len(tasks), c.Now())
+	}
+
+	c.After(time.Second, record(6))
+	tasks = c.advance(10 * time.Second)
+	if len(tasks) != 1 || c.Now() != 4*time.Second {
+		t.Fatal("invalid after", len(tasks), c.Now())
+	}
+}
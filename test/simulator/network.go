@@ -0,0 +1,370 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+// Any matches all the nodes when used as an end of a link
+const Any = -1
+
+// Link is the fault model of the one-way link from a node to another
+type Link struct {
+	Latency  time.Duration // delay of every message
+	Jitter   time.Duration // extra random delay in [0, Jitter)
+	DropRate float64       // probability of losing a message
+	DupRate  float64       // probability of delivering a message twice
+	Down     bool          // all messages are lost, set by partitions
+}
+
+// Stats counts the messages carried by the network, a broadcast is counted once per receiver
+type Stats struct {
+	Sent        uint64 `json:"sent"`
+	Delivered   uint64 `json:"delivered"`
+	Dropped     uint64 `json:"dropped"`
+	Duplicated  uint64 `json:"duplicated"`
+	Unsupported uint64 `json:"unsupported"`
+}
+
+type envelope struct {
+	from int
+	to   int
+	typ  p2p.MessageType
+	data []byte
+}
+
+// network is the fake transport in place of the libp2p QlcService. It takes the messages published by the nodes to
+// EventBroadcast and EventSendMsgToSingle, and delivers them to the event bus of the receivers like MessageService
+// does. Messages are held until flush, so they are scheduled on the virtual clock in a stable order.
+type network struct {
+	mu       sync.Mutex
+	clock    *Clock
+	nodes    []*Node
+	peers    map[string]int
+	links    [][]Link
+	rands    [][]*rand.Rand
+	pending  [][]*envelope
+	stats    Stats
+	activity uint64
+
+	// onFrontierReq runs the ledger sync of node from with the ledger of node to
+	onFrontierReq func(from, to int)
+
+	subscribers []*event.ActorSubscriber
+	logger      *zap.SugaredLogger
+}
+
+func newNetwork(clock *Clock, nodes []*Node, seed int64, link Link) *network {
+	n := len(nodes)
+	net := &network{
+		clock:       clock,
+		nodes:       nodes,
+		peers:       make(map[string]int),
+		links:       make([][]Link, n),
+		rands:       make([][]*rand.Rand, n),
+		pending:     make([][]*envelope, n),
+		subscribers: make([]*event.ActorSubscriber, 0),
+		logger:      log.NewLogger("simulator_network"),
+	}
+	for i, node := range nodes {
+		net.peers[node.ID] = i
+		net.links[i] = make([]Link, n)
+		net.rands[i] = make([]*rand.Rand, n)
+		for j := 0; j < n; j++ {
+			net.links[i][j] = link
+			// every link has its own random source, so the fate of the k-th message of a link only depends on the seed
+			net.rands[i][j] = rand.New(rand.NewSource(seed*int64(n*n) + int64(i*n+j)))
+		}
+	}
+	return net
+}
+
+func (net *network) start() error {
+	for i, node := range net.nodes {
+		from := i
+		subscriber := event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+			switch msg := c.Message().(type) {
+			case *p2p.EventBroadcastMsg:
+				net.capture(from, Any, msg.Type, msg.Message)
+			case *topic.EventBroadcastMsg:
+				net.capture(from, Any, p2p.MessageType(msg.Type), msg.Message)
+			case *p2p.EventSendMsgToSingleMsg:
+				if to, ok := net.peers[msg.PeerID]; ok {
+					net.capture(from, to, msg.Type, msg.Message)
+				}
+			case *p2p.EventFrontiersReqMsg:
+				if to, ok := net.peers[msg.PeerID]; ok {
+					net.capture(from, to, p2p.FrontierRequest, nil)
+				}
+			case string:
+				// pov syncer requests frontiers with the peer id only
+				if to, ok := net.peers[msg]; ok {
+					net.capture(from, to, p2p.FrontierRequest, nil)
+				}
+			}
+		}), node.cc.EventBus())
+
+		if err := subscriber.Subscribe(topic.EventBroadcast, topic.EventSendMsgToSingle, topic.EventFrontiersReq); err != nil {
+			return err
+		}
+		net.subscribers = append(net.subscribers, subscriber)
+	}
+
+	// connect all the nodes with each other
+	for i, node := range net.nodes {
+		for j, peer := range net.nodes {
+			if i != j {
+				node.cc.EventBus().Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: peer.ID})
+			}
+		}
+	}
+	return nil
+}
+
+func (net *network) stop() {
+	for _, subscriber := range net.subscribers {
+		if err := subscriber.UnsubscribeAll(); err != nil {
+			net.logger.Error(err)
+		}
+	}
+	net.subscribers = net.subscribers[:0]
+}
+
+// capture encodes the message sent by a node, to is Any for broadcast
+func (net *network) capture(from, to int, typ p2p.MessageType, value interface{}) {
+	defer atomic.AddUint64(&net.activity, 1)
+
+	var data []byte
+	if typ != p2p.FrontierRequest {
+		var err error
+		data, err = encodeMessage(typ, value)
+		if err != nil {
+			net.mu.Lock()
+			net.stats.Unsupported++
+			net.mu.Unlock()
+			net.logger.Debugf("node %d send %s: %s", from, typ, err)
+			return
+		}
+	}
+
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	net.pending[from] = append(net.pending[from], &envelope{from: from, to: to, typ: typ, data: data})
+}
+
+// flush schedules the captured messages in the order of sender, receiver and sending
+func (net *network) flush() {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+
+	for from, envs := range net.pending {
+		for to := range net.nodes {
+			if to == from {
+				continue
+			}
+			for _, env := range envs {
+				if env.to == Any || env.to == to {
+					net.send(from, to, env.typ, env.data)
+				}
+			}
+		}
+		net.pending[from] = nil
+	}
+}
+
+// send decides the fate of the message by the link, must be called with the lock held
+func (net *network) send(from, to int, typ p2p.MessageType, data []byte) {
+	link := net.links[from][to]
+	r := net.rands[from][to]
+	net.stats.Sent++
+
+	// always draw the same numbers, so the random stream of a link does not depend on its fault model
+	drop, dup, jitter := r.Float64(), r.Float64(), r.Float64()
+	if link.Down || drop < link.DropRate {
+		net.stats.Dropped++
+		return
+	}
+
+	delay := link.Latency + time.Duration(jitter*float64(link.Jitter))
+	deliver := func() {
+		net.deliver(from, to, typ, data)
+	}
+	net.clock.After(delay, deliver)
+	if dup < link.DupRate {
+		net.stats.Duplicated++
+		net.clock.After(delay, deliver)
+	}
+}
+
+func (net *network) deliver(from, to int, typ p2p.MessageType, data []byte) {
+	net.mu.Lock()
+	if net.links[from][to].Down {
+		// lost in flight
+		net.stats.Dropped++
+		net.mu.Unlock()
+		return
+	}
+	net.stats.Delivered++
+	net.mu.Unlock()
+
+	if typ == p2p.FrontierRequest {
+		if net.onFrontierReq != nil {
+			net.onFrontierReq(from, to)
+		}
+		return
+	}
+
+	if err := publishMessage(net.nodes[to].cc.EventBus(), net.nodes[from].ID, typ, data); err != nil {
+		net.logger.Errorf("node %d receive %s from node %d: %s", to, typ, from, err)
+	}
+}
+
+// inject sends a message from a node without going through its event bus, still subject to the links
+func (net *network) inject(from, to int, typ p2p.MessageType, value interface{}) error {
+	data, err := encodeMessage(typ, value)
+	if err != nil {
+		return err
+	}
+
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	for i := range net.nodes {
+		if i != from && (to == Any || to == i) {
+			net.send(from, i, typ, data)
+		}
+	}
+	return nil
+}
+
+func (net *network) setLink(from, to int, fn func(link *Link)) {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+
+	for i := range net.nodes {
+		for j := range net.nodes {
+			if i != j && (from == Any || from == i) && (to == Any || to == j) {
+				fn(&net.links[i][j])
+			}
+		}
+	}
+}
+
+func (net *network) link(from, to int) Link {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	return net.links[from][to]
+}
+
+func (net *network) connected(a, b int) bool {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	return !net.links[a][b].Down && !net.links[b][a].Down
+}
+
+func (net *network) getStats() Stats {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	return net.stats
+}
+
+func (net *network) getActivity() uint64 {
+	return atomic.LoadUint64(&net.activity)
+}
+
+// encodeMessage serializes the messages like the p2p layer, so the receivers never share memory with the sender
+func encodeMessage(typ p2p.MessageType, value interface{}) ([]byte, error) {
+	switch typ {
+	case p2p.PublishReq:
+		return protos.PublishBlockToProto(&protos.PublishBlock{Blk: value.(*types.StateBlock)})
+	case p2p.ConfirmReq:
+		return protos.ConfirmReqBlockToProto(&protos.ConfirmReqBlock{Blk: value.([]*types.StateBlock)})
+	case p2p.ConfirmAck:
+		return protos.ConfirmAckBlockToProto(value.(*protos.ConfirmAckBlock))
+	case p2p.PovStatus:
+		return protos.PovStatusToProto(value.(*protos.PovStatus))
+	case p2p.PovPublishReq:
+		return protos.PovPublishBlockToProto(&protos.PovPublishBlock{Blk: value.(*types.PovBlock)})
+	case p2p.PovBulkPullReq:
+		return protos.PovBulkPullReqToProto(value.(*protos.PovBulkPullReq))
+	case p2p.PovBulkPullRsp:
+		return protos.PovBulkPullRspToProto(value.(*protos.PovBulkPullRsp))
+	default:
+		return nil, fmt.Errorf("message type %s is not supported", typ)
+	}
+}
+
+// publishMessage decodes the message and publishes it to the receiver like MessageService
+func publishMessage(eb event.EventBus, from string, typ p2p.MessageType, data []byte) error {
+	switch typ {
+	case p2p.PublishReq:
+		p, err := protos.PublishBlockFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventPublish, &topic.EventPublishMsg{Block: p.Blk, From: from})
+	case p2p.ConfirmReq:
+		r, err := protos.ConfirmReqBlockFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventConfirmReq, &topic.EventConfirmReqMsg{Blocks: r.Blk, From: from})
+	case p2p.ConfirmAck:
+		ack, err := protos.ConfirmAckBlockFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventConfirmAck, &p2p.EventConfirmAckMsg{Block: ack, From: from})
+	case p2p.PovStatus:
+		status, err := protos.PovStatusFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventPovPeerStatus, &p2p.EventPovPeerStatusMsg{Status: status, From: from})
+	case p2p.PovPublishReq:
+		p, err := protos.PovPublishBlockFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventPovRecvBlock, &topic.EventPovRecvBlockMsg{
+			Block:   p.Blk,
+			From:    types.PovBlockFromRemoteBroadcast,
+			MsgPeer: from,
+		})
+	case p2p.PovBulkPullReq:
+		req, err := protos.PovBulkPullReqFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventPovBulkPullReq, &p2p.EventPovBulkPullReqMsg{Req: req, From: from})
+	case p2p.PovBulkPullRsp:
+		rsp, err := protos.PovBulkPullRspFromProto(data)
+		if err != nil {
+			return err
+		}
+		eb.Publish(topic.EventPovBulkPullRsp, &p2p.EventPovBulkPullRspMsg{Resp: rsp, From: from})
+	default:
+		return errors.New("unknown message type")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package simulator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/p2p"
+)
+
+func testNetwork(seed int64) *network {
+	nodes := make([]*Node, 3)
+	for i := range nodes {
+		nodes[i] = &Node{Index: i, ID: fmt.Sprintf("sim-node-%d", i)}
+	}
+	return newNetwork(NewClock(), nodes, seed, Link{Latency: 10 * time.Millisecond})
+}
+
+func TestNetwork_SameSeed(t *testing.T) {
+	run := func(seed int64) (Stats, int) {
+		net := testNetwork(seed)
+		net.setLink(Any, Any, func(link *Link) {
+			link.DropRate = 0.3
+			link.DupRate = 0.3
+		})
+		for i := 0; i < 100; i++ {
+			net.send(i%3, (i+1)%3, p2p.PublishReq, nil)
+		}
+		return net.getStats(), net.clock.Pending()
+	}
+
+	s1, p1 := run(1)
+	s2, p2 := run(1)
+	if s1 != s2 || p1 != p2 {
+		t.Fatal("same seed makes different faults", s1, s2)
+	}
+	if s1.Sent != 100 || s1.Dropped == 0 || s1.Duplicated == 0 {
+		t.Fatal("invalid stats", s1)
+	}
+	if uint64(p1) != s1.Sent-s1.Dropped+s1.Duplicated {
+		t.Fatal("invalid pending", p1, s1)
+	}
+}
+
+func TestNetwork_Partition(t *testing.T) {
+	net := testNetwork(1)
+	net.setLink(0, Any, func(link *Link) {
+		link.Down = true
+	})
+	if net.connected(0, 1) || net.connected(2, 0) || !net.connected(1, 2) {
+		t.Fatal("invalid links")
+	}
+
+	net.send(0, 1, p2p.PublishReq, nil)
+	net.send(1, 0, p2p.PublishReq, nil)
+	if s := net.getStats(); s.Sent != 2 || s.Dropped != 1 || net.clock.Pending() != 1 {
+		t.Fatal("invalid stats", s)
+	}
+}
@@ -0,0 +1,289 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/merkle"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/consensus"
+	"github.com/qlcchain/go-qlc/consensus/dpos"
+	"github.com/qlcchain/go-qlc/consensus/pov"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+// Node is a chain node of the simulator, it runs the ledger, the DPoS consensus and the PoV engine of a real node,
+// the p2p service is replaced by the simulated network.
+type Node struct {
+	Index int
+	ID    string
+	Rep   *types.Account
+
+	dir     string
+	cfgFile string
+	cc      *context.ChainContext
+	ledger  *ledger.Ledger
+	cons    *consensus.Consensus
+	pov     *pov.PoVEngine
+}
+
+func newNode(index int, rep *types.Account) (*Node, error) {
+	dir := filepath.Join(config.QlcTestDataDir(), "simulator", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	if _, err := cm.Load(); err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		Index:   index,
+		ID:      fmt.Sprintf("sim-node-%d", index),
+		Rep:     rep,
+		dir:     dir,
+		cfgFile: cm.ConfigFile,
+		cc:      context.NewChainContext(cm.ConfigFile),
+	}
+
+	cfg, err := n.cc.Config()
+	if err != nil {
+		return nil, err
+	}
+	cfg.PoV.PovEnabled = true
+
+	if err := n.cc.Init(nil); err != nil {
+		return nil, err
+	}
+	if err := n.cc.Start(); err != nil {
+		return nil, err
+	}
+
+	ls := chain.NewLedgerService(n.cfgFile)
+	if err := ls.Init(); err != nil {
+		return nil, err
+	}
+	n.ledger = ls.Ledger
+
+	for _, blk := range []*types.StateBlock{&mock.TestSendBlock, &mock.TestReceiveBlock, &mock.TestSendGasBlock,
+		&mock.TestReceiveGasBlock, &mock.TestChangeRepresentative} {
+		if err := n.processLocal(blk); err != nil {
+			return nil, err
+		}
+	}
+
+	if n.pov, err = pov.NewPovEngine(n.cfgFile, true); err != nil {
+		return nil, err
+	}
+	if err := n.pov.Init(); err != nil {
+		return nil, err
+	}
+	if err := n.pov.Start(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// startConsensus runs DPoS with the representative of the node, the fixture must be in the ledger already
+func (n *Node) startConsensus() {
+	n.cc.SetAccounts([]*types.Account{n.Rep})
+	n.cons = consensus.NewConsensus(dpos.NewDPoS(n.cfgFile), n.cfgFile)
+	n.cons.Init()
+	n.cons.Start()
+	n.cc.EventBus().Publish(topic.EventPovSyncState, topic.SyncDone)
+}
+
+func (n *Node) stop() error {
+	if n.cons != nil {
+		n.cons.Stop()
+	}
+	if n.pov != nil {
+		_ = n.pov.Stop()
+	}
+	if err := n.ledger.Close(); err != nil {
+		return err
+	}
+	if err := n.cc.Destroy(); err != nil {
+		return err
+	}
+	return os.RemoveAll(n.dir)
+}
+
+func (n *Node) Ledger() *ledger.Ledger {
+	return n.ledger
+}
+
+func (n *Node) ChainContext() *context.ChainContext {
+	return n.cc
+}
+
+func (n *Node) PovEngine() *pov.PoVEngine {
+	return n.pov
+}
+
+func (n *Node) processLocal(blk *types.StateBlock) error {
+	r, err := process.NewLedgerVerifier(n.ledger).Process(blk)
+	if err != nil {
+		return err
+	}
+	if r != process.Progress {
+		return fmt.Errorf("process block %s: %s", blk.GetHash(), r)
+	}
+	return nil
+}
+
+// ProcessBlock processes the block like it is generated by the wallet of the node, the block is published to
+// the network and waits for the votes of the representatives
+func (n *Node) ProcessBlock(blk *types.StateBlock) error {
+	verifier := process.NewLedgerVerifier(n.ledger)
+	flag, err := verifier.BlockCacheCheck(blk)
+	if flag == process.Other {
+		return err
+	}
+	if flag != process.Progress {
+		return fmt.Errorf("check block %s: %s", blk.GetHash(), flag)
+	}
+	if err := verifier.BlockCacheProcess(blk); err != nil {
+		return err
+	}
+
+	eb := n.cc.EventBus()
+	eb.Publish(topic.EventAddBlockCache, blk)
+	eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{Type: p2p.PublishReq, Message: blk})
+	eb.Publish(topic.EventGenerateBlock, blk)
+	return nil
+}
+
+// GenerateSend generates a signed send block of chain token, from must have an account in the ledger of the node
+func (n *Node) GenerateSend(from *types.Account, to types.Address, amount types.Balance) (*types.StateBlock, error) {
+	b := &types.StateBlock{
+		Address: from.Address(),
+		Link:    to.ToHash(),
+		Token:   config.ChainToken(),
+	}
+	return n.ledger.GenerateSendBlock(b, amount, from.PrivateKey())
+}
+
+// GenerateOpen generates a signed open block, which is represented by the receiver itself
+func (n *Node) GenerateOpen(send *types.StateBlock, to *types.Account) (*types.StateBlock, error) {
+	blk, err := n.ledger.GenerateReceiveBlock(send, nil)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Type != types.Open {
+		return nil, errors.New("account is opened already")
+	}
+	blk.Representative = to.Address()
+	blk.Signature = to.Sign(blk.GetHash())
+	worker, _ := types.NewWorker(types.Work(0), blk.Root())
+	blk.Work = worker.NewWork()
+	return blk, nil
+}
+
+func (n *Node) IsConfirmed(hash types.Hash) bool {
+	has, _ := n.ledger.HasStateBlockConfirmed(hash)
+	return has
+}
+
+// Vote signs a confirm ack of the hashes by the representative of the node
+func (n *Node) Vote(hashes ...types.Hash) *protos.ConfirmAckBlock {
+	hashBytes := make([]byte, 0, len(hashes)*types.HashSize)
+	for _, h := range hashes {
+		hashBytes = append(hashBytes, h[:]...)
+	}
+	hash, _ := types.HashBytes(hashBytes)
+	return &protos.ConfirmAckBlock{
+		Hash:      hashes,
+		Account:   n.Rep.Address(),
+		Signature: n.Rep.Sign(hash),
+	}
+}
+
+// MinePov mines a PoV block on the latest block of the node and broadcasts it. The block is solved by the fake
+// consensus, and its timestamp follows the previous one, so the same chain is mined in every run.
+func (n *Node) MinePov() (*types.PovBlock, error) {
+	povChain := n.pov.GetChain()
+	latestHeader := povChain.LatestHeader()
+	if latestHeader == nil {
+		return nil, errors.New("failed to get latest header")
+	}
+
+	mineBlock := types.NewPovMineBlock()
+	header := mineBlock.Header
+	header.BasHdr.Version = types.POV_VBS_TOPBITS | uint32(types.ALGO_SHA256D)
+	header.BasHdr.Previous = latestHeader.GetHash()
+	header.BasHdr.Height = latestHeader.GetHeight() + 1
+	header.BasHdr.Timestamp = latestHeader.GetTimestamp() + 1
+	header.BasHdr.Nonce = header.BasHdr.Timestamp
+
+	gsdb := statedb.NewPovGlobalStateDB(n.ledger.DBStore(), latestHeader.GetStateHash())
+	accBlocks := n.pov.GetTxPool().SelectPendingTxs(gsdb, 1000)
+
+	accTxHashes := make([]*types.Hash, 0, len(accBlocks))
+	accTxs := make([]*types.PovTransaction, 0, len(accBlocks))
+	for _, accBlock := range accBlocks {
+		accTx := &types.PovTransaction{Hash: accBlock.GetHash(), Block: accBlock}
+		accTxHashes = append(accTxHashes, &accTx.Hash)
+		accTxs = append(accTxs, accTx)
+	}
+
+	if err := n.pov.GetConsensus().PrepareHeader(header); err != nil {
+		return nil, err
+	}
+	if err := povChain.TransitStateDB(header.GetHeight(), accTxs, gsdb); err != nil {
+		return nil, err
+	}
+
+	cbtx := header.CbTx
+	cbtx.TxNum = uint32(len(accTxs) + 1)
+	cbtx.StateHash = gsdb.GetCurHash()
+
+	minerRwd, repRwd, err := povChain.CalcBlockReward(header)
+	if err != nil {
+		return nil, err
+	}
+	minerTxOut := cbtx.GetMinerTxOut()
+	minerTxOut.Address = n.Rep.Address()
+	minerTxOut.Value = minerRwd
+	repTxOut := cbtx.GetRepTxOut()
+	repTxOut.Address = contractaddress.MinerAddress
+	repTxOut.Value = repRwd
+	cbtx.Hash = cbtx.ComputeHash()
+
+	cbTxPov := &types.PovTransaction{Hash: cbtx.Hash, CbTx: cbtx}
+	mineBlock.Body.Txs = append(mineBlock.Body.Txs, cbTxPov)
+	mineBlock.Body.Txs = append(mineBlock.Body.Txs, accTxs...)
+
+	txHashes := append([]*types.Hash{&cbTxPov.Hash}, accTxHashes...)
+	header.BasHdr.MerkleRoot = merkle.CalcMerkleTreeRootHash(txHashes)
+
+	block := mineBlock.Block
+	block.Header.BasHdr.Hash = block.ComputeHash()
+	if err := n.pov.AddMinedBlock(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// PovTip returns the latest PoV header of the node
+func (n *Node) PovTip() *types.PovHeader {
+	return n.pov.GetChain().LatestHeader()
+}
@@ -0,0 +1,465 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package simulator runs several chain nodes in one process, connected by a simulated network instead of libp2p,
+// so the consensus and the sync of ledger and PoV chain can be tested with partitions, delays, losses, duplicated
+// messages and equivocating representatives in go test.
+//
+// The network is driven by a virtual clock: the messages sent by the nodes are held until the nodes are quiet, then
+// scheduled on the clock in the order of sender and sending, and their delays and faults are decided by a random
+// source of each link seeded by Config.Seed. The virtual clock does not drive the consensus engines, the nodes run
+// their own goroutines and wall clock timers (batched votes, PoV sync tickers), so the messages sent and the outcome
+// of a run depend on the scheduling and the load of the machine, and runs are not deterministic. Tests should wait
+// for the expected state by RunUntil with a generous limit instead of checking it after a fixed time.
+package simulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+)
+
+// testPrivateKey is the account opened by mock.TestReceiveBlock, which holds all the supply and represents itself
+const testPrivateKey = "194908c480fddb6e66b56c08f0d55d935681da0b3c9c33077010bf12a91414576c0b2cdd533ee3a21668f199e111f6c8614040e60e70a73ab6c8da036f2a7ad7"
+
+type Config struct {
+	Nodes int   // number of the nodes, each has a representative with the same weight
+	Seed  int64 // seed of the representatives and the fault decisions
+	Link  Link  // default fault model of all the links
+
+	// Quiet is how long the network must be silent before the virtual clock moves on, it is wall time given to the
+	// nodes to process the delivered messages, and the virtual time passed when nothing is scheduled. MaxSettle
+	// limits the wait if a node never gets quiet.
+	Quiet     time.Duration
+	MaxSettle time.Duration
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Nodes:     4,
+		Seed:      1,
+		Link:      Link{Latency: 50 * time.Millisecond},
+		Quiet:     3 * time.Second,
+		MaxSettle: 30 * time.Second,
+	}
+}
+
+type Simulator struct {
+	cfg     *Config
+	clock   *Clock
+	nodes   []*Node
+	network *network
+	stopped int32
+	logger  *zap.SugaredLogger
+}
+
+// New starts the nodes with the same genesis and fixture, the supply is shared by the representatives equally
+func New(cfg *Config) (*Simulator, error) {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+	if cfg.Nodes < 2 {
+		return nil, errors.New("at least two nodes are required")
+	}
+	if cfg.Quiet <= 0 {
+		cfg.Quiet = DefaultConfig().Quiet
+	}
+	if cfg.MaxSettle < cfg.Quiet {
+		cfg.MaxSettle = cfg.Quiet
+	}
+
+	reps, err := representatives(cfg.Seed, cfg.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &Simulator{
+		cfg:    cfg,
+		clock:  NewClock(),
+		nodes:  make([]*Node, 0, cfg.Nodes),
+		logger: log.NewLogger("simulator"),
+	}
+	for i := 0; i < cfg.Nodes; i++ {
+		node, err := newNode(i, reps[i])
+		if err != nil {
+			sim.Stop()
+			return nil, fmt.Errorf("start node %d: %s", i, err)
+		}
+		sim.nodes = append(sim.nodes, node)
+	}
+
+	if err := sim.initFixture(); err != nil {
+		sim.Stop()
+		return nil, err
+	}
+
+	sim.network = newNetwork(sim.clock, sim.nodes, cfg.Seed, cfg.Link)
+	sim.network.onFrontierReq = func(from, to int) {
+		if err := sim.nodes[from].syncWith(sim.nodes[to]); err != nil {
+			sim.logger.Infof("node %d sync with node %d: %s", from, to, err)
+		}
+	}
+	if err := sim.network.start(); err != nil {
+		sim.Stop()
+		return nil, err
+	}
+
+	for _, node := range sim.nodes {
+		node.startConsensus()
+	}
+	return sim, nil
+}
+
+func representatives(seed int64, count int) ([]*types.Account, error) {
+	h := types.HashData([]byte(fmt.Sprintf("simulator-%d", seed)))
+	s, err := types.BytesToSeed(h[:])
+	if err != nil {
+		return nil, err
+	}
+	reps := make([]*types.Account, 0, count)
+	for i := 0; i < count; i++ {
+		acc, err := s.Account(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		reps = append(reps, acc)
+	}
+	return reps, nil
+}
+
+// initFixture moves the supply from the genesis account to the representatives, the blocks are generated on the
+// first node and processed on all the nodes, so all the ledgers start from the same confirmed frontiers
+func (sim *Simulator) initFixture() error {
+	genesis := sim.nodes[0]
+	prk, err := hex.DecodeString(testPrivateKey)
+	if err != nil {
+		return err
+	}
+	account := types.NewAccount(prk)
+	tm, err := genesis.ledger.GetTokenMeta(account.Address(), config.ChainToken())
+	if err != nil {
+		return err
+	}
+	amount, err := tm.Balance.Div(int64(len(sim.nodes)))
+	if err != nil {
+		return err
+	}
+
+	sends := make([]*types.StateBlock, 0, len(sim.nodes))
+	for _, node := range sim.nodes {
+		send, err := genesis.GenerateSend(account, node.Rep.Address(), amount)
+		if err != nil {
+			return err
+		}
+		if err := genesis.processLocal(send); err != nil {
+			return err
+		}
+		sends = append(sends, send)
+	}
+
+	blks := sends
+	for i, node := range sim.nodes {
+		open, err := genesis.GenerateOpen(sends[i], node.Rep)
+		if err != nil {
+			return err
+		}
+		if err := genesis.processLocal(open); err != nil {
+			return err
+		}
+		blks = append(blks, open)
+	}
+
+	for _, node := range sim.nodes[1:] {
+		for _, blk := range blks {
+			if err := node.processLocal(blk); err != nil {
+				return fmt.Errorf("node %d: %s", node.Index, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Stop stops all the nodes and removes their data
+func (sim *Simulator) Stop() {
+	if !atomic.CompareAndSwapInt32(&sim.stopped, 0, 1) {
+		return
+	}
+	if sim.network != nil {
+		sim.network.stop()
+	}
+	for _, node := range sim.nodes {
+		if err := node.stop(); err != nil {
+			sim.logger.Errorf("stop node %d: %s", node.Index, err)
+		}
+	}
+}
+
+func (sim *Simulator) Node(i int) *Node {
+	return sim.nodes[i]
+}
+
+func (sim *Simulator) Nodes() []*Node {
+	return sim.nodes
+}
+
+func (sim *Simulator) Clock() *Clock {
+	return sim.clock
+}
+
+func (sim *Simulator) Stats() Stats {
+	return sim.network.getStats()
+}
+
+// At runs fn at the virtual time t, it is how faults are scripted
+func (sim *Simulator) At(t time.Duration, fn func()) {
+	sim.clock.Schedule(t, fn)
+}
+
+// Run runs the simulation for d of virtual time
+func (sim *Simulator) Run(d time.Duration) {
+	sim.RunUntil(d, nil)
+}
+
+// RunUntil runs the simulation until cond is true or max of virtual time elapsed, cond is checked when the nodes
+// are quiet. It returns whether cond is satisfied.
+func (sim *Simulator) RunUntil(max time.Duration, cond func() bool) bool {
+	deadline := sim.clock.Now() + max
+	for {
+		sim.settle()
+		if cond != nil && cond() {
+			return true
+		}
+		sim.network.flush()
+		if sim.clock.Now() >= deadline {
+			return cond == nil
+		}
+
+		// the nodes may still be working by their own timers when nothing is scheduled, so the idle time goes
+		// by the quiet period, and the messages scheduled after the deadline are left to the next run
+		next := sim.clock.Now() + sim.cfg.Quiet
+		if next > deadline {
+			next = deadline
+		}
+		for _, t := range sim.clock.advance(next) {
+			t.fn()
+		}
+	}
+}
+
+// settle waits until no message is sent by the nodes for the quiet period
+func (sim *Simulator) settle() {
+	start := time.Now()
+	last := sim.network.getActivity()
+	quietSince := time.Now()
+	for time.Since(start) < sim.cfg.MaxSettle {
+		time.Sleep(50 * time.Millisecond)
+		if cur := sim.network.getActivity(); cur != last {
+			last = cur
+			quietSince = time.Now()
+			continue
+		}
+		if time.Since(quietSince) >= sim.cfg.Quiet {
+			return
+		}
+	}
+}
+
+// SetLink replaces the fault model of the links from a node to another, Any matches all the nodes
+func (sim *Simulator) SetLink(from, to int, link Link) {
+	sim.network.setLink(from, to, func(l *Link) {
+		*l = link
+	})
+}
+
+// Link returns the fault model of the link from a node to another
+func (sim *Simulator) Link(from, to int) Link {
+	return sim.network.link(from, to)
+}
+
+// Partition splits the nodes into the groups, the links between the groups are down, and the nodes not in any
+// group are isolated
+func (sim *Simulator) Partition(groups ...[]int) {
+	group := make(map[int]int)
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			group[i] = g
+		}
+	}
+	for i := range sim.nodes {
+		for j := range sim.nodes {
+			gi, oki := group[i]
+			gj, okj := group[j]
+			down := !oki || !okj || gi != gj
+			sim.network.setLink(i, j, func(l *Link) {
+				l.Down = down
+			})
+		}
+	}
+}
+
+// Heal brings all the links up
+func (sim *Simulator) Heal() {
+	sim.network.setLink(Any, Any, func(l *Link) {
+		l.Down = false
+	})
+}
+
+// Delay sets the latency and the jitter of the links
+func (sim *Simulator) Delay(from, to int, latency, jitter time.Duration) {
+	sim.network.setLink(from, to, func(l *Link) {
+		l.Latency = latency
+		l.Jitter = jitter
+	})
+}
+
+// Drop sets the probability of losing the messages of the links
+func (sim *Simulator) Drop(from, to int, rate float64) {
+	sim.network.setLink(from, to, func(l *Link) {
+		l.DropRate = rate
+	})
+}
+
+// Duplicate sets the probability of delivering the messages of the links twice
+func (sim *Simulator) Duplicate(from, to int, rate float64) {
+	sim.network.setLink(from, to, func(l *Link) {
+		l.DupRate = rate
+	})
+}
+
+// Send sends a message from a node to another, or to all the others if to is Any, it is subject to the links
+func (sim *Simulator) Send(from, to int, typ p2p.MessageType, msg interface{}) error {
+	return sim.network.inject(from, to, typ, msg)
+}
+
+// Equivocate makes the representative of the node publish conflicting blocks and vote for all of them. Each group
+// of nodes receives all the blocks, the j-th group starts from the j-th block, so the groups see different blocks
+// first. The blocks are sent one by one with an interval of the default latency.
+func (sim *Simulator) Equivocate(from int, blks []*types.StateBlock, groups ...[]int) error {
+	if len(blks) == 0 {
+		return errors.New("no block to equivocate")
+	}
+	node := sim.nodes[from]
+	interval := sim.cfg.Link.Latency
+	if interval <= 0 {
+		interval = 50 * time.Millisecond
+	}
+
+	for g, nodes := range groups {
+		for k := range blks {
+			blk := blks[(g+k)%len(blks)]
+			ack := node.Vote(blk.GetHash())
+			for _, to := range nodes {
+				to := to
+				if to == from {
+					continue
+				}
+				sim.clock.After(time.Duration(k)*interval, func() {
+					if err := sim.Send(from, to, p2p.PublishReq, blk); err != nil {
+						sim.logger.Error(err)
+					}
+					if err := sim.Send(from, to, p2p.ConfirmAck, ack); err != nil {
+						sim.logger.Error(err)
+					}
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// SyncLedger catches up the ledger of a node with another, like the node receives the frontiers of the peer
+func (sim *Simulator) SyncLedger(to, from int) error {
+	return sim.nodes[to].syncWith(sim.nodes[from])
+}
+
+// MinePov mines a PoV block on the node and broadcasts it
+func (sim *Simulator) MinePov(i int) (*types.PovBlock, error) {
+	return sim.nodes[i].MinePov()
+}
+
+// Confirmed returns whether the block is confirmed by all the nodes
+func (sim *Simulator) Confirmed(hash types.Hash) bool {
+	for _, node := range sim.nodes {
+		if !node.IsConfirmed(hash) {
+			return false
+		}
+	}
+	return true
+}
+
+// FrontiersConverged checks the ledgers of all the nodes have the same frontiers
+func (sim *Simulator) FrontiersConverged() error {
+	expect, err := frontiers(sim.nodes[0])
+	if err != nil {
+		return err
+	}
+	for _, node := range sim.nodes[1:] {
+		fs, err := frontiers(node)
+		if err != nil {
+			return err
+		}
+		if len(fs) != len(expect) {
+			return fmt.Errorf("node %d has %d frontiers, node 0 has %d", node.Index, len(fs), len(expect))
+		}
+		for open, header := range expect {
+			if fs[open] != header {
+				return fmt.Errorf("node %d frontier of %s is %s, node 0 is %s", node.Index, open, fs[open], header)
+			}
+		}
+	}
+	return nil
+}
+
+func frontiers(node *Node) (map[types.Hash]types.Hash, error) {
+	fs, err := node.ledger.GetFrontiers()
+	if err != nil {
+		return nil, fmt.Errorf("node %d: %s", node.Index, err)
+	}
+	m := make(map[types.Hash]types.Hash, len(fs))
+	for _, f := range fs {
+		m[f.OpenBlock] = f.HeaderBlock
+	}
+	return m, nil
+}
+
+// PovTipsConverged checks the PoV chains of all the nodes have the same latest block
+func (sim *Simulator) PovTipsConverged() error {
+	expect := sim.nodes[0].PovTip()
+	if expect == nil {
+		return errors.New("node 0 has no pov tip")
+	}
+	for _, node := range sim.nodes[1:] {
+		tip := node.PovTip()
+		if tip == nil {
+			return fmt.Errorf("node %d has no pov tip", node.Index)
+		}
+		eh, th := expect.GetHash(), tip.GetHash()
+		if !bytes.Equal(eh[:], th[:]) {
+			return fmt.Errorf("node %d pov tip is %d/%s, node 0 is %d/%s", node.Index, tip.GetHeight(), th,
+				expect.GetHeight(), eh)
+		}
+	}
+	return nil
+}
+
+// Converged checks both the frontiers and the PoV tips
+func (sim *Simulator) Converged() error {
+	if err := sim.FrontiersConverged(); err != nil {
+		return err
+	}
+	return sim.PovTipsConverged()
+}
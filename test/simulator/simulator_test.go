@@ -0,0 +1,184 @@
+// +build integrate
+
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func setupSimulator(t *testing.T, fn func(cfg *Config)) *Simulator {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	if fn != nil {
+		fn(cfg)
+	}
+	sim, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sim
+}
+
+func sendAndProcess(t *testing.T, node *Node, amount int64) *types.StateBlock {
+	t.Helper()
+
+	send, err := node.GenerateSend(node.Rep, mock.Address(), types.NewBalance(amount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.ProcessBlock(send); err != nil {
+		t.Fatal(err)
+	}
+	return send
+}
+
+func TestSimulator_Confirm(t *testing.T) {
+	sim := setupSimulator(t, nil)
+	defer sim.Stop()
+
+	send := sendAndProcess(t, sim.Node(1), 100)
+	if !sim.RunUntil(3*time.Minute, func() bool { return sim.Confirmed(send.GetHash()) }) {
+		t.Fatal("block is not confirmed by all the nodes")
+	}
+
+	if _, err := sim.MinePov(0); err != nil {
+		t.Fatal(err)
+	}
+	if !sim.RunUntil(3*time.Minute, func() bool { return sim.Converged() == nil }) {
+		t.Fatal(sim.Converged())
+	}
+}
+
+func TestSimulator_Partition(t *testing.T) {
+	sim := setupSimulator(t, nil)
+	defer sim.Stop()
+
+	sim.Partition([]int{0, 1, 2}, []int{3})
+
+	send := sendAndProcess(t, sim.Node(0), 100)
+	if !sim.RunUntil(3*time.Minute, func() bool {
+		return sim.Node(0).IsConfirmed(send.GetHash()) && sim.Node(1).IsConfirmed(send.GetHash()) &&
+			sim.Node(2).IsConfirmed(send.GetHash())
+	}) {
+		t.Fatal("block is not confirmed by the majority")
+	}
+	if sim.Node(3).IsConfirmed(send.GetHash()) {
+		t.Fatal("block is confirmed by the isolated node")
+	}
+
+	// the minority can not confirm its block with a quarter of the weight
+	minority := sendAndProcess(t, sim.Node(3), 100)
+	sim.Run(10 * time.Second)
+	if sim.Node(3).IsConfirmed(minority.GetHash()) {
+		t.Fatal("block is confirmed by the minority")
+	}
+
+	if _, err := sim.MinePov(1); err != nil {
+		t.Fatal(err)
+	}
+	sim.Run(time.Second)
+	if err := sim.PovTipsConverged(); err == nil {
+		t.Fatal("pov tips converged in partition")
+	}
+
+	sim.Heal()
+	if _, err := sim.MinePov(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := sim.SyncLedger(3, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !sim.RunUntil(5*time.Minute, func() bool { return sim.Converged() == nil }) {
+		t.Fatal(sim.Converged())
+	}
+}
+
+func TestSimulator_Faults(t *testing.T) {
+	sim := setupSimulator(t, func(cfg *Config) {
+		cfg.Seed = 7
+	})
+	defer sim.Stop()
+
+	sim.Duplicate(Any, Any, 0.5)
+	sim.Delay(0, Any, 300*time.Millisecond, 200*time.Millisecond)
+	sim.Drop(Any, 2, 0.2)
+
+	hashes := make([]types.Hash, 0)
+	for i := 0; i < 3; i++ {
+		hashes = append(hashes, sendAndProcess(t, sim.Node(i), 100).GetHash())
+	}
+	if !sim.RunUntil(5*time.Minute, func() bool {
+		for _, h := range hashes {
+			if !sim.Node(0).IsConfirmed(h) || !sim.Node(1).IsConfirmed(h) {
+				return false
+			}
+		}
+		return true
+	}) {
+		t.Fatal("blocks are not confirmed")
+	}
+
+	stats := sim.Stats()
+	if stats.Duplicated == 0 || stats.Dropped == 0 || stats.Delivered == 0 {
+		t.Fatal("invalid stats", stats)
+	}
+}
+
+func TestSimulator_Equivocation(t *testing.T) {
+	// each representative has a fifth of the weight, so the three honest ones seeing the same block first confirm it
+	// without the equivocator
+	sim := setupSimulator(t, func(cfg *Config) {
+		cfg.Nodes = 5
+	})
+	defer sim.Stop()
+
+	node := sim.Node(4)
+	fork1, err := node.GenerateSend(node.Rep, mock.Address(), types.NewBalance(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork2, err := node.GenerateSend(node.Rep, mock.Address(), types.NewBalance(200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fork1.GetHash() == fork2.GetHash() || fork1.Root() != fork2.Root() {
+		t.Fatal("blocks are not forked")
+	}
+
+	if err := sim.Equivocate(4, []*types.StateBlock{fork1, fork2}, []int{0, 1, 2}, []int{3}); err != nil {
+		t.Fatal(err)
+	}
+
+	majority := sim.Nodes()[:3]
+	if !sim.RunUntil(3*time.Minute, func() bool {
+		for _, n := range majority {
+			if !n.IsConfirmed(fork1.GetHash()) {
+				return false
+			}
+		}
+		return true
+	}) {
+		t.Fatal("fork is not resolved")
+	}
+	for _, n := range sim.Nodes()[:4] {
+		if n.IsConfirmed(fork2.GetHash()) {
+			t.Fatalf("losing fork is confirmed by node %d", n.Index)
+		}
+	}
+
+	// the election of node 3 only knows the losing fork, so it ignores the votes of the winner and catches up by
+	// the frontiers like the equivocator, which never processed its blocks
+	for _, i := range []int{3, 4} {
+		if err := sim.SyncLedger(i, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !sim.RunUntil(5*time.Minute, func() bool { return sim.FrontiersConverged() == nil }) {
+		t.Fatal(sim.FrontiersConverged())
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package simulator
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var ErrSyncing = errors.New("ledger is syncing")
+
+// syncWith catches up the ledger of the node with the peer, like ServiceSync does when it receives the frontiers.
+// The frontiers and the chain blocks are read from the ledger of the peer directly, but the confirmation of the
+// frontiers is done by the consensus, so the ConfirmReq and the votes are carried by the simulated network.
+func (n *Node) syncWith(peer *Node) error {
+	if state := n.cc.P2PSyncState(); state != topic.SyncFinish && state != topic.SyncNotStart {
+		return ErrSyncing
+	}
+
+	remotes, err := peer.ledger.GetFrontiers()
+	if err != nil {
+		return err
+	}
+	sort.Sort(types.Frontiers(remotes))
+
+	locals, err := n.ledger.GetFrontiers()
+	if err != nil {
+		return err
+	}
+	headers := make(map[types.Hash]types.Hash, len(locals))
+	for _, f := range locals {
+		headers[f.OpenBlock] = f.HeaderBlock
+	}
+
+	var blks types.StateBlockList
+	pulls := make([]types.StateBlockList, 0)
+	for _, f := range remotes {
+		blk, err := peer.ledger.GetStateBlockConfirmed(f.HeaderBlock)
+		if err != nil {
+			return err
+		}
+		blks = append(blks, blk)
+
+		if n.IsConfirmed(f.HeaderBlock) {
+			continue
+		}
+		pull, err := peer.chainSegment(headers[f.OpenBlock], f.HeaderBlock)
+		if err != nil {
+			return err
+		}
+		pulls = append(pulls, pull)
+	}
+
+	eb := n.cc.EventBus()
+	eb.Publish(topic.EventSyncStateChange, &topic.EventP2PSyncStateMsg{P2pSyncState: topic.Syncing})
+	n.cons.RPC(common.RpcDPoSOnSyncStateChange, topic.Syncing, nil)
+
+	state := topic.SyncFinish
+	if len(blks) > 0 {
+		n.cons.RPC(common.RpcDPoSProcessFrontier, blks, nil)
+		for _, pull := range pulls {
+			eb.Publish(topic.EventSyncBlock, pull)
+		}
+		if len(pulls) > 0 {
+			state = topic.SyncDone
+		}
+	}
+
+	eb.Publish(topic.EventSyncStateChange, &topic.EventP2PSyncStateMsg{P2pSyncState: state})
+	n.cons.RPC(common.RpcDPoSOnSyncStateChange, state, nil)
+	return nil
+}
+
+// chainSegment returns the confirmed blocks after start until end, the whole chain if start is zero or not found
+func (n *Node) chainSegment(start, end types.Hash) (types.StateBlockList, error) {
+	var blks types.StateBlockList
+	for hash := end; !hash.IsZero() && hash != start; {
+		blk, err := n.ledger.GetStateBlockConfirmed(hash)
+		if err != nil {
+			return nil, err
+		}
+		blks = append(blks, blk)
+		hash = blk.GetPrevious()
+	}
+
+	for i, j := 0, len(blks)-1; i < j; i, j = i+1, j-1 {
+		blks[i], blks[j] = blks[j], blks[i]
+	}
+	return blks, nil
+}
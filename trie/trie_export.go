@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var ErrNodeHashMismatch = errors.New("trie node hash mismatch")
+
+// Export calls fn with the db key and value of every node reachable from the root,
+// and of every value referenced by hash nodes.
+func (trie *Trie) Export(fn func(k, v []byte) error) error {
+	if trie.Root == nil {
+		return nil
+	}
+	visited := make(map[types.Hash]struct{})
+	return trie.exportNode(trie.Root.Hash(), visited, fn)
+}
+
+func (trie *Trie) exportNode(hash *types.Hash, visited map[types.Hash]struct{}, fn func(k, v []byte) error) error {
+	if _, ok := visited[*hash]; ok {
+		return nil
+	}
+	visited[*hash] = struct{}{}
+
+	node := trie.getNode(hash)
+	if node == nil {
+		return fmt.Errorf("can not find trie node %s", hash)
+	}
+	data, err := node.Serialize()
+	if err != nil {
+		return fmt.Errorf("serialize trie node failed, error is %s", err)
+	}
+	if err := fn(encodeKey(hash[:]), data); err != nil {
+		return err
+	}
+
+	switch node.NodeType() {
+	case FullNode:
+		if node.child != nil {
+			if err := trie.exportNode(node.child.Hash(), visited, fn); err != nil {
+				return err
+			}
+		}
+		for _, child := range node.SortedChildren() {
+			if err := trie.exportNode(child.Hash(), visited, fn); err != nil {
+				return err
+			}
+		}
+	case ShortNode:
+		return trie.exportNode(node.child.Hash(), visited, fn)
+	case HashNode:
+		value, err := trie.getRefValue(node.value)
+		if err != nil {
+			return fmt.Errorf("can not find ref value of %s, error is %s", hash, err)
+		}
+		return fn(encodeKey(node.value), value)
+	}
+	return nil
+}
+
+// VerifyNodes loads the trie of rootHash by get, which returns values of the db keys written by Export.
+// It checks the hash of every node and referenced value, and calls fn with the key and value of every leaf.
+func VerifyNodes(rootHash types.Hash, get func(k []byte) ([]byte, error), fn func(key, value []byte) error) error {
+	return verifyNode(rootHash, nil, get, fn)
+}
+
+func verifyNode(hash types.Hash, path []byte, get func(k []byte) ([]byte, error), fn func(key, value []byte) error) error {
+	data, err := get(encodeKey(hash[:]))
+	if err != nil {
+		return fmt.Errorf("can not find trie node %s, error is %s", hash, err)
+	}
+	node := new(TrieNode)
+	if err := node.Deserialize(data); err != nil {
+		return fmt.Errorf("deserialize trie node %s failed, error is %s", hash, err)
+	}
+	node.hash = nil
+	if *node.Hash() != hash {
+		return ErrNodeHashMismatch
+	}
+
+	childPath := func(key ...byte) []byte {
+		p := make([]byte, 0, len(path)+len(key))
+		p = append(p, path...)
+		return append(p, key...)
+	}
+
+	switch node.NodeType() {
+	case FullNode:
+		if node.child != nil {
+			if err := verifyNode(*node.child.Hash(), path, get, fn); err != nil {
+				return err
+			}
+		}
+		for _, c := range sortChildren(node.children) {
+			if err := verifyNode(*c.Value.Hash(), childPath(c.Key), get, fn); err != nil {
+				return err
+			}
+		}
+	case ShortNode:
+		return verifyNode(*node.child.Hash(), childPath(node.key...), get, fn)
+	case ValueNode:
+		return fn(path, node.value)
+	case HashNode:
+		value, err := get(encodeKey(node.value))
+		if err != nil {
+			return fmt.Errorf("can not find ref value of %s, error is %s", hash, err)
+		}
+		if valueHash := types.HashData(value); !bytes.Equal(valueHash[:], node.value) {
+			return ErrNodeHashMismatch
+		}
+		return fn(path, value)
+	default:
+		return fmt.Errorf("invalid trie node type %d", node.NodeType())
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/storage/db"
+)
+
+func TestTrie_Export(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	kvs := map[string][]byte{
+		"tesabcd": []byte("value.hash4value.hash4value.hash4value.hash4value.hash4value.hash4"),
+		"tesab":   []byte("short"),
+		"tesa":    []byte("value.555value.555value.555value.555value.555value.555value.555"),
+		"abc":     []byte("abc"),
+	}
+	for k, v := range kvs {
+		trie.SetValue([]byte(k), v)
+	}
+	fn, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn()
+	rootHash := *trie.Hash()
+
+	store := db.NewMemoryStore()
+	if err := trie.Export(func(k, v []byte) error {
+		return store.Put(k, v)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	leaves := make(map[string][]byte)
+	if err := VerifyNodes(rootHash, store.Get, func(key, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(leaves) != len(kvs) {
+		t.Fatal("invalid leaf count", len(leaves))
+	}
+	for k, v := range kvs {
+		if !bytes.Equal(leaves[k], v) {
+			t.Fatalf("key %s, exp %s, got %s", k, v, leaves[k])
+		}
+	}
+
+	// tamper a referenced value
+	var refKey []byte
+	_ = store.Iterator([]byte{byte(storage.KeyPrefixTrie)}, nil, func(k, v []byte) error {
+		if bytes.Equal(v, kvs["tesa"]) {
+			refKey = k
+		}
+		return nil
+	})
+	if refKey == nil {
+		t.Fatal("ref value not exported")
+	}
+	_ = store.Put(refKey, []byte("forged value"))
+	if err := VerifyNodes(rootHash, store.Get, func(key, value []byte) error { return nil }); err != ErrNodeHashMismatch {
+		t.Fatal("expect hash mismatch, got", err)
+	}
+
+	_ = store.Delete(refKey)
+	if err := VerifyNodes(rootHash, store.Get, func(key, value []byte) error { return nil }); err == nil {
+		t.Fatal("missing node should fail")
+	}
+}
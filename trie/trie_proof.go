@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var (
+	ErrProofEmpty        = errors.New("proof is empty")
+	ErrProofTooShort     = errors.New("proof ended before reaching a leaf")
+	ErrProofHashMismatch = errors.New("proof node hash mismatch")
+	ErrProofValueInvalid = errors.New("proof value does not match hash node")
+)
+
+// Prove returns the serialized nodes on the path from the root to the leaf of key.
+// If the leaf is a hash node, the referenced value is appended as the last element.
+// When key does not exist, the returned nodes prove its absence.
+func (trie *Trie) Prove(key []byte) ([][]byte, error) {
+	if trie.Root == nil {
+		return nil, errors.New("trie is empty")
+	}
+
+	var proof [][]byte
+	node := trie.Root
+	for node != nil {
+		data, err := node.Serialize()
+		if err != nil {
+			return nil, fmt.Errorf("serialize trie node failed, error is %s", err)
+		}
+		proof = append(proof, data)
+
+		switch node.NodeType() {
+		case FullNode:
+			if len(key) == 0 {
+				node = node.child
+			} else {
+				node = node.children[key[0]]
+				key = key[1:]
+			}
+		case ShortNode:
+			if !bytes.HasPrefix(key, node.key) {
+				return proof, nil
+			}
+			key = key[len(node.key):]
+			node = node.child
+		case HashNode:
+			if len(key) == 0 {
+				value, err := trie.getRefValue(node.value)
+				if err != nil {
+					return nil, err
+				}
+				proof = append(proof, value)
+			}
+			return proof, nil
+		default:
+			return proof, nil
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks proof against rootHash and returns the value stored under key.
+// A nil value with a nil error means the proof shows that key is not in the trie.
+func VerifyProof(rootHash types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if len(proof) == 0 {
+		return nil, ErrProofEmpty
+	}
+
+	expected := rootHash
+	for i := 0; i < len(proof); i++ {
+		node := new(TrieNode)
+		if err := node.Deserialize(proof[i]); err != nil {
+			return nil, fmt.Errorf("deserialize proof node %d failed, error is %s", i, err)
+		}
+		// never trust the hash carried by the node itself
+		node.hash = nil
+		if *node.Hash() != expected {
+			return nil, ErrProofHashMismatch
+		}
+
+		var next *TrieNode
+		switch node.NodeType() {
+		case FullNode:
+			if len(key) == 0 {
+				next = node.child
+			} else {
+				next = node.children[key[0]]
+				key = key[1:]
+			}
+		case ShortNode:
+			if !bytes.HasPrefix(key, node.key) {
+				return nil, nil
+			}
+			key = key[len(node.key):]
+			next = node.child
+		case ValueNode:
+			if len(key) != 0 {
+				return nil, nil
+			}
+			return node.value, nil
+		case HashNode:
+			if len(key) != 0 {
+				return nil, nil
+			}
+			if i+1 >= len(proof) {
+				return nil, ErrProofTooShort
+			}
+			value := proof[i+1]
+			if valueHash := types.HashData(value); !bytes.Equal(valueHash[:], node.value) {
+				return nil, ErrProofValueInvalid
+			}
+			return value, nil
+		default:
+			return nil, fmt.Errorf("invalid proof node type %d", node.NodeType())
+		}
+
+		if next == nil {
+			return nil, nil
+		}
+		expected = *next.Hash()
+	}
+
+	return nil, ErrProofTooShort
+}
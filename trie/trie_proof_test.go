@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2019 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func TestTrie_Prove(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	kvs := map[string][]byte{
+		"tesabcd": []byte("value.hash4value.hash4value.hash4value.hash4value.hash4value.hash4"),
+		"tesab":   []byte("short"),
+		"tesa":    []byte("value.555value.555value.555value.555value.555value.555value.555"),
+		"abc":     []byte("abc"),
+		"":        []byte("empty key"),
+	}
+	for k, v := range kvs {
+		trie.SetValue([]byte(k), v)
+	}
+
+	fn, err := trie.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn()
+
+	rootHash := *trie.Hash()
+	trie2 := NewTrie(trie.db, &rootHash, nil)
+
+	for k, v := range kvs {
+		proof, err := trie2.Prove([]byte(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := VerifyProof(rootHash, []byte(k), proof)
+		if err != nil {
+			t.Fatal(k, err)
+		}
+		if !bytes.Equal(value, v) {
+			t.Fatalf("key %s, exp %s, got %s", k, v, value)
+		}
+	}
+
+	for _, k := range []string{"tesabc", "tes", "xyz", "abcd"} {
+		proof, err := trie2.Prove([]byte(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := VerifyProof(rootHash, []byte(k), proof)
+		if err != nil {
+			t.Fatal(k, err)
+		}
+		if value != nil {
+			t.Fatalf("key %s should not exist, got %s", k, value)
+		}
+	}
+}
+
+func TestVerifyProof_Invalid(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	key := []byte("tesabcd")
+	value := []byte("value.hash4value.hash4value.hash4value.hash4value.hash4value.hash4")
+	trie.SetValue(key, value)
+	trie.SetValue([]byte("tesab"), []byte("short"))
+	rootHash := *trie.Hash()
+
+	proof, err := trie.Prove(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := VerifyProof(rootHash, key, nil); err != ErrProofEmpty {
+		t.Fatal("expect empty proof error, got", err)
+	}
+
+	if _, err := VerifyProof(types.ZeroHash, key, proof); err != ErrProofHashMismatch {
+		t.Fatal("expect hash mismatch error, got", err)
+	}
+
+	if _, err := VerifyProof(rootHash, key, proof[:len(proof)-1]); err != ErrProofTooShort {
+		t.Fatal("expect too short error, got", err)
+	}
+
+	forged := make([][]byte, len(proof))
+	copy(forged, proof)
+	forged[len(forged)-1] = []byte("forged value")
+	if _, err := VerifyProof(rootHash, key, forged); err != ErrProofValueInvalid {
+		t.Fatal("expect invalid value error, got", err)
+	}
+}
@@ -8,10 +8,13 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"sync"
 	"time"
 
@@ -21,6 +24,8 @@ import (
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/crypto"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/monitor"
 )
@@ -33,6 +38,7 @@ const (
 	idPrefixIndex
 	idPrefixRepresentation
 	idPrefixWork
+	idPrefixHDPath
 )
 
 const (
@@ -72,7 +78,7 @@ func (ws *WalletStore) NewSession(walletId types.Address) *Session {
 }
 
 func (s *Session) removeWallet(batch storage.Batch) error {
-	for _, val := range []byte{idPrefixId, idPrefixVersion, idPrefixSeed, idPrefixRepresentation} {
+	for _, val := range []byte{idPrefixId, idPrefixVersion, idPrefixSeed, idPrefixRepresentation, idPrefixHDPath} {
 		key := []byte{val}
 		key = append(key, s.walletId...)
 		err := batch.Delete(key)
@@ -173,6 +179,72 @@ func (s *Session) setSeedByTxn(batch storage.Batch, seed []byte) error {
 	return batch.Put(key, encryptSeed)
 }
 
+// GetHDPath returns the path prefix of accounts of a HD wallet, empty for a wallet using seed index
+func (s *Session) GetHDPath() (string, error) {
+	val, err := s.Get(s.getKey(idPrefixHDPath))
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(val), nil
+}
+
+func (s *Session) setHDPath(batch storage.Batch, path string) error {
+	return batch.Put(s.getKey(idPrefixHDPath), []byte(path))
+}
+
+// Account returns the account of the index, derived by SLIP-10 path for a HD wallet, or by seed index
+func (s *Session) Account(index uint32) (*types.Account, error) {
+	derive, err := s.accountDeriver()
+	if err != nil {
+		return nil, err
+	}
+	return derive(index)
+}
+
+// Accounts returns the accounts of index 0 to count-1, the seed is decrypted only once
+func (s *Session) Accounts(count uint32) ([]*types.Account, error) {
+	derive, err := s.accountDeriver()
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*types.Account, 0, count)
+	for i := uint32(0); i < count; i++ {
+		a, err := derive(i)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, nil
+}
+
+func (s *Session) accountDeriver() (func(index uint32) (*types.Account, error), error) {
+	seedArray, err := s.GetSeed()
+	if err != nil {
+		return nil, err
+	}
+	path, err := s.GetHDPath()
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		seed, err := types.BytesToSeed(seedArray)
+		if err != nil {
+			return nil, err
+		}
+		return seed.Account, nil
+	}
+	if len(seedArray) != hd.SeedSize {
+		return nil, fmt.Errorf("invalid hd seed size[%d]", len(seedArray))
+	}
+	return func(index uint32) (*types.Account, error) {
+		return hd.DeriveAccount(seedArray, hd.AccountPath(path, index))
+	}, nil
+}
+
 func (s *Session) ResetDeterministicIndex() error {
 	return s.SetDeterministicIndex(0)
 }
@@ -284,16 +356,15 @@ func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
 		index = 0
 	}
 
-	seedArray, err := s.GetSeed()
+	derive, err := s.accountDeriver()
 	if err != nil {
 		return nil, err
 	}
 
 	max := util.UInt32Max(uint32(index), uint32(s.maxAccountCount))
-	seed, _ := types.BytesToSeed(seedArray)
 
 	for i := uint32(0); i < max; i++ {
-		a, err := seed.Account(uint32(i))
+		a, err := derive(i)
 		if err != nil {
 			s.logger.Fatal(err)
 		}
@@ -306,6 +377,82 @@ func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
 	return nil, fmt.Errorf("can not fetch account[%s]'s raw key", account.String())
 }
 
+// ExportKeystore exports the seed of the wallet to a keystore encrypted by the wallet password
+func (s *Session) ExportKeystore() (*keystore.Keystore, error) {
+	seed, err := s.GetSeed()
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) == 0 {
+		return nil, fmt.Errorf("can not find seed of wallet[%s]", s.address())
+	}
+	path, err := s.GetHDPath()
+	if err != nil {
+		return nil, err
+	}
+	pw := s.getPassword()
+	return keystore.EncryptWallet(s.address(), seed, path, string(pw), keystore.StandardScrypt)
+}
+
+// ExportAccount exports the private key of the account to a keystore encrypted by the wallet password
+func (s *Session) ExportAccount(account types.Address) (*keystore.Keystore, error) {
+	a, err := s.GetRawKey(account)
+	if err != nil {
+		return nil, err
+	}
+	pw := s.getPassword()
+	return keystore.EncryptAccount(a, string(pw), keystore.StandardScrypt)
+}
+
+// Import restores the seed of the wallet from its keystore encrypted by password, the seed is saved
+// encrypted by the wallet password, and must be the same as the saved one if the wallet has a seed
+func (s *Session) Import(content string, password string) error {
+	ks, err := keystore.Parse([]byte(content))
+	if err != nil {
+		return err
+	}
+	walletId, seed, err := decryptWalletKeystore(ks, password)
+	if err != nil {
+		return err
+	}
+	if walletId != s.address() {
+		return fmt.Errorf("keystore of wallet[%s] can not be imported to wallet[%s]", walletId, s.address())
+	}
+	saved, err := s.GetSeed()
+	if err != nil {
+		return err
+	}
+	if len(saved) > 0 && !bytes.Equal(saved, seed) {
+		return fmt.Errorf("seed of wallet[%s] does not match the keystore", walletId)
+	}
+	return s.BatchWrite(true, func(batch storage.Batch) error {
+		if ks.HDPath != "" {
+			if err := s.setHDPath(batch, ks.HDPath); err != nil {
+				return err
+			}
+		}
+		return s.setSeedByTxn(batch, seed)
+	})
+}
+
+// Export writes the keystore of the wallet to the file
+func (s *Session) Export(path string) error {
+	ks, err := s.ExportKeystore()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+func (s *Session) address() types.Address {
+	addr, _ := types.BytesToAddress(s.walletId)
+	return addr
+}
+
 func (s *Session) getKey(t byte) []byte {
 	var key []byte
 	key = append(key, t)
@@ -18,6 +18,8 @@ import (
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/storage/db"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -87,14 +89,90 @@ func (ws *WalletStore) NewWalletBySeed(seed, password string) (types.Address, er
 		return walletId, fmt.Errorf("seed[%s] already exist", seed)
 	}
 
+	return walletId, ws.newWallet(walletId, s[:], "", password)
+}
+
+// NewWalletByMnemonic create HD wallet from BIP-39 mnemonic, accounts are derived by SLIP-10 under the path,
+// hd.DefaultPath is used if the path is empty
+func (ws *WalletStore) NewWalletByMnemonic(mnemonic, passphrase, password, path string) (types.Address, error) {
+	if path == "" {
+		path = hd.DefaultPath
+	}
+	seed, err := hd.MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	account, err := hd.DeriveAccount(seed, hd.AccountPath(path, 0))
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	walletId := account.Address()
+	if b, err := ws.IsWalletExist(walletId); b && err == nil {
+		return walletId, fmt.Errorf("wallet[%s] already exist", walletId)
+	}
+
+	return walletId, ws.newWallet(walletId, seed, path, password)
+}
+
+// ImportKeystore creates wallet from the wallet keystore, the password of the keystore is used as the wallet password
+func (ws *WalletStore) ImportKeystore(content []byte, password string) (types.Address, error) {
+	ks, err := keystore.Parse(content)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	walletId, seed, err := decryptWalletKeystore(ks, password)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	if b, err := ws.IsWalletExist(walletId); b && err == nil {
+		return walletId, fmt.Errorf("wallet[%s] already exist", walletId)
+	}
+
+	return walletId, ws.newWallet(walletId, seed, ks.HDPath, password)
+}
+
+// decryptWalletKeystore decrypts the seed of the wallet keystore and checks the wallet id derived from it
+func decryptWalletKeystore(ks *keystore.Keystore, password string) (types.Address, []byte, error) {
+	if ks.Type != keystore.TypeWallet {
+		return types.ZeroAddress, nil, fmt.Errorf("can not import %s keystore as wallet", ks.Type)
+	}
+	seed, err := ks.Decrypt(password)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	var walletId types.Address
+	if ks.HDPath == "" {
+		s, err := types.BytesToSeed(seed)
+		if err != nil {
+			return types.ZeroAddress, nil, err
+		}
+		walletId = s.MasterAddress()
+	} else {
+		if len(seed) != hd.SeedSize {
+			return types.ZeroAddress, nil, fmt.Errorf("invalid hd seed size[%d]", len(seed))
+		}
+		account, err := hd.DeriveAccount(seed, hd.AccountPath(ks.HDPath, 0))
+		if err != nil {
+			return types.ZeroAddress, nil, err
+		}
+		walletId = account.Address()
+	}
+	if walletId != ks.Address {
+		return types.ZeroAddress, nil, keystore.ErrAddressMismatch
+	}
+	return walletId, seed, nil
+}
+
+func (ws *WalletStore) newWallet(walletId types.Address, seed []byte, path, password string) error {
 	session := ws.NewSession(walletId)
 	ids, err := ws.WalletIds()
 	if err != nil {
-		return types.ZeroAddress, err
+		return err
 	}
 
 	ids = append(ids, walletId)
-	err = ws.BatchWrite(true, func(batch storage.Batch) error {
+	return ws.BatchWrite(true, func(batch storage.Batch) error {
 		//add new walletId to ids
 		key := []byte{idPrefixIds}
 		bytes, err := json.Marshal(&ids)
@@ -119,12 +197,18 @@ func (ws *WalletStore) NewWalletBySeed(seed, password string) (types.Address, er
 		}
 		_ = session.setVersion(batch, Version)
 
+		if path != "" {
+			if err := session.setHDPath(batch, path); err != nil {
+				return err
+			}
+		}
+
 		err = session.EnterPassword(password)
 		if err != nil {
 			return err
 		}
 
-		err = session.setSeedByTxn(batch, s[:])
+		err = session.setSeedByTxn(batch, seed)
 
 		if err != nil {
 			return err
@@ -132,8 +216,6 @@ func (ws *WalletStore) NewWalletBySeed(seed, password string) (types.Address, er
 
 		return nil
 	})
-
-	return walletId, err
 }
 
 // IsWalletExist check is the wallet exist by master address
@@ -9,6 +9,7 @@ package wallet
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -18,6 +19,8 @@ import (
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/crypto/hd"
+	"github.com/qlcchain/go-qlc/crypto/keystore"
 )
 
 func TestNewWalletStore(t *testing.T) {
@@ -158,3 +161,114 @@ func TestWalletStore_NewWalletBySeed(t *testing.T) {
 		t.Fatal("invalid password")
 	}
 }
+
+func TestWalletStore_NewWalletByMnemonic(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	mnemonic, err := hd.NewMnemonic(24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := store.NewWalletByMnemonic(mnemonic, "passphrase", "password", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.NewWalletByMnemonic(mnemonic, "passphrase", "password", ""); err == nil {
+		t.Fatal("wallet should exist")
+	}
+	if id2, err := store.NewWalletByMnemonic(mnemonic, "", "password", ""); err != nil || id2 == id {
+		t.Fatal("passphrase should change the wallet", err)
+	}
+	if _, err := store.NewWalletByMnemonic(mnemonic, "", "password", "m/44'/0"); err == nil {
+		t.Fatal("path should be hardened")
+	}
+
+	session := store.NewSession(id)
+	if _, err := session.VerifyPassword("password"); err != nil {
+		t.Fatal(err)
+	}
+	if path, err := session.GetHDPath(); err != nil || path != hd.DefaultPath {
+		t.Fatal("invalid hd path", path, err)
+	}
+	seed, _ := hd.MnemonicToSeed(mnemonic, "passphrase")
+	expect, _ := hd.DeriveAccount(seed, hd.AccountPath(hd.DefaultPath, 3))
+	a, err := session.Account(3)
+	if err != nil || a.Address() != expect.Address() {
+		t.Fatal("invalid account", err)
+	}
+	if raw, err := session.GetRawKey(expect.Address()); err != nil || raw.Address() != expect.Address() {
+		t.Fatal("invalid raw key", err)
+	}
+	if accounts, err := session.Accounts(5); err != nil || len(accounts) != 5 || accounts[3].Address() != expect.Address() {
+		t.Fatal("invalid accounts", err)
+	}
+}
+
+func TestWalletStore_ImportKeystore(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	mnemonic, _ := hd.NewMnemonic(12)
+	hdId, err := store.NewWalletByMnemonic(mnemonic, "", "password", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, _ := types.NewSeed()
+	id, err := store.NewWalletBySeed(seed.String(), "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, walletId := range []types.Address{hdId, id} {
+		session := store.NewSession(walletId)
+		if _, err := session.VerifyPassword("password"); err != nil {
+			t.Fatal(err)
+		}
+		account, err := session.Account(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ks, err := session.ExportAccount(account.Address()); err != nil || ks.Address != account.Address() {
+			t.Fatal("invalid account keystore", err)
+		}
+
+		file := filepath.Join(store.dir, walletId.String()+".json")
+		if err := session.Export(file); err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.ImportKeystore(content, "password"); err == nil {
+			t.Fatal("wallet should exist")
+		}
+		if err := session.Import(string(content), "wrong"); err != keystore.ErrDecrypt {
+			t.Fatal("password should be invalid", err)
+		}
+		if err := session.Import(string(content), "password"); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.NewSession(types.ZeroAddress).Import(string(content), "password"); err == nil {
+			t.Fatal("keystore of another wallet should not be imported")
+		}
+		if err := store.RemoveWallet(walletId); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.ImportKeystore(content, "wrong"); err != keystore.ErrDecrypt {
+			t.Fatal("password should be invalid", err)
+		}
+		imported, err := store.ImportKeystore(content, "password")
+		if err != nil || imported != walletId {
+			t.Fatal("invalid imported wallet", err)
+		}
+		session = store.NewSession(imported)
+		if _, err := session.VerifyPassword("password"); err != nil {
+			t.Fatal(err)
+		}
+		if a, err := session.Account(2); err != nil || a.Address() != account.Address() {
+			t.Fatal("invalid imported account", err)
+		}
+	}
+}